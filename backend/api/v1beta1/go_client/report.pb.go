@@ -23,9 +23,10 @@ package go_client
 import (
 	context "context"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	status1 "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -88,6 +89,103 @@ func (x *ReportWorkflowRequest) GetWorkflow() string {
 	return ""
 }
 
+type ReportWorkflowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Workflows are workflow custom resources marshalled into json strings.
+	Workflows []string `protobuf:"bytes,1,rep,name=workflows,proto3" json:"workflows,omitempty"`
+}
+
+func (x *ReportWorkflowsRequest) Reset() {
+	*x = ReportWorkflowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v1beta1_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportWorkflowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkflowsRequest) ProtoMessage() {}
+
+func (x *ReportWorkflowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v1beta1_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkflowsRequest.ProtoReflect.Descriptor instead.
+func (*ReportWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v1beta1_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportWorkflowsRequest) GetWorkflows() []string {
+	if x != nil {
+		return x.Workflows
+	}
+	return nil
+}
+
+type ReportWorkflowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results of the reports of the workflows, in the order of the request.
+	// The code of a result is OK if its workflow was reported.
+	Results []*status.Status `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReportWorkflowsResponse) Reset() {
+	*x = ReportWorkflowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v1beta1_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportWorkflowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkflowsResponse) ProtoMessage() {}
+
+func (x *ReportWorkflowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v1beta1_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkflowsResponse.ProtoReflect.Descriptor instead.
+func (*ReportWorkflowsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v1beta1_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportWorkflowsResponse) GetResults() []*status.Status {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ReportScheduledWorkflowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -100,7 +198,7 @@ type ReportScheduledWorkflowRequest struct {
 func (x *ReportScheduledWorkflowRequest) Reset() {
 	*x = ReportScheduledWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_api_v1beta1_report_proto_msgTypes[1]
+		mi := &file_backend_api_v1beta1_report_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -113,7 +211,7 @@ func (x *ReportScheduledWorkflowRequest) String() string {
 func (*ReportScheduledWorkflowRequest) ProtoMessage() {}
 
 func (x *ReportScheduledWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v1beta1_report_proto_msgTypes[1]
+	mi := &file_backend_api_v1beta1_report_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,7 +224,7 @@ func (x *ReportScheduledWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportScheduledWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*ReportScheduledWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v1beta1_report_proto_rawDescGZIP(), []int{1}
+	return file_backend_api_v1beta1_report_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ReportScheduledWorkflowRequest) GetScheduledWorkflow() string {
@@ -145,37 +243,55 @@ var file_backend_api_v1beta1_report_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x33, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x4f, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0x9b, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x31, 0x12, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x15, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x22, 0x36, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x4f, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x32, 0x9b, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x31, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x08, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x7e, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x56, 0x31, 0x12, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22,
+	0x23, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x56, 0x31, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66,
 	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69,
-	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x73, 0x3a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x96, 0x01,
-	0x0a, 0x19, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x56, 0x31, 0x12, 0x23, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36,
-	0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x73, 0x3a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69,
+	0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x12, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -190,22 +306,28 @@ func file_backend_api_v1beta1_report_proto_rawDescGZIP() []byte {
 	return file_backend_api_v1beta1_report_proto_rawDescData
 }
 
-var file_backend_api_v1beta1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_backend_api_v1beta1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_backend_api_v1beta1_report_proto_goTypes = []interface{}{
 	(*ReportWorkflowRequest)(nil),          // 0: api.ReportWorkflowRequest
-	(*ReportScheduledWorkflowRequest)(nil), // 1: api.ReportScheduledWorkflowRequest
-	(*emptypb.Empty)(nil),                  // 2: google.protobuf.Empty
+	(*ReportWorkflowsRequest)(nil),         // 1: api.ReportWorkflowsRequest
+	(*ReportWorkflowsResponse)(nil),        // 2: api.ReportWorkflowsResponse
+	(*ReportScheduledWorkflowRequest)(nil), // 3: api.ReportScheduledWorkflowRequest
+	(*status.Status)(nil),                  // 4: google.rpc.Status
+	(*emptypb.Empty)(nil),                  // 5: google.protobuf.Empty
 }
 var file_backend_api_v1beta1_report_proto_depIdxs = []int32{
-	0, // 0: api.ReportService.ReportWorkflowV1:input_type -> api.ReportWorkflowRequest
-	1, // 1: api.ReportService.ReportScheduledWorkflowV1:input_type -> api.ReportScheduledWorkflowRequest
-	2, // 2: api.ReportService.ReportWorkflowV1:output_type -> google.protobuf.Empty
-	2, // 3: api.ReportService.ReportScheduledWorkflowV1:output_type -> google.protobuf.Empty
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: api.ReportWorkflowsResponse.results:type_name -> google.rpc.Status
+	0, // 1: api.ReportService.ReportWorkflowV1:input_type -> api.ReportWorkflowRequest
+	1, // 2: api.ReportService.ReportWorkflowsV1:input_type -> api.ReportWorkflowsRequest
+	3, // 3: api.ReportService.ReportScheduledWorkflowV1:input_type -> api.ReportScheduledWorkflowRequest
+	5, // 4: api.ReportService.ReportWorkflowV1:output_type -> google.protobuf.Empty
+	2, // 5: api.ReportService.ReportWorkflowsV1:output_type -> api.ReportWorkflowsResponse
+	5, // 6: api.ReportService.ReportScheduledWorkflowV1:output_type -> google.protobuf.Empty
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v1beta1_report_proto_init() }
@@ -227,6 +349,30 @@ func file_backend_api_v1beta1_report_proto_init() {
 			}
 		}
 		file_backend_api_v1beta1_report_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportWorkflowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v1beta1_report_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportWorkflowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v1beta1_report_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportScheduledWorkflowRequest); i {
 			case 0:
 				return &v.state
@@ -245,7 +391,7 @@ func file_backend_api_v1beta1_report_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_backend_api_v1beta1_report_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -272,6 +418,9 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ReportServiceClient interface {
 	ReportWorkflowV1(ctx context.Context, in *ReportWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reports a batch of workflows. The workflows are reported independently, so
+	// the failure of a workflow does not fail the others.
+	ReportWorkflowsV1(ctx context.Context, in *ReportWorkflowsRequest, opts ...grpc.CallOption) (*ReportWorkflowsResponse, error)
 	ReportScheduledWorkflowV1(ctx context.Context, in *ReportScheduledWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -292,6 +441,15 @@ func (c *reportServiceClient) ReportWorkflowV1(ctx context.Context, in *ReportWo
 	return out, nil
 }
 
+func (c *reportServiceClient) ReportWorkflowsV1(ctx context.Context, in *ReportWorkflowsRequest, opts ...grpc.CallOption) (*ReportWorkflowsResponse, error) {
+	out := new(ReportWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/api.ReportService/ReportWorkflowsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reportServiceClient) ReportScheduledWorkflowV1(ctx context.Context, in *ReportScheduledWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.ReportService/ReportScheduledWorkflowV1", in, out, opts...)
@@ -304,6 +462,9 @@ func (c *reportServiceClient) ReportScheduledWorkflowV1(ctx context.Context, in
 // ReportServiceServer is the server API for ReportService service.
 type ReportServiceServer interface {
 	ReportWorkflowV1(context.Context, *ReportWorkflowRequest) (*emptypb.Empty, error)
+	// Reports a batch of workflows. The workflows are reported independently, so
+	// the failure of a workflow does not fail the others.
+	ReportWorkflowsV1(context.Context, *ReportWorkflowsRequest) (*ReportWorkflowsResponse, error)
 	ReportScheduledWorkflowV1(context.Context, *ReportScheduledWorkflowRequest) (*emptypb.Empty, error)
 }
 
@@ -312,10 +473,13 @@ type UnimplementedReportServiceServer struct {
 }
 
 func (*UnimplementedReportServiceServer) ReportWorkflowV1(context.Context, *ReportWorkflowRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportWorkflowV1 not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method ReportWorkflowV1 not implemented")
+}
+func (*UnimplementedReportServiceServer) ReportWorkflowsV1(context.Context, *ReportWorkflowsRequest) (*ReportWorkflowsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ReportWorkflowsV1 not implemented")
 }
 func (*UnimplementedReportServiceServer) ReportScheduledWorkflowV1(context.Context, *ReportScheduledWorkflowRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportScheduledWorkflowV1 not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method ReportScheduledWorkflowV1 not implemented")
 }
 
 func RegisterReportServiceServer(s *grpc.Server, srv ReportServiceServer) {
@@ -340,6 +504,24 @@ func _ReportService_ReportWorkflowV1_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportService_ReportWorkflowsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ReportWorkflowsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ReportService/ReportWorkflowsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ReportWorkflowsV1(ctx, req.(*ReportWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReportService_ReportScheduledWorkflowV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportScheduledWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +548,10 @@ var _ReportService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportWorkflowV1",
 			Handler:    _ReportService_ReportWorkflowV1_Handler,
 		},
+		{
+			MethodName: "ReportWorkflowsV1",
+			Handler:    _ReportService_ReportWorkflowsV1_Handler,
+		},
 		{
 			MethodName: "ReportScheduledWorkflowV1",
 			Handler:    _ReportService_ReportScheduledWorkflowV1_Handler,
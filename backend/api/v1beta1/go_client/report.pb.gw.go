@@ -45,6 +45,23 @@ func request_ReportService_ReportWorkflowV1_0(ctx context.Context, marshaler run
 
 }
 
+func request_ReportService_ReportWorkflowsV1_0(ctx context.Context, marshaler runtime.Marshaler, client ReportServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ReportWorkflowsRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.ReportWorkflowsV1(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
 func request_ReportService_ReportScheduledWorkflowV1_0(ctx context.Context, marshaler runtime.Marshaler, client ReportServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ReportScheduledWorkflowRequest
 	var metadata runtime.ServerMetadata
@@ -120,6 +137,26 @@ func RegisterReportServiceHandlerClient(ctx context.Context, mux *runtime.ServeM
 
 	})
 
+	mux.Handle("POST", pattern_ReportService_ReportWorkflowsV1_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ReportService_ReportWorkflowsV1_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_ReportService_ReportWorkflowsV1_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_ReportService_ReportScheduledWorkflowV1_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -146,11 +183,15 @@ func RegisterReportServiceHandlerClient(ctx context.Context, mux *runtime.ServeM
 var (
 	pattern_ReportService_ReportWorkflowV1_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"apis", "v1beta1", "workflows"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_ReportService_ReportWorkflowsV1_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"apis", "v1beta1", "workflows"}, "batchReport", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_ReportService_ReportScheduledWorkflowV1_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"apis", "v1beta1", "scheduledworkflows"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
 	forward_ReportService_ReportWorkflowV1_0 = runtime.ForwardResponseMessage
 
+	forward_ReportService_ReportWorkflowsV1_0 = runtime.ForwardResponseMessage
+
 	forward_ReportService_ReportScheduledWorkflowV1_0 = runtime.ForwardResponseMessage
 )
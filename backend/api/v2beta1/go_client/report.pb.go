@@ -23,9 +23,10 @@ package go_client
 import (
 	context "context"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	status1 "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -88,6 +89,103 @@ func (x *ReportWorkflowRequest) GetWorkflow() string {
 	return ""
 }
 
+type ReportWorkflowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Workflows are workflow custom resources marshalled into json strings.
+	Workflows []string `protobuf:"bytes,1,rep,name=workflows,proto3" json:"workflows,omitempty"`
+}
+
+func (x *ReportWorkflowsRequest) Reset() {
+	*x = ReportWorkflowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v2beta1_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportWorkflowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkflowsRequest) ProtoMessage() {}
+
+func (x *ReportWorkflowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkflowsRequest.ProtoReflect.Descriptor instead.
+func (*ReportWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportWorkflowsRequest) GetWorkflows() []string {
+	if x != nil {
+		return x.Workflows
+	}
+	return nil
+}
+
+type ReportWorkflowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results of the reports of the workflows, in the order of the request.
+	// The code of a result is OK if its workflow was reported.
+	Results []*status.Status `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReportWorkflowsResponse) Reset() {
+	*x = ReportWorkflowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v2beta1_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportWorkflowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkflowsResponse) ProtoMessage() {}
+
+func (x *ReportWorkflowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkflowsResponse.ProtoReflect.Descriptor instead.
+func (*ReportWorkflowsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportWorkflowsResponse) GetResults() []*status.Status {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ReportScheduledWorkflowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -100,7 +198,7 @@ type ReportScheduledWorkflowRequest struct {
 func (x *ReportScheduledWorkflowRequest) Reset() {
 	*x = ReportScheduledWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_api_v2beta1_report_proto_msgTypes[1]
+		mi := &file_backend_api_v2beta1_report_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -113,7 +211,7 @@ func (x *ReportScheduledWorkflowRequest) String() string {
 func (*ReportScheduledWorkflowRequest) ProtoMessage() {}
 
 func (x *ReportScheduledWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_report_proto_msgTypes[1]
+	mi := &file_backend_api_v2beta1_report_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,7 +224,7 @@ func (x *ReportScheduledWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportScheduledWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*ReportScheduledWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_report_proto_rawDescGZIP(), []int{1}
+	return file_backend_api_v2beta1_report_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ReportScheduledWorkflowRequest) GetScheduledWorkflow() string {
@@ -136,6 +234,111 @@ func (x *ReportScheduledWorkflowRequest) GetScheduledWorkflow() string {
 	return ""
 }
 
+type MaterializeScheduledWorkflowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the recurring run.
+	RecurringRunId string `protobuf:"bytes,1,opt,name=recurring_run_id,json=recurringRunId,proto3" json:"recurring_run_id,omitempty"`
+}
+
+func (x *MaterializeScheduledWorkflowRequest) Reset() {
+	*x = MaterializeScheduledWorkflowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v2beta1_report_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaterializeScheduledWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeScheduledWorkflowRequest) ProtoMessage() {}
+
+func (x *MaterializeScheduledWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_report_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeScheduledWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*MaterializeScheduledWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MaterializeScheduledWorkflowRequest) GetRecurringRunId() string {
+	if x != nil {
+		return x.RecurringRunId
+	}
+	return ""
+}
+
+type MaterializeScheduledWorkflowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the pipeline version the workflow was built from.
+	PipelineVersionId string `protobuf:"bytes,1,opt,name=pipeline_version_id,json=pipelineVersionId,proto3" json:"pipeline_version_id,omitempty"`
+	// Workflow is a WorkflowResource of a ScheduledWorkflow marshalled into a json string.
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+}
+
+func (x *MaterializeScheduledWorkflowResponse) Reset() {
+	*x = MaterializeScheduledWorkflowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v2beta1_report_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaterializeScheduledWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeScheduledWorkflowResponse) ProtoMessage() {}
+
+func (x *MaterializeScheduledWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_report_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeScheduledWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*MaterializeScheduledWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MaterializeScheduledWorkflowResponse) GetPipelineVersionId() string {
+	if x != nil {
+		return x.PipelineVersionId
+	}
+	return ""
+}
+
+func (x *MaterializeScheduledWorkflowResponse) GetWorkflow() string {
+	if x != nil {
+		return x.Workflow
+	}
+	return ""
+}
+
 var File_backend_api_v2beta1_report_proto protoreflect.FileDescriptor
 
 var file_backend_api_v2beta1_report_proto_rawDesc = []byte{
@@ -147,42 +350,92 @@ var file_backend_api_v2beta1_report_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x4f, 0x0a, 0x1e, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0xde, 0x02, 0x0a, 0x0d,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x92, 0x01,
-	0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x12, 0x3d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22,
-	0x17, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x12, 0xb7, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x46,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70,
+	0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33,
+	0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x22, 0x36, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x4f, 0x0a, 0x23, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x24, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0xa5, 0x06, 0x0a, 0x0d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x92, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12,
+	0x3d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x17,
+	0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x12, 0xc2, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x23,
+	0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xb7, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69,
+	0x73, 0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x12, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x12, 0xff, 0x01, 0x0a, 0x1c, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x12, 0x4b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4c,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
 	0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x32,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x3d, 0x5a, 0x3b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x66,
-	0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x32, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -197,22 +450,32 @@ func file_backend_api_v2beta1_report_proto_rawDescGZIP() []byte {
 	return file_backend_api_v2beta1_report_proto_rawDescData
 }
 
-var file_backend_api_v2beta1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_backend_api_v2beta1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_backend_api_v2beta1_report_proto_goTypes = []interface{}{
-	(*ReportWorkflowRequest)(nil),          // 0: kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowRequest
-	(*ReportScheduledWorkflowRequest)(nil), // 1: kubeflow.pipelines.backend.api.v2beta1.ReportScheduledWorkflowRequest
-	(*emptypb.Empty)(nil),                  // 2: google.protobuf.Empty
+	(*ReportWorkflowRequest)(nil),                // 0: kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowRequest
+	(*ReportWorkflowsRequest)(nil),               // 1: kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowsRequest
+	(*ReportWorkflowsResponse)(nil),              // 2: kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowsResponse
+	(*ReportScheduledWorkflowRequest)(nil),       // 3: kubeflow.pipelines.backend.api.v2beta1.ReportScheduledWorkflowRequest
+	(*MaterializeScheduledWorkflowRequest)(nil),  // 4: kubeflow.pipelines.backend.api.v2beta1.MaterializeScheduledWorkflowRequest
+	(*MaterializeScheduledWorkflowResponse)(nil), // 5: kubeflow.pipelines.backend.api.v2beta1.MaterializeScheduledWorkflowResponse
+	(*status.Status)(nil),                        // 6: google.rpc.Status
+	(*emptypb.Empty)(nil),                        // 7: google.protobuf.Empty
 }
 var file_backend_api_v2beta1_report_proto_depIdxs = []int32{
-	0, // 0: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportWorkflow:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowRequest
-	1, // 1: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportScheduledWorkflow:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReportScheduledWorkflowRequest
-	2, // 2: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportWorkflow:output_type -> google.protobuf.Empty
-	2, // 3: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportScheduledWorkflow:output_type -> google.protobuf.Empty
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowsResponse.results:type_name -> google.rpc.Status
+	0, // 1: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportWorkflow:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowRequest
+	1, // 2: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportWorkflows:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowsRequest
+	3, // 3: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportScheduledWorkflow:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReportScheduledWorkflowRequest
+	4, // 4: kubeflow.pipelines.backend.api.v2beta1.ReportService.MaterializeScheduledWorkflow:input_type -> kubeflow.pipelines.backend.api.v2beta1.MaterializeScheduledWorkflowRequest
+	7, // 5: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportWorkflow:output_type -> google.protobuf.Empty
+	2, // 6: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportWorkflows:output_type -> kubeflow.pipelines.backend.api.v2beta1.ReportWorkflowsResponse
+	7, // 7: kubeflow.pipelines.backend.api.v2beta1.ReportService.ReportScheduledWorkflow:output_type -> google.protobuf.Empty
+	5, // 8: kubeflow.pipelines.backend.api.v2beta1.ReportService.MaterializeScheduledWorkflow:output_type -> kubeflow.pipelines.backend.api.v2beta1.MaterializeScheduledWorkflowResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v2beta1_report_proto_init() }
@@ -234,6 +497,30 @@ func file_backend_api_v2beta1_report_proto_init() {
 			}
 		}
 		file_backend_api_v2beta1_report_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportWorkflowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v2beta1_report_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportWorkflowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v2beta1_report_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportScheduledWorkflowRequest); i {
 			case 0:
 				return &v.state
@@ -245,6 +532,30 @@ func file_backend_api_v2beta1_report_proto_init() {
 				return nil
 			}
 		}
+		file_backend_api_v2beta1_report_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaterializeScheduledWorkflowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v2beta1_report_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaterializeScheduledWorkflowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -252,7 +563,7 @@ func file_backend_api_v2beta1_report_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_backend_api_v2beta1_report_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -279,7 +590,13 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ReportServiceClient interface {
 	ReportWorkflow(ctx context.Context, in *ReportWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reports a batch of workflows. The workflows are reported independently, so
+	// the failure of a workflow does not fail the others.
+	ReportWorkflows(ctx context.Context, in *ReportWorkflowsRequest, opts ...grpc.CallOption) (*ReportWorkflowsResponse, error)
 	ReportScheduledWorkflow(ctx context.Context, in *ReportScheduledWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Builds the workflow of the next run of a recurring run that uses the latest
+	// version of its pipeline.
+	MaterializeScheduledWorkflow(ctx context.Context, in *MaterializeScheduledWorkflowRequest, opts ...grpc.CallOption) (*MaterializeScheduledWorkflowResponse, error)
 }
 
 type reportServiceClient struct {
@@ -299,6 +616,15 @@ func (c *reportServiceClient) ReportWorkflow(ctx context.Context, in *ReportWork
 	return out, nil
 }
 
+func (c *reportServiceClient) ReportWorkflows(ctx context.Context, in *ReportWorkflowsRequest, opts ...grpc.CallOption) (*ReportWorkflowsResponse, error) {
+	out := new(ReportWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/kubeflow.pipelines.backend.api.v2beta1.ReportService/ReportWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reportServiceClient) ReportScheduledWorkflow(ctx context.Context, in *ReportScheduledWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/kubeflow.pipelines.backend.api.v2beta1.ReportService/ReportScheduledWorkflow", in, out, opts...)
@@ -308,10 +634,25 @@ func (c *reportServiceClient) ReportScheduledWorkflow(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *reportServiceClient) MaterializeScheduledWorkflow(ctx context.Context, in *MaterializeScheduledWorkflowRequest, opts ...grpc.CallOption) (*MaterializeScheduledWorkflowResponse, error) {
+	out := new(MaterializeScheduledWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/kubeflow.pipelines.backend.api.v2beta1.ReportService/MaterializeScheduledWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReportServiceServer is the server API for ReportService service.
 type ReportServiceServer interface {
 	ReportWorkflow(context.Context, *ReportWorkflowRequest) (*emptypb.Empty, error)
+	// Reports a batch of workflows. The workflows are reported independently, so
+	// the failure of a workflow does not fail the others.
+	ReportWorkflows(context.Context, *ReportWorkflowsRequest) (*ReportWorkflowsResponse, error)
 	ReportScheduledWorkflow(context.Context, *ReportScheduledWorkflowRequest) (*emptypb.Empty, error)
+	// Builds the workflow of the next run of a recurring run that uses the latest
+	// version of its pipeline.
+	MaterializeScheduledWorkflow(context.Context, *MaterializeScheduledWorkflowRequest) (*MaterializeScheduledWorkflowResponse, error)
 }
 
 // UnimplementedReportServiceServer can be embedded to have forward compatible implementations.
@@ -319,10 +660,16 @@ type UnimplementedReportServiceServer struct {
 }
 
 func (*UnimplementedReportServiceServer) ReportWorkflow(context.Context, *ReportWorkflowRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportWorkflow not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method ReportWorkflow not implemented")
+}
+func (*UnimplementedReportServiceServer) ReportWorkflows(context.Context, *ReportWorkflowsRequest) (*ReportWorkflowsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ReportWorkflows not implemented")
 }
 func (*UnimplementedReportServiceServer) ReportScheduledWorkflow(context.Context, *ReportScheduledWorkflowRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportScheduledWorkflow not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method ReportScheduledWorkflow not implemented")
+}
+func (*UnimplementedReportServiceServer) MaterializeScheduledWorkflow(context.Context, *MaterializeScheduledWorkflowRequest) (*MaterializeScheduledWorkflowResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method MaterializeScheduledWorkflow not implemented")
 }
 
 func RegisterReportServiceServer(s *grpc.Server, srv ReportServiceServer) {
@@ -347,6 +694,24 @@ func _ReportService_ReportWorkflow_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportService_ReportWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ReportWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.ReportService/ReportWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ReportWorkflows(ctx, req.(*ReportWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReportService_ReportScheduledWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportScheduledWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -365,6 +730,24 @@ func _ReportService_ReportScheduledWorkflow_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportService_MaterializeScheduledWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaterializeScheduledWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).MaterializeScheduledWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.ReportService/MaterializeScheduledWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).MaterializeScheduledWorkflow(ctx, req.(*MaterializeScheduledWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReportService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubeflow.pipelines.backend.api.v2beta1.ReportService",
 	HandlerType: (*ReportServiceServer)(nil),
@@ -373,10 +756,18 @@ var _ReportService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportWorkflow",
 			Handler:    _ReportService_ReportWorkflow_Handler,
 		},
+		{
+			MethodName: "ReportWorkflows",
+			Handler:    _ReportService_ReportWorkflows_Handler,
+		},
 		{
 			MethodName: "ReportScheduledWorkflow",
 			Handler:    _ReportService_ReportScheduledWorkflow_Handler,
 		},
+		{
+			MethodName: "MaterializeScheduledWorkflow",
+			Handler:    _ReportService_MaterializeScheduledWorkflow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/v2beta1/report.proto",
@@ -0,0 +1,432 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.17.3
+// source: backend/api/v2beta1/run_log.proto
+
+package go_client
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StreamRunLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required input. The ID of the run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Optional input. The IDs of the nodes whose logs are streamed. All the pod
+	// nodes of the run if empty.
+	NodeIds []string `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// Optional input. The cursor of the last entry received by the client, which
+	// resumes the stream after it.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *StreamRunLogsRequest) Reset() {
+	*x = StreamRunLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v2beta1_run_log_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRunLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRunLogsRequest) ProtoMessage() {}
+
+func (x *StreamRunLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_log_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRunLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamRunLogsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamRunLogsRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *StreamRunLogsRequest) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *StreamRunLogsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// A line of the log of a node of a run.
+type RunLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output. The ID of the node, which is the name of its pod.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Output. The name of the task run by the node.
+	TaskName string `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	// Output. The time at which the line was written.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Output. The line, without the trailing newline.
+	Log string `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
+	// Output. The position of the stream after the entry, which resumes it when
+	// passed as the cursor of a new request.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RunLogEntry) Reset() {
+	*x = RunLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_v2beta1_run_log_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunLogEntry) ProtoMessage() {}
+
+func (x *RunLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_log_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunLogEntry.ProtoReflect.Descriptor instead.
+func (*RunLogEntry) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RunLogEntry) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RunLogEntry) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *RunLogEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RunLogEntry) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *RunLogEntry) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+var File_backend_api_v2beta1_run_log_proto protoreflect.FileDescriptor
+
+var file_backend_api_v2beta1_run_log_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x26, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x14,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xa7,
+	0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x32, 0x98, 0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6e,
+	0x4c, 0x6f, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x0d, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x3c, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x00, 0x30, 0x01, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x32, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_backend_api_v2beta1_run_log_proto_rawDescOnce sync.Once
+	file_backend_api_v2beta1_run_log_proto_rawDescData = file_backend_api_v2beta1_run_log_proto_rawDesc
+)
+
+func file_backend_api_v2beta1_run_log_proto_rawDescGZIP() []byte {
+	file_backend_api_v2beta1_run_log_proto_rawDescOnce.Do(func() {
+		file_backend_api_v2beta1_run_log_proto_rawDescData = protoimpl.X.CompressGZIP(file_backend_api_v2beta1_run_log_proto_rawDescData)
+	})
+	return file_backend_api_v2beta1_run_log_proto_rawDescData
+}
+
+var file_backend_api_v2beta1_run_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_backend_api_v2beta1_run_log_proto_goTypes = []interface{}{
+	(*StreamRunLogsRequest)(nil),  // 0: kubeflow.pipelines.backend.api.v2beta1.StreamRunLogsRequest
+	(*RunLogEntry)(nil),           // 1: kubeflow.pipelines.backend.api.v2beta1.RunLogEntry
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_backend_api_v2beta1_run_log_proto_depIdxs = []int32{
+	2, // 0: kubeflow.pipelines.backend.api.v2beta1.RunLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	0, // 1: kubeflow.pipelines.backend.api.v2beta1.RunLogService.StreamRunLogs:input_type -> kubeflow.pipelines.backend.api.v2beta1.StreamRunLogsRequest
+	1, // 2: kubeflow.pipelines.backend.api.v2beta1.RunLogService.StreamRunLogs:output_type -> kubeflow.pipelines.backend.api.v2beta1.RunLogEntry
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_backend_api_v2beta1_run_log_proto_init() }
+func file_backend_api_v2beta1_run_log_proto_init() {
+	if File_backend_api_v2beta1_run_log_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_backend_api_v2beta1_run_log_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRunLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_api_v2beta1_run_log_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_backend_api_v2beta1_run_log_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_api_v2beta1_run_log_proto_goTypes,
+		DependencyIndexes: file_backend_api_v2beta1_run_log_proto_depIdxs,
+		MessageInfos:      file_backend_api_v2beta1_run_log_proto_msgTypes,
+	}.Build()
+	File_backend_api_v2beta1_run_log_proto = out.File
+	file_backend_api_v2beta1_run_log_proto_rawDesc = nil
+	file_backend_api_v2beta1_run_log_proto_goTypes = nil
+	file_backend_api_v2beta1_run_log_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RunLogServiceClient is the client API for RunLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RunLogServiceClient interface {
+	// Streams the logs of the pods of a run as they are written, until the run
+	// finishes. The logs of the pods which already finished are sent first. Over
+	// HTTP, the stream is served as server-sent events by
+	// GET /apis/v2beta1/runs/{run_id}/logs:stream.
+	StreamRunLogs(ctx context.Context, in *StreamRunLogsRequest, opts ...grpc.CallOption) (RunLogService_StreamRunLogsClient, error)
+}
+
+type runLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRunLogServiceClient(cc grpc.ClientConnInterface) RunLogServiceClient {
+	return &runLogServiceClient{cc}
+}
+
+func (c *runLogServiceClient) StreamRunLogs(ctx context.Context, in *StreamRunLogsRequest, opts ...grpc.CallOption) (RunLogService_StreamRunLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RunLogService_serviceDesc.Streams[0], "/kubeflow.pipelines.backend.api.v2beta1.RunLogService/StreamRunLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runLogServiceStreamRunLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RunLogService_StreamRunLogsClient interface {
+	Recv() (*RunLogEntry, error)
+	grpc.ClientStream
+}
+
+type runLogServiceStreamRunLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *runLogServiceStreamRunLogsClient) Recv() (*RunLogEntry, error) {
+	m := new(RunLogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RunLogServiceServer is the server API for RunLogService service.
+type RunLogServiceServer interface {
+	// Streams the logs of the pods of a run as they are written, until the run
+	// finishes. The logs of the pods which already finished are sent first. Over
+	// HTTP, the stream is served as server-sent events by
+	// GET /apis/v2beta1/runs/{run_id}/logs:stream.
+	StreamRunLogs(*StreamRunLogsRequest, RunLogService_StreamRunLogsServer) error
+}
+
+// UnimplementedRunLogServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRunLogServiceServer struct {
+}
+
+func (*UnimplementedRunLogServiceServer) StreamRunLogs(*StreamRunLogsRequest, RunLogService_StreamRunLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRunLogs not implemented")
+}
+
+func RegisterRunLogServiceServer(s *grpc.Server, srv RunLogServiceServer) {
+	s.RegisterService(&_RunLogService_serviceDesc, srv)
+}
+
+func _RunLogService_StreamRunLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRunLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RunLogServiceServer).StreamRunLogs(m, &runLogServiceStreamRunLogsServer{stream})
+}
+
+type RunLogService_StreamRunLogsServer interface {
+	Send(*RunLogEntry) error
+	grpc.ServerStream
+}
+
+type runLogServiceStreamRunLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *runLogServiceStreamRunLogsServer) Send(m *RunLogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _RunLogService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubeflow.pipelines.backend.api.v2beta1.RunLogService",
+	HandlerType: (*RunLogServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRunLogs",
+			Handler:       _RunLogService_StreamRunLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "backend/api/v2beta1/run_log.proto",
+}
@@ -24,6 +24,34 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+BackfillRecurringRun creates the runs a recurring run would have triggered between a start and an end time
+*/
+func (a *Client) BackfillRecurringRun(params *BackfillRecurringRunParams) (*BackfillRecurringRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackfillRecurringRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BackfillRecurringRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/recurringruns/{recurring_run_id}:backfill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BackfillRecurringRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BackfillRecurringRunOK), nil
+
+}
+
 /*
 CreateRecurringRun creates a new recurring run in an experiment given the experiment ID
 */
@@ -192,6 +220,90 @@ func (a *Client) ListRecurringRuns(params *ListRecurringRunsParams) (*ListRecurr
 
 }
 
+/*
+PreviewRecurringRunTriggers returns the upcoming trigger times of a recurring run with a cron or periodic schedule
+*/
+func (a *Client) PreviewRecurringRunTriggers(params *PreviewRecurringRunTriggersParams) (*PreviewRecurringRunTriggersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewRecurringRunTriggersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PreviewRecurringRunTriggers",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/recurringruns/{recurring_run_id}:previewTriggers",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PreviewRecurringRunTriggersReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PreviewRecurringRunTriggersOK), nil
+
+}
+
+/*
+UpdateRecurringRun updates the fields of a recurring run in the update mask
+*/
+func (a *Client) UpdateRecurringRun(params *UpdateRecurringRunParams) (*UpdateRecurringRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateRecurringRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateRecurringRun",
+		Method:             "PATCH",
+		PathPattern:        "/apis/v2beta1/recurringruns/{recurring_run.recurring_run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateRecurringRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateRecurringRunOK), nil
+
+}
+
+/*
+ValidateTrigger validates a trigger and returns its first trigger times as if a recurring run was created with it now
+*/
+func (a *Client) ValidateTrigger(params *ValidateTriggerParams) (*ValidateTriggerOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewValidateTriggerParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ValidateTrigger",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/recurringruns:validateTrigger",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ValidateTriggerReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ValidateTriggerOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -28,6 +28,11 @@ type V2beta1CronSchedule struct {
 	// The start time of the cron job.
 	// Format: date-time
 	StartTime strfmt.DateTime `json:"start_time,omitempty"`
+
+	// The IANA time zone in which the cron string is evaluated, e.g.
+	// "America/New_York". Runs follow daylight-saving changes of the time zone.
+	// Defaults to the time zone of the scheduled workflow controller.
+	TimeZone string `json:"time_zone,omitempty"`
 }
 
 // Validate validates this v2beta1 cron schedule
@@ -17,6 +17,13 @@ import (
 // swagger:model v2beta1RecurringRun
 type V2beta1RecurringRun struct {
 
+	// concurrency policy
+	ConcurrencyPolicy RecurringRunConcurrencyPolicy `json:"concurrency_policy,omitempty"`
+
+	// Output. The number of consecutive failed runs of the recurring run. Reset
+	// to 0 when a run succeeds.
+	ConsecutiveFailures int64 `json:"consecutive_failures,omitempty,string"`
+
 	// Output. The time this recurring run was created.
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
@@ -35,6 +42,10 @@ type V2beta1RecurringRun struct {
 	// ID of the parent experiment this recurring run belongs to.
 	ExperimentID string `json:"experiment_id,omitempty"`
 
+	// Optional input field. Specifies when the recurring run is disabled because
+	// its runs keep failing.
+	FailurePolicy *V2beta1FailurePolicy `json:"failure_policy,omitempty"`
+
 	// Required input field.
 	// Specifies how many runs can be executed concurrently. Range [1-10].
 	MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`
@@ -52,6 +63,10 @@ type V2beta1RecurringRun struct {
 	// If false, the recurring run will catch up on each past interval.
 	NoCatchup bool `json:"no_catchup,omitempty"`
 
+	// The ID of a pipeline. Each run is created from the latest version of the
+	// pipeline at the time the run is triggered.
+	PipelineID string `json:"pipeline_id,omitempty"`
+
 	// The pipeline spec.
 	PipelineSpec interface{} `json:"pipeline_spec,omitempty"`
 
@@ -86,6 +101,10 @@ type V2beta1RecurringRun struct {
 func (m *V2beta1RecurringRun) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConcurrencyPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -94,6 +113,10 @@ func (m *V2beta1RecurringRun) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFailurePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -124,6 +147,22 @@ func (m *V2beta1RecurringRun) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1RecurringRun) validateConcurrencyPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ConcurrencyPolicy) { // not required
+		return nil
+	}
+
+	if err := m.ConcurrencyPolicy.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("concurrency_policy")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *V2beta1RecurringRun) validateCreatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CreatedAt) { // not required
@@ -155,6 +194,24 @@ func (m *V2beta1RecurringRun) validateError(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1RecurringRun) validateFailurePolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.FailurePolicy) { // not required
+		return nil
+	}
+
+	if m.FailurePolicy != nil {
+		if err := m.FailurePolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("failure_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2beta1RecurringRun) validateMode(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Mode) { // not required
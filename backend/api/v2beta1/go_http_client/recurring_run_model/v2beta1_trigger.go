@@ -13,14 +13,23 @@ import (
 )
 
 // V2beta1Trigger Trigger defines what starts a pipeline run.
+// The details of the event which started a run triggered by an object store
+// or a run completion are available to the run parameters through the
+// [[TriggerEvent.<key>]] macros, e.g. [[TriggerEvent.uri]] or [[TriggerEvent.run_id]].
 // swagger:model v2beta1Trigger
 type V2beta1Trigger struct {
 
 	// cron schedule
 	CronSchedule *V2beta1CronSchedule `json:"cron_schedule,omitempty"`
 
+	// object store trigger
+	ObjectStoreTrigger *V2beta1ObjectStoreTrigger `json:"object_store_trigger,omitempty"`
+
 	// periodic schedule
 	PeriodicSchedule *V2beta1PeriodicSchedule `json:"periodic_schedule,omitempty"`
+
+	// run completion trigger
+	RunCompletionTrigger *V2beta1RunCompletionTrigger `json:"run_completion_trigger,omitempty"`
 }
 
 // Validate validates this v2beta1 trigger
@@ -31,10 +40,18 @@ func (m *V2beta1Trigger) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectStoreTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePeriodicSchedule(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRunCompletionTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -59,6 +76,24 @@ func (m *V2beta1Trigger) validateCronSchedule(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1Trigger) validateObjectStoreTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ObjectStoreTrigger) { // not required
+		return nil
+	}
+
+	if m.ObjectStoreTrigger != nil {
+		if err := m.ObjectStoreTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("object_store_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2beta1Trigger) validatePeriodicSchedule(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.PeriodicSchedule) { // not required
@@ -77,6 +112,24 @@ func (m *V2beta1Trigger) validatePeriodicSchedule(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *V2beta1Trigger) validateRunCompletionTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RunCompletionTrigger) { // not required
+		return nil
+	}
+
+	if m.RunCompletionTrigger != nil {
+		if err := m.RunCompletionTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("run_completion_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *V2beta1Trigger) MarshalBinary() ([]byte, error) {
 	if m == nil {
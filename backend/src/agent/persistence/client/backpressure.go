@@ -0,0 +1,58 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"time"
+)
+
+// backpressure adapts the delay between the reports of batches of workflows to
+// the latency and the errors of the API server. The delay doubles when a batch
+// fails or is slower than the target latency, and halves otherwise, so the
+// reports slow down as soon as the API server struggles and recover gradually.
+type backpressure struct {
+	targetLatency time.Duration
+	minDelay      time.Duration
+	maxDelay      time.Duration
+	delay         time.Duration
+}
+
+func newBackpressure(targetLatency time.Duration, minDelay time.Duration, maxDelay time.Duration) *backpressure {
+	return &backpressure{
+		targetLatency: targetLatency,
+		minDelay:      minDelay,
+		maxDelay:      maxDelay,
+	}
+}
+
+// observe adapts the delay to the latency of the report of a batch, and whether
+// it failed.
+func (b *backpressure) observe(latency time.Duration, failed bool) {
+	if failed || latency > b.targetLatency {
+		b.delay *= 2
+		if b.delay < b.minDelay {
+			b.delay = b.minDelay
+		}
+		if b.delay > b.maxDelay {
+			b.delay = b.maxDelay
+		}
+	} else {
+		b.delay /= 2
+		if b.delay < b.minDelay {
+			b.delay = 0
+		}
+	}
+	reportBackpressureDelay.Set(b.delay.Seconds())
+}
@@ -33,8 +33,13 @@ const (
 	addressTemp = "%s:%s"
 )
 
+// ErrBatchReportsUnimplemented is returned by ReportWorkflows when the API
+// server does not support batch reports.
+var ErrBatchReportsUnimplemented = errors.New("the API server does not support batch reports")
+
 type PipelineClientInterface interface {
 	ReportWorkflow(workflow util.ExecutionSpec) error
+	ReportWorkflows(workflows []util.ExecutionSpec) ([]error, error)
 	ReportScheduledWorkflow(swf *util.ScheduledWorkflow) error
 	ReadArtifact(request *api.ReadArtifactRequest) (*api.ReadArtifactResponse, error)
 	ReportRunMetrics(request *api.ReportRunMetricsRequest) (*api.ReportRunMetricsResponse, error)
@@ -90,38 +95,90 @@ func (p *PipelineClient) ReportWorkflow(workflow util.ExecutionSpec) error {
 		Workflow: workflow.ToStringForStore(),
 	})
 
+	if err != nil {
+		return p.reportWorkflowError(err, workflow)
+	}
+	return nil
+}
+
+// ReportWorkflows reports a batch of workflows, and returns the errors of the
+// reports of the workflows, in the order of the batch. An error is returned
+// instead if the batch could not be reported, ErrBatchReportsUnimplemented if
+// the API server does not support batch reports.
+func (p *PipelineClient) ReportWorkflows(workflows []util.ExecutionSpec) ([]error, error) {
+	pctx := context.Background()
+	pctx = metadata.AppendToOutgoingContext(pctx, "Authorization",
+		"Bearer "+p.tokenRefresher.GetToken())
+
+	ctx, cancel := context.WithTimeout(pctx, time.Minute)
+	defer cancel()
+
+	request := &api.ReportWorkflowsRequest{Workflows: make([]string, len(workflows))}
+	for i, workflow := range workflows {
+		request.Workflows[i] = workflow.ToStringForStore()
+	}
+	response, err := p.reportServiceClient.ReportWorkflowsV1(ctx, request)
 	if err != nil {
 		statusCode, _ := status.FromError(err)
-		if statusCode.Code() == codes.InvalidArgument || statusCode.Code() == codes.NotFound {
-			// Do not retry if either:
-			// * there is something wrong with the workflow
-			// * the workflow has been deleted by someone else
-			return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT,
-				"Error while reporting workflow resource (code: %v, message: %v): %v, %+v",
-				statusCode.Code(),
-				statusCode.Message(),
-				err.Error(),
-				workflow.ToStringForStore())
-		} else if statusCode.Code() == codes.Unauthenticated && strings.Contains(err.Error(), "service account token has expired") {
+		if statusCode.Code() == codes.Unimplemented {
+			return nil, ErrBatchReportsUnimplemented
+		}
+		if statusCode.Code() == codes.Unauthenticated && strings.Contains(err.Error(), "service account token has expired") {
 			// If unauthenticated because SA token is expired, re-read/refresh the token and try again
 			p.tokenRefresher.RefreshToken()
-			return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
-				"Error while reporting workflow resource (code: %v, message: %v): %v, %+v",
-				statusCode.Code(),
-				statusCode.Message(),
-				err.Error(),
-				workflow.ToStringForStore())
-		} else {
-			// Retry otherwise
-			return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
-				"Error while reporting workflow resource (code: %v, message: %v): %v, %+v",
-				statusCode.Code(),
-				statusCode.Message(),
-				err.Error(),
-				workflow.ToStringForStore())
 		}
+		// The whole batch is retried.
+		return nil, util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
+			"Error while reporting a batch of %v workflows (code: %v, message: %v): %v",
+			len(workflows),
+			statusCode.Code(),
+			statusCode.Message(),
+			err.Error())
+	}
+	if len(response.GetResults()) != len(workflows) {
+		return nil, util.NewCustomError(errors.Errorf("got %v results", len(response.GetResults())), util.CUSTOM_CODE_TRANSIENT,
+			"Error while reporting a batch of %v workflows: got %v results", len(workflows), len(response.GetResults()))
+	}
+	errs := make([]error, len(workflows))
+	for i, result := range response.GetResults() {
+		if err := status.ErrorProto(result); err != nil {
+			errs[i] = p.reportWorkflowError(err, workflows[i])
+		}
+	}
+	return errs, nil
+}
+
+// reportWorkflowError classifies the error of the report of a workflow.
+func (p *PipelineClient) reportWorkflowError(err error, workflow util.ExecutionSpec) error {
+	statusCode, _ := status.FromError(err)
+	if statusCode.Code() == codes.InvalidArgument || statusCode.Code() == codes.NotFound {
+		// Do not retry if either:
+		// * there is something wrong with the workflow
+		// * the workflow has been deleted by someone else
+		return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT,
+			"Error while reporting workflow resource (code: %v, message: %v): %v, %+v",
+			statusCode.Code(),
+			statusCode.Message(),
+			err.Error(),
+			workflow.ToStringForStore())
+	} else if statusCode.Code() == codes.Unauthenticated && strings.Contains(err.Error(), "service account token has expired") {
+		// If unauthenticated because SA token is expired, re-read/refresh the token and try again
+		p.tokenRefresher.RefreshToken()
+		return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
+			"Error while reporting workflow resource (code: %v, message: %v): %v, %+v",
+			statusCode.Code(),
+			statusCode.Message(),
+			err.Error(),
+			workflow.ToStringForStore())
+	} else {
+		// Retry otherwise
+		return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
+			"Error while reporting workflow resource (code: %v, message: %v): %v, %+v",
+			statusCode.Code(),
+			statusCode.Message(),
+			err.Error(),
+			workflow.ToStringForStore())
 	}
-	return nil
 }
 
 func (p *PipelineClient) ReportScheduledWorkflow(swf *util.ScheduledWorkflow) error {
@@ -29,6 +29,9 @@ type PipelineClientFake struct {
 	reportedMetricsRequest    *api.ReportRunMetricsRequest
 	reportMetricsResponseStub *api.ReportRunMetricsResponse
 	reportMetricsErrorStub    error
+	workflowErrors            map[string]error
+	reportedBatchSizes        []int
+	batchReportsUnimplemented bool
 }
 
 func NewPipelineClientFake() *PipelineClientFake {
@@ -38,6 +41,7 @@ func NewPipelineClientFake() *PipelineClientFake {
 		err:                       nil,
 		artifacts:                 make(map[string]*api.ReadArtifactResponse),
 		reportMetricsResponseStub: &api.ReportRunMetricsResponse{},
+		workflowErrors:            make(map[string]error),
 	}
 }
 
@@ -49,6 +53,24 @@ func (p *PipelineClientFake) ReportWorkflow(workflow util.ExecutionSpec) error {
 	return nil
 }
 
+func (p *PipelineClientFake) ReportWorkflows(workflows []util.ExecutionSpec) ([]error, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.batchReportsUnimplemented {
+		return nil, ErrBatchReportsUnimplemented
+	}
+	p.reportedBatchSizes = append(p.reportedBatchSizes, len(workflows))
+	errs := make([]error, len(workflows))
+	for i, workflow := range workflows {
+		key := getKey(workflow.ExecutionNamespace(), workflow.ExecutionName())
+		if errs[i] = p.workflowErrors[key]; errs[i] == nil {
+			p.workflows[key] = workflow
+		}
+	}
+	return errs, nil
+}
+
 func (p *PipelineClientFake) ReportScheduledWorkflow(swf *util.ScheduledWorkflow) error {
 	if p.err != nil {
 		return p.err
@@ -74,6 +96,22 @@ func (p *PipelineClientFake) SetError(err error) {
 	p.err = err
 }
 
+// SetWorkflowError sets the error of the reports of a workflow in batches.
+func (p *PipelineClientFake) SetWorkflowError(namespace string, name string, err error) {
+	p.workflowErrors[getKey(namespace, name)] = err
+}
+
+// SetBatchReportsUnimplemented makes the reports of batches of workflows fail
+// as if the API server did not support them.
+func (p *PipelineClientFake) SetBatchReportsUnimplemented() {
+	p.batchReportsUnimplemented = true
+}
+
+// GetReportedBatchSizes returns the sizes of the reported batches of workflows.
+func (p *PipelineClientFake) GetReportedBatchSizes() []int {
+	return p.reportedBatchSizes
+}
+
 func (p *PipelineClientFake) GetWorkflow(namespace string, name string) util.ExecutionSpec {
 	return p.workflows[getKey(namespace, name)]
 }
@@ -0,0 +1,203 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	reportBatchSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "persistence_agent_report_batch_size",
+		Help:    "The number of workflows in the batches reported to the API server",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+	})
+
+	reportLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "persistence_agent_report_latency_seconds",
+		Help: "The latency of the reports of batches of workflows to the API server",
+	})
+
+	reportFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "persistence_agent_report_failures",
+		Help: "The total number of batches of workflows that failed to be reported",
+	})
+
+	reportWorkflowFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "persistence_agent_report_workflow_failures",
+		Help: "The total number of workflows that failed to be reported, in batches which were reported",
+	})
+
+	reportBackpressureDelay = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "persistence_agent_report_backpressure_delay_seconds",
+		Help: "The delay between the reports of batches of workflows, adapted to the latency and errors of the API server",
+	})
+)
+
+// ReportBatcherOptions configure a ReportBatcher.
+type ReportBatcherOptions struct {
+	// MaxBatchSize is the maximum number of workflows reported in a batch.
+	MaxBatchSize int
+	// BatchWindow is how long a batch waits for more workflows before it is
+	// reported, unless it is full.
+	BatchWindow time.Duration
+	// TargetLatency is the latency of the API server above which the reports
+	// slow down.
+	TargetLatency time.Duration
+	// MinDelay and MaxDelay bound the delay between batches when the reports
+	// slow down.
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+type workflowReport struct {
+	workflow util.ExecutionSpec
+	result   chan error
+}
+
+// ReportBatcher is a pipeline client that reports the workflows of concurrent
+// workers to the API server in batches. The workers wait for the report of
+// their workflow, so a slow API server slows down the workers and the updates
+// of the workflows pile up, coalesced, in their work queue.
+type ReportBatcher struct {
+	PipelineClientInterface
+	opts         ReportBatcherOptions
+	backpressure *backpressure
+	reports      chan *workflowReport
+	stopped      chan struct{}
+}
+
+func NewReportBatcher(pipelineClient PipelineClientInterface, opts ReportBatcherOptions) *ReportBatcher {
+	return &ReportBatcher{
+		PipelineClientInterface: pipelineClient,
+		opts:                    opts,
+		backpressure:            newBackpressure(opts.TargetLatency, opts.MinDelay, opts.MaxDelay),
+		reports:                 make(chan *workflowReport, opts.MaxBatchSize),
+		stopped:                 make(chan struct{}),
+	}
+}
+
+// ReportWorkflow reports a workflow in the next batch, and returns the error of
+// its report.
+func (b *ReportBatcher) ReportWorkflow(workflow util.ExecutionSpec) error {
+	report := &workflowReport{workflow: workflow, result: make(chan error, 1)}
+	select {
+	case b.reports <- report:
+	case <-b.stopped:
+		return b.stoppedError(workflow)
+	}
+	select {
+	case err := <-report.result:
+		return err
+	case <-b.stopped:
+		return b.stoppedError(workflow)
+	}
+}
+
+// Run reports the batches of workflows until stopCh is closed.
+func (b *ReportBatcher) Run(stopCh <-chan struct{}) {
+	defer close(b.stopped)
+	for {
+		select {
+		case <-stopCh:
+			return
+		case report := <-b.reports:
+			batch, ok := b.collect(report, stopCh)
+			if !ok {
+				return
+			}
+			b.report(batch)
+		}
+	}
+}
+
+// collect collects the workflows of a batch until the batch window and the
+// backpressure delay elapsed, or the batch is full and the delay elapsed.
+// Returns false if stopCh was closed.
+func (b *ReportBatcher) collect(first *workflowReport, stopCh <-chan struct{}) ([]*workflowReport, bool) {
+	batch := []*workflowReport{first}
+	window := time.NewTimer(b.opts.BatchWindow)
+	defer window.Stop()
+	delay := time.NewTimer(b.backpressure.delay)
+	defer delay.Stop()
+	windowC, delayC := window.C, delay.C
+	for delayC != nil || (windowC != nil && len(batch) < b.opts.MaxBatchSize) {
+		reports := b.reports
+		if len(batch) >= b.opts.MaxBatchSize {
+			reports = nil
+		}
+		select {
+		case <-stopCh:
+			return nil, false
+		case report := <-reports:
+			batch = append(batch, report)
+		case <-windowC:
+			windowC = nil
+		case <-delayC:
+			delayC = nil
+		}
+	}
+	return batch, true
+}
+
+// report reports a batch of workflows, and adapts the backpressure to the
+// latency and the errors of the API server. The errors of the reports of single
+// workflows, e.g. invalid workflows, do not slow down the reports.
+func (b *ReportBatcher) report(batch []*workflowReport) {
+	workflows := make([]util.ExecutionSpec, len(batch))
+	for i, report := range batch {
+		workflows[i] = report.workflow
+	}
+	start := time.Now()
+	errs, err := b.PipelineClientInterface.ReportWorkflows(workflows)
+	latency := time.Since(start)
+	if err == ErrBatchReportsUnimplemented {
+		// The workflows are sent one at a time, the latency is not the one of
+		// a batch.
+		for _, report := range batch {
+			report.result <- b.PipelineClientInterface.ReportWorkflow(report.workflow)
+		}
+		return
+	}
+	reportBatchSize.Observe(float64(len(batch)))
+	reportLatency.Observe(latency.Seconds())
+	if err != nil {
+		reportFailures.Inc()
+		log.Errorf("Failed to report a batch of %v workflows: %v", len(batch), err)
+	}
+	b.backpressure.observe(latency, err != nil)
+
+	for i, report := range batch {
+		if err != nil {
+			report.result <- err
+			continue
+		}
+		if errs[i] != nil {
+			reportWorkflowFailures.Inc()
+		}
+		report.result <- errs[i]
+	}
+}
+
+func (b *ReportBatcher) stoppedError(workflow util.ExecutionSpec) error {
+	return util.NewCustomError(errors.New("the report batcher is stopped"), util.CUSTOM_CODE_TRANSIENT,
+		"Failed to report workflow (%v): the report batcher is stopped", workflow.ExecutionName())
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestWorkflow(name string) util.ExecutionSpec {
+	return util.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "MY_NAMESPACE", Name: name},
+	})
+}
+
+// reportWorkflows reports workflows concurrently, and returns their errors.
+func reportWorkflows(batcher *ReportBatcher, names ...string) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[string]error)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			err := batcher.ReportWorkflow(newTestWorkflow(name))
+			mu.Lock()
+			defer mu.Unlock()
+			errs[name] = err
+		}(name)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestReportBatcher_FullBatch(t *testing.T) {
+	pipelineFake := NewPipelineClientFake()
+	pipelineFake.SetWorkflowError("MY_NAMESPACE", "MY_NAME_2", util.NewCustomErrorf(util.CUSTOM_CODE_PERMANENT, "invalid workflow"))
+	// The batch is reported once it is full, long before the end of its window.
+	batcher := NewReportBatcher(pipelineFake, ReportBatcherOptions{MaxBatchSize: 3, BatchWindow: time.Hour})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	errs := reportWorkflows(batcher, "MY_NAME_1", "MY_NAME_2", "MY_NAME_3")
+
+	assert.Nil(t, errs["MY_NAME_1"])
+	assert.True(t, util.HasCustomCode(errs["MY_NAME_2"], util.CUSTOM_CODE_PERMANENT))
+	assert.Nil(t, errs["MY_NAME_3"])
+	assert.Equal(t, []int{3}, pipelineFake.GetReportedBatchSizes())
+	assert.NotNil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME_1"))
+	assert.Nil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME_2"))
+}
+
+func TestReportBatcher_BatchWindow(t *testing.T) {
+	pipelineFake := NewPipelineClientFake()
+	batcher := NewReportBatcher(pipelineFake, ReportBatcherOptions{MaxBatchSize: 10, BatchWindow: 10 * time.Millisecond})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	assert.Nil(t, batcher.ReportWorkflow(newTestWorkflow("MY_NAME_1")))
+	assert.Nil(t, batcher.ReportWorkflow(newTestWorkflow("MY_NAME_2")))
+	assert.Equal(t, []int{1, 1}, pipelineFake.GetReportedBatchSizes())
+}
+
+func TestReportBatcher_BatchFailed(t *testing.T) {
+	pipelineFake := NewPipelineClientFake()
+	pipelineFake.SetError(util.NewCustomErrorf(util.CUSTOM_CODE_TRANSIENT, "unavailable"))
+	batcher := NewReportBatcher(pipelineFake, ReportBatcherOptions{
+		MaxBatchSize:  2,
+		BatchWindow:   time.Hour,
+		TargetLatency: time.Second,
+		MinDelay:      time.Millisecond,
+		MaxDelay:      time.Second,
+	})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	errs := reportWorkflows(batcher, "MY_NAME_1", "MY_NAME_2")
+
+	for name, err := range errs {
+		assert.True(t, util.HasCustomCode(err, util.CUSTOM_CODE_TRANSIENT), name)
+	}
+	// The failure slows down the next reports.
+	assert.Equal(t, time.Millisecond, batcher.backpressure.delay)
+}
+
+func TestReportBatcher_WorkflowFailed(t *testing.T) {
+	pipelineFake := NewPipelineClientFake()
+	pipelineFake.SetWorkflowError("MY_NAMESPACE", "MY_NAME_1", util.NewCustomErrorf(util.CUSTOM_CODE_TRANSIENT, "conflict"))
+	batcher := NewReportBatcher(pipelineFake, ReportBatcherOptions{
+		MaxBatchSize:  2,
+		BatchWindow:   time.Hour,
+		TargetLatency: time.Second,
+		MinDelay:      time.Millisecond,
+		MaxDelay:      time.Second,
+	})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	errs := reportWorkflows(batcher, "MY_NAME_1", "MY_NAME_2")
+
+	assert.True(t, util.HasCustomCode(errs["MY_NAME_1"], util.CUSTOM_CODE_TRANSIENT))
+	assert.Nil(t, errs["MY_NAME_2"])
+	// The failure of a single workflow does not slow down the next reports.
+	assert.Equal(t, time.Duration(0), batcher.backpressure.delay)
+}
+
+func TestReportBatcher_BatchReportsUnimplemented(t *testing.T) {
+	pipelineFake := NewPipelineClientFake()
+	pipelineFake.SetBatchReportsUnimplemented()
+	batcher := NewReportBatcher(pipelineFake, ReportBatcherOptions{
+		MaxBatchSize:  2,
+		BatchWindow:   time.Hour,
+		TargetLatency: 0,
+		MinDelay:      time.Millisecond,
+		MaxDelay:      time.Second,
+	})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	errs := reportWorkflows(batcher, "MY_NAME_1", "MY_NAME_2")
+
+	// The workflows are reported one at a time, and their latency does not
+	// slow down the next reports.
+	assert.Nil(t, errs["MY_NAME_1"])
+	assert.Nil(t, errs["MY_NAME_2"])
+	assert.NotNil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME_1"))
+	assert.NotNil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME_2"))
+	assert.Empty(t, pipelineFake.GetReportedBatchSizes())
+	assert.Equal(t, time.Duration(0), batcher.backpressure.delay)
+}
+
+func TestReportBatcher_Stopped(t *testing.T) {
+	batcher := NewReportBatcher(NewPipelineClientFake(), ReportBatcherOptions{MaxBatchSize: 10, BatchWindow: time.Hour})
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		batcher.Run(stopCh)
+	}()
+
+	errs := make(chan error)
+	go func() {
+		errs <- batcher.ReportWorkflow(newTestWorkflow("MY_NAME"))
+	}()
+	close(stopCh)
+	<-done
+
+	assert.True(t, util.HasCustomCode(<-errs, util.CUSTOM_CODE_TRANSIENT))
+	assert.True(t, util.HasCustomCode(batcher.ReportWorkflow(newTestWorkflow("MY_NAME")), util.CUSTOM_CODE_TRANSIENT))
+}
+
+func TestBackpressure(t *testing.T) {
+	b := newBackpressure(time.Second, 100*time.Millisecond, time.Second)
+	steps := []struct {
+		latency time.Duration
+		failed  bool
+		delay   time.Duration
+	}{
+		{100 * time.Millisecond, false, 0},
+		{100 * time.Millisecond, true, 100 * time.Millisecond},
+		{2 * time.Second, false, 200 * time.Millisecond},
+		{2 * time.Second, false, 400 * time.Millisecond},
+		{100 * time.Millisecond, true, 800 * time.Millisecond},
+		{100 * time.Millisecond, true, time.Second},
+		{100 * time.Millisecond, false, 500 * time.Millisecond},
+		{100 * time.Millisecond, false, 250 * time.Millisecond},
+		{100 * time.Millisecond, false, 125 * time.Millisecond},
+		{100 * time.Millisecond, false, 0},
+	}
+	for i, step := range steps {
+		b.observe(step.latency, step.failed)
+		assert.Equal(t, step.delay, b.delay, fmt.Sprintf("step %d", i))
+	}
+}
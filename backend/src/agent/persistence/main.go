@@ -16,6 +16,7 @@ package main
 
 import (
 	"flag"
+	"net/http"
 	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
@@ -23,6 +24,7 @@ import (
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/signals"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/clientcmd"
@@ -44,6 +46,12 @@ var (
 	clientQPS                     float64
 	clientBurst                   int
 	saTokenRefreshIntervalInSecs  int64
+	reportBatchSize               int
+	reportBatchWindow             time.Duration
+	reportTargetLatency           time.Duration
+	reportMaxDelay                time.Duration
+	workflowCoalesceWindow        time.Duration
+	metricsAddress                string
 )
 
 const (
@@ -61,11 +69,18 @@ const (
 	clientQPSFlagName                     = "clientQPS"
 	clientBurstFlagName                   = "clientBurst"
 	saTokenRefreshIntervalFlagName        = "saTokenRefreshIntervalInSecs"
+	reportBatchSizeFlagName               = "reportBatchSize"
+	reportBatchWindowFlagName             = "reportBatchWindow"
+	reportTargetLatencyFlagName           = "reportTargetLatency"
+	reportMaxDelayFlagName                = "reportMaxDelay"
+	workflowCoalesceWindowFlagName        = "workflowCoalesceWindow"
+	metricsAddressFlagName                = "metricsAddress"
 )
 
 const (
 	DefaultConnectionTimeout              = 6 * time.Minute
 	DefaultSATokenRefresherIntervalInSecs = 60 * 60 // 1 Hour in seconds
+	DefaultReportMinDelay                 = 100 * time.Millisecond
 )
 
 func main() {
@@ -120,6 +135,14 @@ func main() {
 		pipelineClient,
 		util.NewRealTime())
 
+	if metricsAddress != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			log.Fatal(http.ListenAndServe(metricsAddress, mux))
+		}()
+	}
+
 	go swfInformerFactory.Start(stopCh)
 	go execInformer.InformerFactoryStart(stopCh)
 
@@ -148,5 +171,11 @@ func init() {
 	// TODO use viper/config file instead. Sync `saTokenRefreshIntervalFlagName` with the value from manifest file by using ENV var.
 	flag.Int64Var(&saTokenRefreshIntervalInSecs, saTokenRefreshIntervalFlagName, DefaultSATokenRefresherIntervalInSecs, "Persistence agent service account token read interval in seconds. "+
 		"Defines how often `/var/run/secrets/kubeflow/tokens/kubeflow-persistent_agent-api-token` to be read")
+	flag.IntVar(&reportBatchSize, reportBatchSizeFlagName, 20, "The maximum number of workflows reported to the ML pipeline API server in a batch. 1 reports the workflows one at a time.")
+	flag.DurationVar(&reportBatchWindow, reportBatchWindowFlagName, 500*time.Millisecond, "Duration a batch of workflows waits for more workflows before it is reported, unless it is full.")
+	flag.DurationVar(&reportTargetLatency, reportTargetLatencyFlagName, 2*time.Second, "Latency of the ML pipeline API server above which the reports of workflows slow down.")
+	flag.DurationVar(&reportMaxDelay, reportMaxDelayFlagName, time.Minute, "Maximum delay between the reports of batches of workflows when the ML pipeline API server is slow or failing.")
+	flag.DurationVar(&workflowCoalesceWindow, workflowCoalesceWindowFlagName, time.Second, "Duration within which the updates of a workflow are reported once. 0 reports each update.")
+	flag.StringVar(&metricsAddress, metricsAddressFlagName, ":9090", "Address on which the Prometheus metrics are served. Empty disables the metrics endpoint.")
 
 }
@@ -39,6 +39,8 @@ type PersistenceAgent struct {
 	workflowClient *client.WorkflowClient
 	swfWorker      *worker.PersistenceWorker
 	workflowWorker *worker.PersistenceWorker
+	// Reports the workflows in batches, unless it is nil.
+	reportBatcher *client.ReportBatcher
 }
 
 // NewPersistenceAgent returns a new persistence agent.
@@ -57,18 +59,31 @@ func NewPersistenceAgent(
 	swfClient := client.NewScheduledWorkflowClient(swfInformer)
 	workflowClient := client.NewWorkflowClient(execInformer)
 
-	swfWorker := worker.NewPersistenceWorker(time, swfregister.Kind, swfInformer.Informer(), true,
+	swfWorker := worker.NewPersistenceWorker(time, swfregister.Kind, swfInformer.Informer(), true, 0,
 		worker.NewScheduledWorkflowSaver(swfClient, pipelineClient))
 
+	var reportBatcher *client.ReportBatcher
+	var workflowPipelineClient client.PipelineClientInterface = pipelineClient
+	if reportBatchSize > 1 {
+		reportBatcher = client.NewReportBatcher(pipelineClient, client.ReportBatcherOptions{
+			MaxBatchSize:  reportBatchSize,
+			BatchWindow:   reportBatchWindow,
+			TargetLatency: reportTargetLatency,
+			MinDelay:      DefaultReportMinDelay,
+			MaxDelay:      reportMaxDelay,
+		})
+		workflowPipelineClient = reportBatcher
+	}
 	workflowWorker := worker.NewPersistenceWorker(time, workflowregister.WorkflowKind,
-		execInformer, true,
-		worker.NewWorkflowSaver(workflowClient, pipelineClient, ttlSecondsAfterWorkflowFinish))
+		execInformer, true, workflowCoalesceWindow,
+		worker.NewWorkflowSaver(workflowClient, workflowPipelineClient, ttlSecondsAfterWorkflowFinish))
 
 	agent := &PersistenceAgent{
 		swfClient:      swfClient,
 		workflowClient: workflowClient,
 		swfWorker:      swfWorker,
 		workflowWorker: workflowWorker,
+		reportBatcher:  reportBatcher,
 	}
 
 	log.Info("Setting up event handlers")
@@ -99,8 +114,19 @@ func (p *PersistenceAgent) Run(threadiness int, stopCh <-chan struct{}) error {
 
 	// Launch multiple workers to process ScheduledWorkflows
 	log.Info("Starting workers")
+	workflowThreadiness := threadiness
+	if p.reportBatcher != nil {
+		go p.reportBatcher.Run(stopCh)
+		// The workflow workers wait for the reports of their workflows, so a
+		// batch is only full with a worker per workflow of the batch.
+		if workflowThreadiness < reportBatchSize {
+			workflowThreadiness = reportBatchSize
+		}
+	}
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(p.swfWorker.RunWorker, time.Second, stopCh)
+	}
+	for i := 0; i < workflowThreadiness; i++ {
 		go wait.Until(p.workflowWorker.RunWorker, time.Second, stopCh)
 	}
 	log.Info("Started workers")
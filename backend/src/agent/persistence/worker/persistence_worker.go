@@ -20,6 +20,8 @@ import (
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	errorutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -34,6 +36,11 @@ var (
 	MaxJobBackOff = 360 * time.Second
 )
 
+var queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "persistence_agent_queue_depth",
+	Help: "The number of objects waiting to be persisted, by work queue",
+}, []string{"queue"})
+
 type Saver interface {
 	Save(key string, namespace string, name string, nowEpoch int64) error
 }
@@ -53,7 +60,11 @@ type PersistenceWorker struct {
 	// An interface to generate the current time.
 	time                 util.TimeInterface
 	enforceRequeueDelays bool
-	saver                Saver
+	// The updates of an object within the coalesce window are persisted once,
+	// at the end of the window. Zero persists each update.
+	coalesceWindow time.Duration
+	saver          Saver
+	queueDepth     prometheus.Gauge
 }
 
 // NewPersistenceWorker returns a new PersistenceWorker
@@ -62,13 +73,16 @@ func NewPersistenceWorker(
 	name string,
 	eventHandler util.ExecutionInformerEventHandler,
 	enforceRequeueDelays bool,
+	coalesceWindow time.Duration,
 	saver Saver) *PersistenceWorker {
 	worker := &PersistenceWorker{
 		workqueue: workqueue.NewNamedRateLimitingQueue(
 			workqueue.NewItemExponentialFailureRateLimiter(DefaultJobBackOff, MaxJobBackOff), name),
 		time:                 time,
 		enforceRequeueDelays: enforceRequeueDelays,
+		coalesceWindow:       coalesceWindow,
 		saver:                saver,
+		queueDepth:           queueDepth.WithLabelValues(name),
 	}
 
 	log.Info("Setting up event handlers")
@@ -77,7 +91,7 @@ func NewPersistenceWorker(
 	eventHandler.AddEventHandler(&cache.ResourceEventHandlerFuncs{
 		AddFunc: worker.enqueue,
 		UpdateFunc: func(old, new interface{}) {
-			worker.enqueue(new)
+			worker.enqueueUpdate(new)
 		},
 		DeleteFunc: worker.enqueueForDelete,
 	})
@@ -115,6 +129,24 @@ func (p *PersistenceWorker) enqueue(obj interface{}) {
 	} else {
 		p.workqueue.Add(key) // For testing.
 	}
+	p.queueDepth.Set(float64(p.workqueue.Len()))
+}
+
+// enqueueUpdate enqueues an updated object at the end of the coalesce window.
+// The work queue keeps the earliest time of an object waiting to be enqueued,
+// and enqueues an object at most once, so the updates of an object within the
+// window are persisted once.
+func (p *PersistenceWorker) enqueueUpdate(obj interface{}) {
+	if p.coalesceWindow <= 0 {
+		p.enqueue(obj)
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("Equeuing object: error: %v: %+v", err, obj))
+		return
+	}
+	p.workqueue.AddAfter(key, p.coalesceWindow)
 }
 
 func (p *PersistenceWorker) enqueueForDelete(obj interface{}) {
@@ -128,6 +160,7 @@ func (p *PersistenceWorker) enqueueForDelete(obj interface{}) {
 // attempt to process it, by calling the syncHandler.
 func (p *PersistenceWorker) processNextWorkItem() bool {
 	obj, shutdown := p.workqueue.Get()
+	p.queueDepth.Set(float64(p.workqueue.Len()))
 
 	if shutdown {
 		return false
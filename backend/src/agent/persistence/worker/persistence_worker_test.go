@@ -17,6 +17,7 @@ package worker
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	client "github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
@@ -62,6 +63,7 @@ func TestPersistenceWorker_Success(t *testing.T) {
 		"PERSISTENCE_WORKER",
 		eventHandler,
 		false,
+		0,
 		saver)
 
 	// Test
@@ -92,6 +94,7 @@ func TestPersistenceWorker_NotFoundError(t *testing.T) {
 		"PERSISTENCE_WORKER",
 		eventHandler,
 		false,
+		0,
 		saver)
 
 	// Test
@@ -123,6 +126,7 @@ func TestPersistenceWorker_GetWorklowError(t *testing.T) {
 		"PERSISTENCE_WORKER",
 		eventHandler,
 		false,
+		0,
 		saver)
 
 	// Test
@@ -157,6 +161,7 @@ func TestPersistenceWorker_ReportWorkflowRetryableError(t *testing.T) {
 		"PERSISTENCE_WORKER",
 		eventHandler,
 		false,
+		0,
 		saver)
 
 	// Test
@@ -190,6 +195,7 @@ func TestPersistenceWorker_ReportWorkflowNonRetryableError(t *testing.T) {
 		"PERSISTENCE_WORKER",
 		eventHandler,
 		false,
+		0,
 		saver)
 
 	// Test
@@ -198,3 +204,41 @@ func TestPersistenceWorker_ReportWorkflowNonRetryableError(t *testing.T) {
 	assert.Nil(t, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
 	assert.Equal(t, 0, worker.Len())
 }
+
+func TestPersistenceWorker_CoalescedUpdates(t *testing.T) {
+	// Set up workflow client
+	workflow := util.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      "MY_NAME",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+		},
+	})
+	workflowClient := client.NewWorkflowClientFake()
+	workflowClient.Put("MY_NAMESPACE", "MY_NAME", workflow)
+
+	// Set up pipeline client
+	pipelineClient := client.NewPipelineClientFake()
+
+	// Set up peristence worker
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100)
+	eventHandler := NewFakeEventHandler()
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"PERSISTENCE_WORKER",
+		eventHandler,
+		false,
+		50*time.Millisecond,
+		saver)
+
+	// Test
+	for i := 0; i < 3; i++ {
+		eventHandler.handler.OnUpdate(workflow, workflow)
+	}
+	// The updates wait for the end of the coalesce window, and are enqueued once.
+	assert.Equal(t, 0, worker.Len())
+	assert.Eventually(t, func() bool { return worker.Len() == 1 }, 5*time.Second, 10*time.Millisecond)
+	worker.processNextWorkItem()
+	assert.Equal(t, workflow, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
+	assert.Equal(t, 0, worker.Len())
+}
@@ -15,20 +15,33 @@
 package worker
 
 import (
+	"sync"
 	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	log "github.com/sirupsen/logrus"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 )
 
+var skippedWorkflowReports = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "persistence_agent_skipped_workflow_reports",
+	Help: "The total number of reports of workflows skipped because their status did not change",
+})
+
 // WorkflowSaver provides a function to persist a workflow to a database.
 type WorkflowSaver struct {
 	client                        client.WorkflowClientInterface
 	pipelineClient                client.PipelineClientInterface
 	metricsReporter               *MetricsReporter
 	ttlSecondsAfterWorkflowFinish int64
+
+	// The status hashes of the last reports of the workflows, by key, to skip
+	// the reports of workflows whose status did not change.
+	mu             sync.Mutex
+	reportedHashes map[string]string
 }
 
 func NewWorkflowSaver(client client.WorkflowClientInterface,
@@ -38,6 +51,7 @@ func NewWorkflowSaver(client client.WorkflowClientInterface,
 		pipelineClient:                pipelineClient,
 		metricsReporter:               NewMetricsReporter(pipelineClient),
 		ttlSecondsAfterWorkflowFinish: ttlSecondsAfterWorkflowFinish,
+		reportedHashes:                make(map[string]string),
 	}
 }
 
@@ -46,6 +60,7 @@ func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch
 	wf, err := s.client.Get(namespace, name)
 	isNotFound := util.HasCustomCode(err, util.CUSTOM_CODE_NOT_FOUND)
 	if err != nil && isNotFound {
+		s.setReportedHash(key, "")
 		// Permanent failure.
 		// The Workflow may no longer exist, we stop processing and do not retry.
 		return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT,
@@ -68,6 +83,16 @@ func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch
 		return nil
 	}
 
+	hash := wf.StatusHash()
+	if !wf.PersistedFinalState() && hash != "" && hash == s.getReportedHash(key) {
+		// Skip reporting the workflow if its status did not change since its
+		// last report, like on the resyncs of the informer. Workflows past
+		// their TTL are still reported, to be garbage collected.
+		log.Infof("Skip syncing Workflow (%v): status unchanged since the last report.", name)
+		skippedWorkflowReports.Inc()
+		return nil
+	}
+
 	// Save this Workflow to the database.
 	err = s.pipelineClient.ReportWorkflow(wf)
 	retry := util.HasCustomCode(err, util.CUSTOM_CODE_TRANSIENT)
@@ -84,8 +109,27 @@ func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch
 	}
 
 	// Success
+	s.setReportedHash(key, hash)
 	log.WithFields(log.Fields{
 		"Workflow": name,
 	}).Infof("Syncing Workflow (%v): success, processing complete.", name)
 	return s.metricsReporter.ReportMetrics(wf)
 }
+
+func (s *WorkflowSaver) getReportedHash(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reportedHashes[key]
+}
+
+// setReportedHash records the status hash of the last report of a workflow, or
+// forgets the workflow if the hash is empty.
+func (s *WorkflowSaver) setReportedHash(key string, hash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if hash == "" {
+		delete(s.reportedHashes, key)
+	} else {
+		s.reportedHashes[key] = hash
+	}
+}
@@ -218,3 +218,40 @@ func TestWorkflow_Save_SkippedDDueToMissingRunID(t *testing.T) {
 	assert.Equal(t, false, util.HasCustomCode(err, util.CUSTOM_CODE_TRANSIENT))
 	assert.Equal(t, nil, err)
 }
+
+func TestWorkflow_Save_SkippedDueToUnchangedStatus(t *testing.T) {
+	workflowFake := client.NewWorkflowClientFake()
+	pipelineFake := client.NewPipelineClientFake()
+
+	workflow := util.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "MY_NAMESPACE",
+			Name:            "MY_NAME",
+			ResourceVersion: "1",
+			Labels:          map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+		},
+		Status: workflowapi.WorkflowStatus{Phase: workflowapi.WorkflowRunning},
+	})
+
+	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
+
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+
+	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
+	assert.Nil(t, err)
+
+	// Add this will result in failure unless reporting is skipped
+	pipelineFake.SetError(util.NewCustomError(fmt.Errorf("Error"), util.CUSTOM_CODE_PERMANENT,
+		"My Permanent Error"))
+
+	// The status of the new version of the workflow did not change.
+	workflow.SetVersion("2")
+	err = saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
+	assert.Nil(t, err)
+
+	// The status of the workflow changed.
+	workflow.Status.Phase = workflowapi.WorkflowSucceeded
+	err = saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "permanent failure")
+}
@@ -19,6 +19,7 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -42,11 +43,24 @@ type ExtractLogOptions struct {
 	Timestamps bool
 }
 
+// LogArchiveInterface reads the logs of the nodes whose pods are gone. The logs
+// are archived by Argo in the object store, or shipped to an external log store,
+// depending on the configured backend.
 type LogArchiveInterface interface {
+	// GetLogObjectKey returns the key of the archived log of a node in the object
+	// store, or "" if the backend does not archive logs in the object store.
 	GetLogObjectKey(workflow util.ExecutionSpec, nodeId string) (string, error)
+	// ReadLog returns the archived log of a node, in any format read by
+	// CopyLogFromArchive and ReadArchivedLogEntries.
+	ReadLog(ctx context.Context, workflow util.ExecutionSpec, nodeId string) ([]byte, error)
 	CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error
 }
 
+// ObjectStore reads the files of the object store in which Argo archives logs.
+type ObjectStore interface {
+	GetFile(filePath string) ([]byte, error)
+}
+
 // Log Archive.
 type RunLogEntry struct {
 	Log       string    `json:"log"`
@@ -237,13 +251,16 @@ var (
 	crioLogPrefixExp = regexp.MustCompile(`(?m)^(.+)\s(stdout|stderr)\s\w\s(.+)$`)
 )
 
+// LogArchive reads the logs archived by Argo as artifacts in the object store.
 type LogArchive struct {
+	objectStore   ObjectStore
 	logFileName   string
 	logPathPrefix string
 }
 
-func NewLogArchive(logPathPrefix, logFileName string) *LogArchive {
+func NewLogArchive(objectStore ObjectStore, logPathPrefix, logFileName string) *LogArchive {
 	return &LogArchive{
+		objectStore:   objectStore,
 		logFileName:   logFileName,
 		logPathPrefix: logPathPrefix,
 	}
@@ -257,8 +274,20 @@ func (a *LogArchive) GetLogObjectKey(workflow util.ExecutionSpec, nodeID string)
 	}
 }
 
+func (a *LogArchive) ReadLog(ctx context.Context, workflow util.ExecutionSpec, nodeID string) ([]byte, error) {
+	key, err := a.GetLogObjectKey(workflow, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	return a.objectStore.GetFile(key)
+}
+
 // CopyLogFromArchive copies a task run archived log into expected format.
 func (a *LogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error {
+	return copyLogFromArchive(logContent, dst, opts)
+}
+
+func copyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error {
 	reader, err := decompressLogArchive(logContent)
 	if err != nil {
 		return err
@@ -296,6 +325,56 @@ func (a *LogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts E
 	return nil
 }
 
+// The maximum length of the log lines read by ScanLogEntries.
+const maxLogLineLength = 1 << 20
+
+// ParseLogLine parses a line of a pod log or of an archived log, which is either
+// a JSON log entry, or a line with a CRI-O or Kubernetes timestamp prefix.
+func ParseLogLine(line []byte) RunLogEntry {
+	var entry RunLogEntry
+	if json.Unmarshal(line, &entry) == nil {
+		return entry
+	}
+	if result := crioLogPrefixExp.FindSubmatch(line); len(result) == 4 {
+		entry.Log = string(result[3])
+		entry.Timestamp, _ = time.Parse(time.RFC3339, string(result[1]))
+	} else if result := k8sLogPrefixExp.FindSubmatch(line); len(result) == 3 {
+		entry.Log = string(result[2])
+		entry.Timestamp, _ = time.Parse(time.RFC3339, string(result[1]))
+	} else {
+		entry.Log = string(line)
+	}
+	return entry
+}
+
+// ScanLogEntries calls fn with the entries of a log, skipping the empty lines.
+func ScanLogEntries(reader io.Reader, fn func(entry RunLogEntry) error) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineLength)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := fn(ParseLogLine(scanner.Bytes())); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return util.NewInternalServerError(err, "error in reading the log lines")
+	}
+	return nil
+}
+
+// ReadArchivedLogEntries calls fn with the entries of an archived log, which may
+// be compressed.
+func ReadArchivedLogEntries(logContent []byte, fn func(entry RunLogEntry) error) error {
+	reader, err := decompressLogArchive(logContent)
+	if err != nil {
+		return err
+	}
+	return ScanLogEntries(reader, fn)
+}
+
 func decompressLogArchive(logContent []byte) (io.Reader, error) {
 	// Decompress tar archive
 	compressedReader := bytes.NewReader(logContent)
@@ -0,0 +1,170 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// The timeout of the requests to external log stores.
+	logStoreRequestTimeout = 30 * time.Second
+	// The margin added around the lifetime of a node when querying its logs, as
+	// log collectors timestamp the lines independently of the pod status.
+	logTimeWindowMargin = time.Minute
+	// The maximum size of the logs read from external log stores.
+	maxLogStoreResponseSize = 64 << 20
+)
+
+// logQuery identifies the log of a node in an external log store. It is the data
+// of the URL and query templates.
+type logQuery struct {
+	Namespace    string
+	WorkflowName string
+	NodeId       string
+	PodName      string
+	StartTime    time.Time
+	EndTime      time.Time
+}
+
+func newLogQuery(workflow util.ExecutionSpec, nodeId string) (*logQuery, error) {
+	if workflow == nil {
+		return nil, util.NewInvalidInputError("Runtime workflow manifest cannot be empty")
+	}
+	node, ok := workflow.ExecutionStatus().NodeStatuses()[nodeId]
+	if !ok {
+		return nil, util.NewResourceNotFoundError("node", nodeId)
+	}
+	query := &logQuery{
+		Namespace:    workflow.ExecutionNamespace(),
+		WorkflowName: workflow.ExecutionName(),
+		NodeId:       nodeId,
+		// The pods of Argo nodes are named after the node IDs.
+		PodName: nodeId,
+		EndTime: time.Now().UTC(),
+	}
+	if node.StartTime > 0 {
+		query.StartTime = time.Unix(node.StartTime, 0).UTC().Add(-logTimeWindowMargin)
+	}
+	if node.FinishTime > 0 {
+		query.EndTime = time.Unix(node.FinishTime, 0).UTC().Add(logTimeWindowMargin)
+	}
+	return query, nil
+}
+
+func executeTemplate(tmpl *template.Template, query *logQuery) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, query); err != nil {
+		return "", util.Wrapf(err, "failed to execute the template %s", tmpl.Name())
+	}
+	return buf.String(), nil
+}
+
+// getLogStoreResponse sends a GET request to an external log store and returns
+// the response body.
+func getLogStoreResponse(ctx context.Context, client *http.Client, url string, headers map[string]string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create the request to the log store")
+	}
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, util.NewUnavailableServerError(err, "Failed to query the log store")
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, maxLogStoreResponseSize+1))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to read the response of the log store")
+	}
+	if len(body) > maxLogStoreResponseSize {
+		return nil, util.NewInternalServerError(errors.Errorf("the log exceeds %d bytes", maxLogStoreResponseSize), "Failed to read the response of the log store")
+	}
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, util.NewNotFoundError(errors.Errorf("%s: %s", response.Status, truncate(body)), "Log not found in the log store")
+	case response.StatusCode != http.StatusOK:
+		return nil, util.NewInternalServerError(errors.Errorf("%s: %s", response.Status, truncate(body)), "The log store failed to return the log")
+	}
+	return body, nil
+}
+
+// truncate shortens an error response of a log store for the error messages.
+func truncate(body []byte) string {
+	const maxLength = 256
+	if len(body) > maxLength {
+		return fmt.Sprintf("%s...", body[:maxLength])
+	}
+	return string(body)
+}
+
+// HTTPLogArchive reads the logs of the nodes from a generic HTTP endpoint. The
+// URL of the log of a node is a Go template, of which the fields are Namespace,
+// WorkflowName, NodeId, PodName, StartTime and EndTime, e.g.
+//
+//	https://logs.example.com/namespaces/{{.Namespace}}/pods/{{.PodName}}/log?since={{.StartTime.Unix}}
+//
+// Values can be escaped with the urlquery function. The endpoint returns the log
+// as text or JSON lines, optionally compressed with gzip.
+type HTTPLogArchive struct {
+	urlTemplate *template.Template
+	headers     map[string]string
+	client      *http.Client
+}
+
+func NewHTTPLogArchive(urlTemplate string, headers map[string]string) (*HTTPLogArchive, error) {
+	tmpl, err := template.New("url").Option("missingkey=error").Parse(urlTemplate)
+	if err != nil {
+		return nil, util.Wrapf(err, "failed to parse the URL template of the log archive %q", urlTemplate)
+	}
+	return &HTTPLogArchive{
+		urlTemplate: tmpl,
+		headers:     headers,
+		client:      &http.Client{Timeout: logStoreRequestTimeout},
+	}, nil
+}
+
+// GetLogObjectKey returns "", as the logs are not archived in the object store.
+func (a *HTTPLogArchive) GetLogObjectKey(workflow util.ExecutionSpec, nodeId string) (string, error) {
+	return "", nil
+}
+
+func (a *HTTPLogArchive) ReadLog(ctx context.Context, workflow util.ExecutionSpec, nodeId string) ([]byte, error) {
+	query, err := newLogQuery(workflow, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	url, err := executeTemplate(a.urlTemplate, query)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to build the URL of the log of node %v", nodeId)
+	}
+	return getLogStoreResponse(ctx, a.client, url, a.headers)
+}
+
+func (a *HTTPLogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error {
+	return copyLogFromArchive(logContent, dst, opts)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newLogWorkflow returns a workflow with a node which ran from 15:00 to 15:10.
+func newLogWorkflow() util.ExecutionSpec {
+	return util.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "workflow-name"},
+		Status: workflowapi.WorkflowStatus{
+			Nodes: map[string]workflowapi.NodeStatus{
+				"node-1": {
+					ID:         "node-1",
+					Type:       workflowapi.NodeTypePod,
+					StartedAt:  metav1.NewTime(time.Date(2020, 8, 31, 15, 0, 0, 0, time.UTC)),
+					FinishedAt: metav1.NewTime(time.Date(2020, 8, 31, 15, 10, 0, 0, time.UTC)),
+				},
+			},
+		},
+	})
+}
+
+func TestHTTPLogArchive_ReadLog(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.Write([]byte(logText))
+	}))
+	defer server.Close()
+
+	logArchive, err := NewHTTPLogArchive(
+		server.URL+"/logs/{{.Namespace}}/{{.PodName}}?workflow={{.WorkflowName | urlquery}}&from={{.StartTime.Unix}}&to={{.EndTime.Unix}}",
+		map[string]string{"Authorization": "Bearer token"})
+	require.Nil(t, err)
+
+	log, err := logArchive.ReadLog(context.Background(), newLogWorkflow(), "node-1")
+	require.Nil(t, err)
+	assert.Equal(t, logText, string(log))
+	assert.Equal(t, "/logs/ns1/node-1", request.URL.Path)
+	assert.Equal(t, "workflow-name", request.URL.Query().Get("workflow"))
+	assert.Equal(t, "1598885940", request.URL.Query().Get("from"))
+	assert.Equal(t, "1598886660", request.URL.Query().Get("to"))
+	assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+
+	key, err := logArchive.GetLogObjectKey(newLogWorkflow(), "node-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", key)
+}
+
+func TestHTTPLogArchive_ReadLog_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/logs/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		template string
+		nodeId   string
+		wantCode codes.Code
+	}{
+		{"log not found", server.URL + "/logs/missing", "node-1", codes.NotFound},
+		{"log store error", server.URL + "/logs/{{.PodName}}", "node-1", codes.Internal},
+		{"unknown node", server.URL + "/logs/{{.PodName}}", "node-2", codes.NotFound},
+		{"unknown template field", server.URL + "/logs/{{.Pod}}", "node-1", codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logArchive, err := NewHTTPLogArchive(tt.template, nil)
+			require.Nil(t, err)
+			_, err = logArchive.ReadLog(context.Background(), newLogWorkflow(), tt.nodeId)
+			require.NotNil(t, err)
+			assert.Equal(t, tt.wantCode, err.(*util.UserError).ExternalStatusCode())
+		})
+	}
+}
+
+func TestNewHTTPLogArchive_InvalidTemplate(t *testing.T) {
+	_, err := NewHTTPLogArchive("http://logs/{{.PodName", nil)
+	assert.NotNil(t, err)
+}
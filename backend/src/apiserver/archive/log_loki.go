@@ -0,0 +1,161 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultLokiQuery selects the log stream of a pod, with the labels set by the
+	// Kubernetes service discovery of Promtail.
+	DefaultLokiQuery = `{namespace="{{.Namespace}}", pod="{{.PodName}}"}`
+	// DefaultLokiLimit is the maximum number of log lines read from Loki per node.
+	DefaultLokiLimit = 5000
+	// The header selecting the tenant of a multi-tenant Loki.
+	lokiOrgIdHeader = "X-Scope-OrgID"
+)
+
+// lokiQueryResponse is the response of the query_range API of Loki to a log query.
+type lokiQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Values [][2]string `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// LokiLogArchive reads the logs of the nodes from the HTTP API of Loki, or of a
+// log store compatible with it. The log of a node is selected by a LogQL query
+// template, of which the fields are those of the HTTP log archive, in the time
+// window in which the node ran.
+type LokiLogArchive struct {
+	address       string
+	queryTemplate *template.Template
+	orgId         string
+	limit         int
+	client        *http.Client
+}
+
+func NewLokiLogArchive(address string, query string, orgId string, limit int) (*LokiLogArchive, error) {
+	if address == "" {
+		return nil, errors.New("the address of Loki is empty")
+	}
+	if query == "" {
+		query = DefaultLokiQuery
+	}
+	tmpl, err := template.New("query").Option("missingkey=error").Parse(query)
+	if err != nil {
+		return nil, util.Wrapf(err, "failed to parse the Loki query template %q", query)
+	}
+	if limit <= 0 {
+		limit = DefaultLokiLimit
+	}
+	return &LokiLogArchive{
+		address:       strings.TrimSuffix(address, "/"),
+		queryTemplate: tmpl,
+		orgId:         orgId,
+		limit:         limit,
+		client:        &http.Client{Timeout: logStoreRequestTimeout},
+	}, nil
+}
+
+// GetLogObjectKey returns "", as the logs are not archived in the object store.
+func (a *LokiLogArchive) GetLogObjectKey(workflow util.ExecutionSpec, nodeId string) (string, error) {
+	return "", nil
+}
+
+// ReadLog returns the log of a node as JSON lines, in chronological order.
+func (a *LokiLogArchive) ReadLog(ctx context.Context, workflow util.ExecutionSpec, nodeId string) ([]byte, error) {
+	query, err := newLogQuery(workflow, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	logQL, err := executeTemplate(a.queryTemplate, query)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to build the Loki query of the log of node %v", nodeId)
+	}
+	params := url.Values{}
+	params.Set("query", logQL)
+	params.Set("start", strconv.FormatInt(query.StartTime.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(query.EndTime.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(a.limit))
+	params.Set("direction", "forward")
+	var headers map[string]string
+	if a.orgId != "" {
+		headers = map[string]string{lokiOrgIdHeader: a.orgId}
+	}
+	body, err := getLogStoreResponse(ctx, a.client, a.address+"/loki/api/v1/query_range?"+params.Encode(), headers)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &lokiQueryResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to parse the Loki response to the query of the log of node %v", nodeId)
+	}
+	if response.Status != "success" || response.Data.ResultType != "streams" {
+		return nil, util.NewInternalServerError(
+			errors.Errorf("status %q, result type %q", response.Status, response.Data.ResultType),
+			"Unexpected Loki response to the query of the log of node %v", nodeId)
+	}
+	var entries []RunLogEntry
+	for _, stream := range response.Data.Result {
+		for _, value := range stream.Values {
+			ns, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				return nil, util.NewInternalServerError(err, "Failed to parse a Loki timestamp of the log of node %v", nodeId)
+			}
+			entries = append(entries, RunLogEntry{Timestamp: time.Unix(0, ns).UTC(), Log: strings.TrimSuffix(value[1], "\n")})
+		}
+	}
+	if len(entries) >= a.limit {
+		glog.Warningf("The log of node %v is truncated to the first %d lines read from Loki", nodeId, a.limit)
+	}
+	// The streams, e.g. stdout and stderr, are interleaved by timestamp.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to encode the log of node %v", nodeId)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (a *LokiLogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error {
+	return copyLogFromArchive(logContent, dst, opts)
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+var lokiResponse = `{
+  "status": "success",
+  "data": {
+    "resultType": "streams",
+    "result": [
+      {"stream": {"pod": "node-1", "stream": "stderr"}, "values": [["1598886002260657206", "[ERROR] Unable to connect\n"]]},
+      {"stream": {"pod": "node-1", "stream": "stdout"}, "values": [["1598886000000000000", "[INFO] OK"], ["1598886003000000000", "<done>"]]}
+    ]
+  }
+}`
+
+func TestLokiLogArchive_ReadLog(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.Write([]byte(lokiResponse))
+	}))
+	defer server.Close()
+
+	logArchive, err := NewLokiLogArchive(server.URL+"/", "", "tenant1", 0)
+	require.Nil(t, err)
+
+	log, err := logArchive.ReadLog(context.Background(), newLogWorkflow(), "node-1")
+	require.Nil(t, err)
+	assert.Equal(t, `{"log":"[INFO] OK","timestamp":"2020-08-31T15:00:00Z"}
+{"log":"[ERROR] Unable to connect","timestamp":"2020-08-31T15:00:02.260657206Z"}
+{"log":"<done>","timestamp":"2020-08-31T15:00:03Z"}
+`, string(log))
+
+	assert.Equal(t, "/loki/api/v1/query_range", request.URL.Path)
+	query := request.URL.Query()
+	assert.Equal(t, `{namespace="ns1", pod="node-1"}`, query.Get("query"))
+	assert.Equal(t, "1598885940000000000", query.Get("start"))
+	assert.Equal(t, "1598886660000000000", query.Get("end"))
+	assert.Equal(t, fmt.Sprint(DefaultLokiLimit), query.Get("limit"))
+	assert.Equal(t, "forward", query.Get("direction"))
+	assert.Equal(t, "tenant1", request.Header.Get("X-Scope-OrgID"))
+
+	var entries []RunLogEntry
+	err = ReadArchivedLogEntries(log, func(entry RunLogEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []RunLogEntry{
+		{Log: "[INFO] OK", Timestamp: logTs0},
+		{Log: "[ERROR] Unable to connect", Timestamp: logTs1},
+		{Log: "<done>", Timestamp: logTs0.Add(3e9)},
+	}, entries)
+}
+
+func TestLokiLogArchive_ReadLog_CustomQuery(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("query")
+		w.Write([]byte(`{"status": "success", "data": {"resultType": "streams", "result": []}}`))
+	}))
+	defer server.Close()
+
+	logArchive, err := NewLokiLogArchive(server.URL, `{app="kfp", workflow="{{.WorkflowName}}"} |= "{{.NodeId}}"`, "", 100)
+	require.Nil(t, err)
+
+	log, err := logArchive.ReadLog(context.Background(), newLogWorkflow(), "node-1")
+	assert.Nil(t, err)
+	assert.Empty(t, log)
+	assert.Equal(t, `{app="kfp", workflow="workflow-name"} |= "node-1"`, query)
+}
+
+func TestLokiLogArchive_ReadLog_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		wantCode codes.Code
+	}{
+		{"query error", http.StatusBadRequest, "parse error", codes.Internal},
+		{"invalid response", http.StatusOK, "not json", codes.Internal},
+		{"unexpected result type", http.StatusOK, `{"status": "success", "data": {"resultType": "matrix", "result": []}}`, codes.Internal},
+		{"invalid timestamp", http.StatusOK, `{"status": "success", "data": {"resultType": "streams", "result": [{"values": [["now", "line"]]}]}}`, codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			logArchive, err := NewLokiLogArchive(server.URL, "", "", 0)
+			require.Nil(t, err)
+			_, err = logArchive.ReadLog(context.Background(), newLogWorkflow(), "node-1")
+			require.NotNil(t, err)
+			assert.Equal(t, tt.wantCode, err.(*util.UserError).ExternalStatusCode())
+		})
+	}
+}
+
+func TestNewLokiLogArchive_InvalidConfig(t *testing.T) {
+	_, err := NewLokiLogArchive("", "", "", 0)
+	assert.NotNil(t, err)
+	_, err = NewLokiLogArchive("http://loki", `{pod="{{.PodName}"}`, "", 0)
+	assert.NotNil(t, err)
+}
@@ -33,7 +33,7 @@ func compressInput(t *testing.T, content string) []byte {
 	gw := gzip.NewWriter(&src)
 	_, err := gw.Write([]byte(content))
 	assert.Nil(t, err)
-	err = gw.Flush()
+	err = gw.Close()
 	assert.Nil(t, err)
 	return src.Bytes()
 }
@@ -59,7 +59,7 @@ var (
 )
 
 func initLogArchive() *LogArchive {
-	return NewLogArchive("/logs", "main.log")
+	return NewLogArchive(nil, "/logs", "main.log")
 }
 
 func TestGetLogObjectKey(t *testing.T) {
@@ -77,7 +77,7 @@ func TestGetLogObjectKey(t *testing.T) {
 }
 
 func TestGetLogObjectKey_InvalidConfig(t *testing.T) {
-	logArchive := NewLogArchive("", "")
+	logArchive := NewLogArchive(nil, "", "")
 	_, err := logArchive.GetLogObjectKey(nil, "node-id-98765432")
 	assert.NotNil(t, err)
 }
@@ -276,3 +276,40 @@ func TestCopyLogFromArchive_FromCriOTextToTextWithTimestamp(t *testing.T) {
 	line = scanner.Text()
 	assert.Equal(t, "2020-08-31T15:00:02.260657206Z [ERROR] Unable to connect", line)
 }
+
+func TestReadArchivedLogEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"json", logJsonLines},
+		{"text", logText},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var entries []RunLogEntry
+			err := ReadArchivedLogEntries(compressInput(t, tt.content), func(entry RunLogEntry) error {
+				entries = append(entries, entry)
+				return nil
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []RunLogEntry{
+				{Log: "[INFO] OK", Timestamp: logTs0},
+				{Log: "[ERROR] Unable to connect"},
+			}, entries)
+		})
+	}
+}
+
+func TestScanLogEntries_CriO(t *testing.T) {
+	var entries []RunLogEntry
+	err := ScanLogEntries(bytes.NewReader([]byte(logCriOText)), func(entry RunLogEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []RunLogEntry{
+		{Log: "[INFO] OK", Timestamp: logTs0},
+		{Log: "[ERROR] Unable to connect", Timestamp: logTs1},
+	}, entries)
+}
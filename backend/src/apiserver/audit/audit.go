@@ -0,0 +1,194 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+)
+
+// Logger records mutating API calls to its sinks.
+type Logger struct {
+	sinks []Sink
+	// authenticators identify the callers of the requests which were not
+	// authorized, e.g. in single-user mode.
+	authenticators []auth.Authenticator
+	// excludedMethods are the names of the RPC methods and services which are
+	// not audited, e.g. CreateRun or ReportService.
+	excludedMethods []string
+	time            util.TimeInterface
+}
+
+// NewLogger creates a Logger.
+func NewLogger(sinks []Sink, authenticators []auth.Authenticator, excludedMethods []string, time util.TimeInterface) *Logger {
+	return &Logger{sinks: sinks, authenticators: authenticators, excludedMethods: excludedMethods, time: time}
+}
+
+// audits reports whether a full RPC method name is a mutating call which is
+// not excluded from the audit log.
+func (l *Logger) audits(fullMethod string) bool {
+	if !common.IsMutatingMethod(fullMethod) {
+		return false
+	}
+	// The full method name is /<package>.<service>/<method>.
+	service := strings.TrimPrefix(fullMethod[:strings.LastIndex(fullMethod, "/")], "/")
+	service = service[strings.LastIndex(service, ".")+1:]
+	method := common.GetMethodName(fullMethod)
+	for _, excluded := range l.excludedMethods {
+		if excluded == method || excluded == service {
+			return false
+		}
+	}
+	return true
+}
+
+// record accumulates what the handler of a request learns about it.
+type record struct {
+	mu          sync.Mutex
+	user        string
+	namespace   string
+	resourceIds []string
+}
+
+type recordKey struct{}
+
+func newRecordContext(ctx context.Context) (context.Context, *record) {
+	r := &record{}
+	return context.WithValue(ctx, recordKey{}, r), r
+}
+
+func recordFromContext(ctx context.Context) *record {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(recordKey{}).(*record)
+	return r
+}
+
+// SetIdentity records the authenticated caller of an audited request, and the
+// namespace in which the caller was authorized. It does nothing if the request
+// is not audited.
+func SetIdentity(ctx context.Context, user string, namespace string) {
+	r := recordFromContext(ctx)
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.user = user
+	if namespace != "" {
+		r.namespace = namespace
+	}
+}
+
+// AddResourceIds records IDs of the resources of an audited request. It does
+// nothing if the request is not audited.
+func AddResourceIds(ctx context.Context, ids ...string) {
+	r := recordFromContext(ctx)
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resourceIds = appendUnique(r.resourceIds, ids...)
+}
+
+// write builds the audit event of a finished request and writes it to all sinks.
+// Failures are logged, they never fail the request.
+func (l *Logger) write(ctx context.Context, r *record, method string, code codes.Code, errorMessage string) {
+	r.mu.Lock()
+	event := &model.AuditEvent{
+		CreatedAtInSec: l.time.Now().Unix(),
+		User:           r.user,
+		Method:         method,
+		ResourceIds:    joinResourceIds(r.resourceIds),
+		Namespace:      r.namespace,
+		Code:           code.String(),
+		ErrorMessage:   errorMessage,
+	}
+	r.mu.Unlock()
+	if event.User == "" {
+		event.User = l.identify(ctx)
+	}
+	for _, sink := range l.sinks {
+		if err := sink.Write(event); err != nil {
+			glog.Errorf("Failed to write the audit event of %v: %v", method, err)
+		}
+	}
+}
+
+// identify returns the caller of a request, or an empty string if no
+// authenticator identifies it.
+func (l *Logger) identify(ctx context.Context) string {
+	for _, authenticator := range l.authenticators {
+		if identity, err := authenticator.GetUserIdentity(ctx); err == nil {
+			return identity
+		}
+	}
+	return ""
+}
+
+func appendUnique(ids []string, newIds ...string) []string {
+	for _, id := range newIds {
+		if id == "" {
+			continue
+		}
+		found := false
+		for _, existing := range ids {
+			if existing == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// The maximum length of the resource IDs of an audit event, which is the size
+// of its column.
+const maxResourceIdsLength = 1024
+
+func joinResourceIds(ids []string) string {
+	result := ""
+	for _, id := range ids {
+		next := id
+		if result != "" {
+			next = result + "," + id
+		}
+		if len(next) > maxResourceIdsLength {
+			break
+		}
+		result = next
+	}
+	return result
+}
+
+func splitResourceIds(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
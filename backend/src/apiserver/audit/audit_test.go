@@ -0,0 +1,262 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/gorilla/mux"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const userIDHeader = "kubeflow-userid"
+
+type fakeSink struct {
+	events []*model.AuditEvent
+}
+
+func (s *fakeSink) Write(event *model.AuditEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func newTestLogger() (*Logger, *fakeSink) {
+	sink := &fakeSink{}
+	authenticators := []auth.Authenticator{auth.NewHTTPHeaderAuthenticator(userIDHeader, "")}
+	return NewLogger([]Sink{sink}, authenticators, []string{"ReportService", "ReportRunMetricsV1"}, util.NewFakeTimeForEpoch()), sink
+}
+
+func TestUnaryServerInterceptor_MutatingCall(t *testing.T) {
+	logger, sink := newTestLogger()
+	info := &grpc.UnaryServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/CreatePipeline"}
+	request := &apiv2beta1.CreatePipelineRequest{
+		Pipeline: &apiv2beta1.Pipeline{DisplayName: "pipeline", Namespace: "ns1"},
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		SetIdentity(ctx, "user1@google.com", "ns1")
+		return &apiv2beta1.Pipeline{PipelineId: "pipeline1", DisplayName: "pipeline", Namespace: "ns1"}, nil
+	}
+
+	resp, err := logger.UnaryServerInterceptor(context.Background(), request, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "pipeline1", resp.(*apiv2beta1.Pipeline).GetPipelineId())
+	assert.Equal(t, []*model.AuditEvent{{
+		CreatedAtInSec: 1,
+		User:           "user1@google.com",
+		Method:         info.FullMethod,
+		ResourceIds:    "pipeline1",
+		Namespace:      "ns1",
+		Code:           "OK",
+	}}, sink.events)
+}
+
+func TestUnaryServerInterceptor_FailedCall(t *testing.T) {
+	logger, sink := newTestLogger()
+	info := &grpc.UnaryServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.RunService/TerminateRun"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.PermissionDenied, "Failed to authorize the request")
+	}
+	// The caller is identified by the authenticators when the handler fails before authorizing it.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, "user1@google.com"))
+
+	_, err := logger.UnaryServerInterceptor(ctx, &apiv2beta1.TerminateRunRequest{RunId: "run1"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, []*model.AuditEvent{{
+		CreatedAtInSec: 1,
+		User:           "user1@google.com",
+		Method:         info.FullMethod,
+		ResourceIds:    "run1",
+		Code:           "PermissionDenied",
+		ErrorMessage:   "Failed to authorize the request",
+	}}, sink.events)
+}
+
+func TestUnaryServerInterceptor_NonMutatingCall(t *testing.T) {
+	logger, sink := newTestLogger()
+	info := &grpc.UnaryServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.RunService/GetRun"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		// Identities of calls which are not audited are ignored.
+		SetIdentity(ctx, "user1@google.com", "ns1")
+		return &apiv2beta1.Run{RunId: "run1"}, nil
+	}
+
+	_, err := logger.UnaryServerInterceptor(context.Background(), &apiv2beta1.GetRunRequest{RunId: "run1"}, info, handler)
+	assert.Nil(t, err)
+	assert.Empty(t, sink.events)
+}
+
+func TestUnaryServerInterceptor_ExcludedCall(t *testing.T) {
+	logger, sink := newTestLogger()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &empty.Empty{}, nil
+	}
+
+	// The calls of excluded services and methods are not audited.
+	for _, method := range []string{
+		"/kubeflow.pipelines.backend.api.v2beta1.ReportService/ReportWorkflow",
+		"/api.ReportService/ReportScheduledWorkflowV1",
+		"/api.RunService/ReportRunMetricsV1",
+	} {
+		_, err := logger.UnaryServerInterceptor(context.Background(), &apiv2beta1.ReportWorkflowRequest{},
+			&grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.Nil(t, err)
+	}
+	assert.Empty(t, sink.events)
+
+	// Other mutating calls of the services are audited.
+	logger.excludedMethods = []string{"ReportWorkflow"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.ReportService/ReportScheduledWorkflow"}
+	_, err := logger.UnaryServerInterceptor(context.Background(), &apiv2beta1.ReportScheduledWorkflowRequest{}, info, handler)
+	assert.Nil(t, err)
+	assert.Len(t, sink.events, 1)
+}
+
+func TestHTTPHandler(t *testing.T) {
+	logger, sink := newTestLogger()
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v2beta1/pipelines/upload_version", logger.HTTPHandler(func(w http.ResponseWriter, r *http.Request) {
+		SetIdentity(r.Context(), "user1@google.com", "ns1")
+		AddResourceIds(r.Context(), "version1")
+		w.WriteHeader(http.StatusOK)
+	}))
+	router.HandleFunc("/apis/v1alpha1/runs/{run_id}/nodes/{node_id}/log", logger.HTTPHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/apis/v2beta1/pipelines/upload_version?pipelineid=pipeline1", nil)
+	router.ServeHTTP(httptest.NewRecorder(), request)
+	request = httptest.NewRequest(http.MethodGet, "/apis/v1alpha1/runs/run1/nodes/node1/log", nil)
+	request.Header.Set(userIDHeader, "user2@google.com")
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	assert.Equal(t, []*model.AuditEvent{
+		{
+			CreatedAtInSec: 1,
+			User:           "user1@google.com",
+			Method:         "POST /apis/v2beta1/pipelines/upload_version",
+			ResourceIds:    "version1,pipeline1",
+			Namespace:      "ns1",
+			Code:           "OK",
+		},
+		{
+			CreatedAtInSec: 2,
+			User:           "user2@google.com",
+			Method:         "GET /apis/v1alpha1/runs/{run_id}/nodes/{node_id}/log",
+			ResourceIds:    "node1,run1",
+			Code:           "NotFound",
+			ErrorMessage:   "Not Found",
+		},
+	}, sink.events)
+}
+
+func TestHTTPHandler_Stream(t *testing.T) {
+	logger, sink := newTestLogger()
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v2beta1/runs/{run_id}/logs:stream", logger.HTTPHandler(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		assert.True(t, ok)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+		io.WriteString(w, "data: {}\n\n")
+		flusher.Flush()
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/apis/v2beta1/runs/run1/logs:stream", nil)
+	request.Header.Set(userIDHeader, "user1@google.com")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.True(t, recorder.Flushed)
+	assert.Equal(t, "data: {}\n\n", recorder.Body.String())
+	assert.Equal(t, []*model.AuditEvent{
+		{
+			CreatedAtInSec: 1,
+			User:           "user1@google.com",
+			Method:         "GET /apis/v2beta1/runs/{run_id}/logs:stream",
+			ResourceIds:    "run1",
+			Code:           "OK",
+		},
+	}, sink.events)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	sink, err := NewFileSink(path)
+	assert.Nil(t, err)
+	assert.Nil(t, sink.Write(&model.AuditEvent{
+		CreatedAtInSec: 1,
+		User:           "user1@google.com",
+		Method:         "/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun",
+		ResourceIds:    "run1,experiment1",
+		Namespace:      "ns1",
+		Code:           "OK",
+	}))
+	assert.Nil(t, sink.Write(&model.AuditEvent{
+		CreatedAtInSec: 2,
+		User:           "user1@google.com",
+		Method:         "/kubeflow.pipelines.backend.api.v2beta1.RunService/DeleteRun",
+		Code:           "NotFound",
+		ErrorMessage:   "Run not found",
+	}))
+	assert.Nil(t, sink.Close())
+
+	content, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t,
+		`{"time":"1970-01-01T00:00:01Z","user":"user1@google.com","method":"/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun","resource_ids":["run1","experiment1"],"namespace":"ns1","code":"OK"}`+"\n"+
+			`{"time":"1970-01-01T00:00:02Z","user":"user1@google.com","method":"/kubeflow.pipelines.backend.api.v2beta1.RunService/DeleteRun","code":"NotFound","error_message":"Run not found"}`+"\n",
+		string(content))
+}
+
+func TestStoreSink_Retention(t *testing.T) {
+	db := storage.NewFakeDBOrFatal()
+	defer db.Close()
+	store := storage.NewAuditEventStore(db, util.NewUUIDGenerator())
+	sink := NewStoreSink(store, time.Hour)
+
+	for _, createdAtInSec := range []int64{100, 2000, 5000} {
+		assert.Nil(t, sink.Write(&model.AuditEvent{CreatedAtInSec: createdAtInSec, User: "user1@google.com"}))
+	}
+
+	// Writing the last event deleted the events older than an hour.
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	events, _, _, err := store.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	var times []int64
+	for _, event := range events {
+		times = append(times, event.CreatedAtInSec)
+	}
+	assert.ElementsMatch(t, []int64{2000, 5000}, times)
+}
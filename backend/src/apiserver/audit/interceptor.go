@@ -0,0 +1,188 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnaryServerInterceptor records the mutating RPC calls. It must run outside of
+// the interceptor converting errors to gRPC errors, so that it sees the codes
+// returned to callers.
+func (l *Logger) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !l.audits(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	ctx, r := newRecordContext(ctx)
+	resp, err := handler(ctx, req)
+
+	r.mu.Lock()
+	r.resourceIds = appendUnique(r.resourceIds, resourceIdsOf(req, maxMessageDepth)...)
+	if err == nil {
+		r.resourceIds = appendUnique(r.resourceIds, resourceIdsOf(resp, maxMessageDepth)...)
+	}
+	if r.namespace == "" {
+		r.namespace = common.GetNamespaceFromRequest(req)
+	}
+	r.mu.Unlock()
+
+	stat := status.Convert(err)
+	l.write(ctx, r, info.FullMethod, stat.Code(), stat.Message())
+	return resp, err
+}
+
+// The depth of the nested messages in which resource IDs are looked for, e.g.
+// the IDs of the run of a CreateRunRequest.
+const maxMessageDepth = 2
+
+// resourceIdsOf returns the values of the fields named id or ending in _id of a
+// proto message and its nested messages.
+func resourceIdsOf(message interface{}, depth int) []string {
+	m, ok := message.(protoreflect.ProtoMessage)
+	if !ok || depth == 0 {
+		return nil
+	}
+	var ids []string
+	m.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.IsList() || field.IsMap() {
+			return true
+		}
+		name := string(field.Name())
+		switch field.Kind() {
+		case protoreflect.StringKind:
+			if name == "id" || strings.HasSuffix(name, "_id") {
+				ids = appendUnique(ids, value.String())
+			}
+		case protoreflect.MessageKind:
+			ids = appendUnique(ids, resourceIdsOf(value.Message().Interface(), depth-1)...)
+		}
+		return true
+	})
+	return ids
+}
+
+// statusRecorder records the status code of an HTTP response.
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// HTTPHandler records the calls of an HTTP handler, whose method is the HTTP
+// method and the path template of the route, e.g. POST /apis/v2beta1/pipelines/upload.
+// The handler must use the context of the request to authorize it.
+func (l *Logger) HTTPHandler(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		path := req.URL.Path
+		if route := mux.CurrentRoute(req); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				path = template
+			}
+		}
+		method := req.Method + " " + path
+		ctx, r := newRecordContext(req.Context())
+		recorder := &statusRecorder{ResponseWriter: w}
+		handler(recorder, req.WithContext(ctx))
+
+		r.mu.Lock()
+		vars := mux.Vars(req)
+		for _, key := range sortedKeys(vars) {
+			if key == "id" || strings.HasSuffix(key, "_id") {
+				r.resourceIds = appendUnique(r.resourceIds, vars[key])
+			}
+		}
+		r.resourceIds = appendUnique(r.resourceIds, req.URL.Query()["pipelineid"]...)
+		if r.namespace == "" {
+			r.namespace = req.URL.Query().Get("namespace")
+		}
+		r.mu.Unlock()
+
+		if recorder.code == 0 {
+			recorder.code = http.StatusOK
+		}
+		code, message := codes.OK, ""
+		if recorder.code >= http.StatusBadRequest {
+			code, message = httpStatusToCode(recorder.code), http.StatusText(recorder.code)
+		}
+		md := metadata.MD{}
+		for key, values := range req.Header {
+			md.Set(key, values...)
+		}
+		l.write(metadata.NewIncomingContext(ctx, md), r, method, code, message)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// httpStatusToCode returns the gRPC code of an HTTP error status.
+func httpStatusToCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
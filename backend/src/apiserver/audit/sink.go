@@ -0,0 +1,154 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// Sink stores audit events.
+type Sink interface {
+	Write(event *model.AuditEvent) error
+}
+
+// The minimum interval between two deletions of the expired audit events.
+const auditEventPruneInterval = time.Hour
+
+// StoreSink writes audit events to the audit_events table, from which they are
+// listed by the ListAuditEvents API. The events older than the retention are
+// deleted as new events are written.
+type StoreSink struct {
+	store     storage.AuditEventStoreInterface
+	retention time.Duration
+
+	mu sync.Mutex
+	// prunedAt is the creation time of the event after which the expired
+	// events were last deleted.
+	prunedAt int64
+}
+
+// NewStoreSink creates a StoreSink keeping the events for retention, or
+// forever if retention is 0.
+func NewStoreSink(store storage.AuditEventStoreInterface, retention time.Duration) *StoreSink {
+	return &StoreSink{store: store, retention: retention}
+}
+
+func (s *StoreSink) Write(event *model.AuditEvent) error {
+	if _, err := s.store.CreateAuditEvent(event); err != nil {
+		return err
+	}
+	if s.retention <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if event.CreatedAtInSec-s.prunedAt < int64(auditEventPruneInterval/time.Second) {
+		return nil
+	}
+	s.prunedAt = event.CreatedAtInSec
+	if err := s.store.DeleteAuditEventsBefore(event.CreatedAtInSec - int64(s.retention/time.Second)); err != nil {
+		glog.Warningf("Failed to delete the expired audit events: %v", err)
+	}
+	return nil
+}
+
+// FileSink appends audit events to a file as JSON lines.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// fileEvent is the JSON line of an audit event.
+type fileEvent struct {
+	Time         string   `json:"time"`
+	User         string   `json:"user"`
+	Method       string   `json:"method"`
+	ResourceIds  []string `json:"resource_ids,omitempty"`
+	Namespace    string   `json:"namespace,omitempty"`
+	Code         string   `json:"code"`
+	ErrorMessage string   `json:"error_message,omitempty"`
+}
+
+// NewFileSink creates a FileSink appending to the file at path, which is
+// created with its directory if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create the directory of audit log file %v", path)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to open audit log file %v", path)
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Write(event *model.AuditEvent) error {
+	line, err := json.Marshal(&fileEvent{
+		Time:         time.Unix(event.CreatedAtInSec, 0).UTC().Format(time.RFC3339),
+		User:         event.User,
+		Method:       event.Method,
+		ResourceIds:  splitResourceIds(event.ResourceIds),
+		Namespace:    event.Namespace,
+		Code:         event.Code,
+		ErrorMessage: event.ErrorMessage,
+	})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal audit event")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return util.NewInternalServerError(err, "Failed to write audit event to %v", s.file.Name())
+	}
+	return nil
+}
+
+// Close closes the file of the sink.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// GetSinks returns the configured audit sinks.
+func GetSinks(store storage.AuditEventStoreInterface) []Sink {
+	var sinks []Sink
+	for _, name := range common.GetAuditSinks() {
+		switch name {
+		case common.DBAuditSink:
+			sinks = append(sinks, NewStoreSink(store, common.GetAuditRetention()))
+		case common.FileAuditSink:
+			sink, err := NewFileSink(common.GetAuditFilePath())
+			if err != nil {
+				glog.Fatalf("Failed to create the file audit sink: %v", err)
+			}
+			sinks = append(sinks, sink)
+		default:
+			glog.Fatalf("Unsupported audit sink %q, supported values are %q and %q",
+				name, common.DBAuditSink, common.FileAuditSink)
+		}
+	}
+	return sinks
+}
@@ -17,6 +17,7 @@ package auth
 import (
 	"context"
 
+	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -27,19 +28,65 @@ type Authenticator interface {
 	GetUserIdentity(ctx context.Context) (string, error)
 }
 
+// UserInfo is the identity of an authenticated user.
+type UserInfo struct {
+	Name   string
+	Groups []string
+}
+
+// UserInfoAuthenticator is an Authenticator which also identifies the groups of the user.
+type UserInfoAuthenticator interface {
+	Authenticator
+	GetUserInfo(ctx context.Context) (*UserInfo, error)
+}
+
 var IdentityHeaderMissingError = util.NewUnauthenticatedError(
 	errors.New("Request header error: there is no user identity header."),
 	"Request header error: there is no user identity header.",
 )
 
+// GetAuthenticators returns the authenticators selected by the AUTHENTICATORS config,
+// in the order in which they are tried.
 func GetAuthenticators(tokenReviewClient client.TokenReviewInterface) []Authenticator {
-	return []Authenticator{
-		NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()),
-		NewTokenReviewAuthenticator(
-			common.AuthorizationBearerTokenHeader,
-			common.AuthorizationBearerTokenPrefix,
-			[]string{common.GetTokenReviewAudience()},
-			tokenReviewClient,
-		),
+	authenticators := make([]Authenticator, 0)
+	for _, name := range common.GetAuthenticators() {
+		switch name {
+		case common.HTTPHeaderAuthenticator:
+			authenticators = append(authenticators,
+				NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()))
+		case common.TokenReviewAuthenticator:
+			authenticators = append(authenticators, NewTokenReviewAuthenticator(
+				common.AuthorizationBearerTokenHeader,
+				common.AuthorizationBearerTokenPrefix,
+				[]string{common.GetTokenReviewAudience()},
+				tokenReviewClient,
+			))
+		case common.OIDCAuthenticator:
+			authenticator, err := NewOIDCAuthenticator(
+				common.AuthorizationBearerTokenHeader,
+				common.AuthorizationBearerTokenPrefix,
+				getOIDCConfig(),
+			)
+			if err != nil {
+				glog.Fatalf("Failed to create the OIDC authenticator: %v", err)
+			}
+			authenticators = append(authenticators, authenticator)
+		default:
+			glog.Fatalf("Unknown authenticator %q in %s", name, common.Authenticators)
+		}
+	}
+	return authenticators
+}
+
+func getOIDCConfig() OIDCConfig {
+	return OIDCConfig{
+		IssuerURL:           common.GetStringConfigWithDefault(common.OIDCIssuerURL, ""),
+		JWKSURL:             common.GetStringConfigWithDefault(common.OIDCJWKSURL, ""),
+		Audience:            common.GetStringConfigWithDefault(common.OIDCAudience, ""),
+		UsernameClaim:       common.GetStringConfigWithDefault(common.OIDCUsernameClaim, defaultOIDCUsernameClaim),
+		UsernamePrefix:      common.GetStringConfigWithDefault(common.OIDCUsernamePrefix, ""),
+		GroupsClaim:         common.GetStringConfigWithDefault(common.OIDCGroupsClaim, defaultOIDCGroupsClaim),
+		GroupsPrefix:        common.GetStringConfigWithDefault(common.OIDCGroupsPrefix, ""),
+		JWKSRefreshInterval: common.GetDurationConfigWithDefault(common.OIDCJWKSRefreshInterval, defaultJWKSRefreshInterval),
 	}
 }
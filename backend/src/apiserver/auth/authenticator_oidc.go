@@ -0,0 +1,322 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultOIDCUsernameClaim = "sub"
+	defaultOIDCGroupsClaim   = "groups"
+	// The clock skew tolerated when checking the expiry of tokens.
+	oidcClockSkew = time.Minute
+)
+
+// OIDCConfig configures an OIDCAuthenticator.
+type OIDCConfig struct {
+	// IssuerURL is the issuer which the tokens must have, e.g. https://accounts.example.com.
+	IssuerURL string
+	// JWKSURL is the URL of the keys which sign the tokens. When empty, it is discovered
+	// from the OpenID configuration of the issuer.
+	JWKSURL string
+	// Audience is the audience which the tokens must have.
+	Audience string
+	// UsernameClaim is the claim used as the user identity. Defaults to "sub".
+	UsernameClaim string
+	// UsernamePrefix is prepended to the user identity, e.g. "oidc:", so that the users
+	// of the identity provider can't impersonate other users. Defaults to the issuer URL
+	// followed by "#". The identity is not prefixed if it is "-".
+	UsernamePrefix string
+	// GroupsClaim is the claim holding the groups of the user. Defaults to "groups".
+	GroupsClaim string
+	// GroupsPrefix is prepended to the groups of the user, e.g. "oidc:", so that the
+	// identity provider can't grant the privileged groups of the cluster. Defaults to the
+	// issuer URL followed by "#". The groups are not prefixed if it is "-".
+	GroupsPrefix string
+	// JWKSRefreshInterval is the time after which the keys are fetched again.
+	JWKSRefreshInterval time.Duration
+}
+
+// OIDCAuthenticator authenticates requests with a bearer JSON Web Token issued by an
+// OpenID Connect identity provider. The signature of the token is verified with the
+// keys of the JWKS of the provider, and its issuer, audience and expiry are checked.
+type OIDCAuthenticator struct {
+	tokenHeader string
+	tokenPrefix string
+	config      OIDCConfig
+	keySet      *remoteKeySet
+	now         func() time.Time
+}
+
+func NewOIDCAuthenticator(tokenHeader, tokenPrefix string, config OIDCConfig) (*OIDCAuthenticator, error) {
+	if config.IssuerURL == "" {
+		return nil, errors.New("the issuer URL of the OIDC authenticator is empty")
+	}
+	if config.Audience == "" {
+		return nil, errors.New("the audience of the OIDC authenticator is empty")
+	}
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = defaultOIDCUsernameClaim
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = defaultOIDCGroupsClaim
+	}
+	config.UsernamePrefix = oidcPrefix(config.UsernamePrefix, config.IssuerURL)
+	config.GroupsPrefix = oidcPrefix(config.GroupsPrefix, config.IssuerURL)
+	return &OIDCAuthenticator{
+		tokenHeader: tokenHeader,
+		tokenPrefix: tokenPrefix,
+		config:      config,
+		keySet:      newRemoteKeySet(config.IssuerURL, config.JWKSURL, config.JWKSRefreshInterval),
+		now:         time.Now,
+	}, nil
+}
+
+// oidcPrefix returns the prefix of the users or groups of an issuer, configured
+// as "-" for no prefix.
+func oidcPrefix(prefix string, issuerURL string) string {
+	switch prefix {
+	case "":
+		return issuerURL + "#"
+	case "-":
+		return ""
+	default:
+		return prefix
+	}
+}
+
+func (oa *OIDCAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
+	userInfo, err := oa.GetUserInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return userInfo.Name, nil
+}
+
+func (oa *OIDCAuthenticator) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+	token, err := singlePrefixedHeaderFromMetadata(ctx, oa.tokenHeader, oa.tokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := oa.verify(ctx, token)
+	if err != nil {
+		return nil, util.NewUnauthenticatedError(err, "Authentication failure: invalid OIDC token")
+	}
+	userInfo, err := oa.userInfoFromClaims(claims)
+	if err != nil {
+		return nil, util.NewUnauthenticatedError(err, "Authentication failure: invalid OIDC token")
+	}
+	return userInfo, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// verify verifies the signature and the registered claims of the token, and returns
+// its claims.
+func (oa *OIDCAuthenticator) verify(ctx context.Context, token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("the token is not a JWS in compact serialization")
+	}
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed header")
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, errors.Wrap(err, "malformed header")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed signature")
+	}
+	keys, err := oa.keySet.keysFor(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+	verified := false
+	for _, key := range keys {
+		if err = verifySignature(header.Alg, key.key, signed, signature); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed payload")
+	}
+	var claims map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, errors.Wrap(err, "malformed payload")
+	}
+	if err := oa.verifyClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (oa *OIDCAuthenticator) verifyClaims(claims map[string]interface{}) error {
+	if issuer, _ := claims["iss"].(string); issuer != oa.config.IssuerURL {
+		return errors.Errorf("unexpected issuer %q", issuer)
+	}
+	if !audienceContains(claims["aud"], oa.config.Audience) {
+		return errors.Errorf("the audience %v does not contain %q", claims["aud"], oa.config.Audience)
+	}
+	now := oa.now()
+	expiry, ok := numericDate(claims["exp"])
+	if !ok {
+		return errors.New("the token has no expiry")
+	}
+	if now.After(expiry.Add(oidcClockSkew)) {
+		return errors.Errorf("the token expired at %v", expiry.UTC())
+	}
+	if notBefore, ok := numericDate(claims["nbf"]); ok && now.Add(oidcClockSkew).Before(notBefore) {
+		return errors.Errorf("the token is not valid before %v", notBefore.UTC())
+	}
+	return nil
+}
+
+func (oa *OIDCAuthenticator) userInfoFromClaims(claims map[string]interface{}) (*UserInfo, error) {
+	username, _ := claims[oa.config.UsernameClaim].(string)
+	if username == "" {
+		return nil, errors.Errorf("the token has no %q claim", oa.config.UsernameClaim)
+	}
+	if oa.config.UsernameClaim == "email" {
+		// Unverified emails must not identify users.
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return nil, errors.Errorf("the email %q is not verified", username)
+		}
+	}
+	userInfo := &UserInfo{Name: oa.config.UsernamePrefix + username}
+	switch groups := claims[oa.config.GroupsClaim].(type) {
+	case string:
+		userInfo.Groups = []string{oa.config.GroupsPrefix + groups}
+	case []interface{}:
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				userInfo.Groups = append(userInfo.Groups, oa.config.GroupsPrefix+group)
+			}
+		}
+	}
+	return userInfo, nil
+}
+
+// audienceContains reports whether the "aud" claim, a string or an array of strings,
+// contains the audience.
+func audienceContains(claim interface{}, audience string) bool {
+	switch claim := claim.(type) {
+	case string:
+		return claim == audience
+	case []interface{}:
+		for _, value := range claim {
+			if value == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func numericDate(claim interface{}) (time.Time, bool) {
+	number, ok := claim.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(seconds), 0), true
+}
+
+// The bit sizes of the curves of the ECDSA algorithms.
+var ecdsaCurveBitSizes = map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}
+
+// verifySignature verifies a JWS signature with the RS, PS or ES algorithms. Symmetric
+// algorithms and unsigned tokens are rejected.
+func verifySignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return errors.Errorf("unsupported signing algorithm %q", alg)
+	}
+	hasher := hash.New()
+	hasher.Write(signed)
+	digest := hasher.Sum(nil)
+
+	switch alg[:2] {
+	case "RS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.Errorf("the key does not match the algorithm %q", alg)
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature)
+	case "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.Errorf("the key does not match the algorithm %q", alg)
+		}
+		return rsa.VerifyPSS(rsaKey, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	default:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.Errorf("the key does not match the algorithm %q", alg)
+		}
+		if ecKey.Curve.Params().BitSize != ecdsaCurveBitSizes[alg] {
+			return errors.Errorf("the curve of the key does not match the algorithm %q", alg)
+		}
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("ECDSA verification failure")
+		}
+		return nil
+	}
+}
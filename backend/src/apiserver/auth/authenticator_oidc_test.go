@@ -0,0 +1,388 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testAudience = "kubeflow-pipelines"
+)
+
+// fakeIdentityProvider serves a JWKS of locally generated keys, and signs tokens.
+type fakeIdentityProvider struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu       sync.Mutex
+	keys     map[string]crypto.Signer
+	requests int
+}
+
+func newFakeIdentityProvider(t *testing.T) *fakeIdentityProvider {
+	provider := &fakeIdentityProvider{t: t, keys: make(map[string]crypto.Signer)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"jwks_uri": provider.server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		provider.mu.Lock()
+		defer provider.mu.Unlock()
+		provider.requests++
+		keySet := jsonWebKeySet{}
+		for kid, key := range provider.keys {
+			keySet.Keys = append(keySet.Keys, toJSONWebKey(kid, key.Public()))
+		}
+		_ = json.NewEncoder(w).Encode(keySet)
+	})
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+	return provider
+}
+
+func (p *fakeIdentityProvider) addRSAKey(kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(p.t, err)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys[kid] = key
+}
+
+func (p *fakeIdentityProvider) addECKey(kid string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(p.t, err)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys[kid] = key
+}
+
+func (p *fakeIdentityProvider) removeKey(kid string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.keys, kid)
+}
+
+func (p *fakeIdentityProvider) jwksRequests() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.requests
+}
+
+// sign returns a token with the claims, signed by the key with the given ID.
+func (p *fakeIdentityProvider) sign(kid string, claims map[string]interface{}) string {
+	p.mu.Lock()
+	key := p.keys[kid]
+	p.mu.Unlock()
+	alg := "RS256"
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		alg = "ES256"
+	}
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := crypto.SHA256.New()
+	digest.Write([]byte(signed))
+	var signature []byte
+	var err error
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest.Sum(nil))
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, digest.Sum(nil))
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	assert.Nil(p.t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func toJSONWebKey(kid string, key crypto.PublicKey) jsonWebKey {
+	encode := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return jsonWebKey{Kty: "RSA", Kid: kid, Use: "sig", N: encode(key.N), E: encode(big.NewInt(int64(key.E)))}
+	case *ecdsa.PublicKey:
+		return jsonWebKey{Kty: "EC", Kid: kid, Crv: "P-256", X: encode(key.X), Y: encode(key.Y)}
+	}
+	return jsonWebKey{}
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":    testIssuer,
+		"aud":    []string{"other", testAudience},
+		"sub":    "1234",
+		"email":  "user@example.com",
+		"groups": []string{"admins", "data"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"iat":    time.Now().Unix(),
+	}
+}
+
+func bearerContext(token string) context.Context {
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + token})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func newTestOIDCAuthenticator(t *testing.T, provider *fakeIdentityProvider, config OIDCConfig) *OIDCAuthenticator {
+	config.IssuerURL = testIssuer
+	config.Audience = testAudience
+	if config.JWKSURL == "" {
+		config.JWKSURL = provider.server.URL + "/keys"
+	}
+	authenticator, err := NewOIDCAuthenticator(
+		common.AuthorizationBearerTokenHeader, common.AuthorizationBearerTokenPrefix, config)
+	assert.Nil(t, err)
+	return authenticator
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	provider.addRSAKey("rsa")
+	provider.addECKey("ec")
+	authenticator := newTestOIDCAuthenticator(t, provider, OIDCConfig{
+		UsernameClaim:  "email",
+		UsernamePrefix: "oidc:",
+		GroupsPrefix:   "oidc:",
+	})
+
+	for _, kid := range []string{"rsa", "ec"} {
+		ctx := bearerContext(provider.sign(kid, validClaims()))
+		userInfo, err := authenticator.GetUserInfo(ctx)
+		assert.Nil(t, err, kid)
+		assert.Equal(t, &UserInfo{Name: "oidc:user@example.com", Groups: []string{"oidc:admins", "oidc:data"}}, userInfo, kid)
+
+		identity, err := authenticator.GetUserIdentity(ctx)
+		assert.Nil(t, err, kid)
+		assert.Equal(t, "oidc:user@example.com", identity, kid)
+	}
+	// The keys are cached.
+	assert.Equal(t, 1, provider.jwksRequests())
+}
+
+func TestOIDCAuthenticator_DiscoversJWKS(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	provider.addRSAKey("rsa")
+	authenticator, err := NewOIDCAuthenticator(
+		common.AuthorizationBearerTokenHeader, common.AuthorizationBearerTokenPrefix,
+		OIDCConfig{IssuerURL: provider.server.URL, Audience: testAudience})
+	assert.Nil(t, err)
+
+	claims := validClaims()
+	claims["iss"] = provider.server.URL
+	identity, err := authenticator.GetUserIdentity(bearerContext(provider.sign("rsa", claims)))
+	assert.Nil(t, err)
+	assert.Equal(t, provider.server.URL+"#1234", identity)
+}
+
+func TestOIDCAuthenticator_Prefixes(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	provider.addRSAKey("rsa")
+	token := provider.sign("rsa", validClaims())
+
+	// The users and groups are prefixed by the issuer by default.
+	userInfo, err := newTestOIDCAuthenticator(t, provider, OIDCConfig{}).GetUserInfo(bearerContext(token))
+	assert.Nil(t, err)
+	assert.Equal(t, &UserInfo{
+		Name:   testIssuer + "#1234",
+		Groups: []string{testIssuer + "#admins", testIssuer + "#data"},
+	}, userInfo)
+
+	userInfo, err = newTestOIDCAuthenticator(t, provider, OIDCConfig{UsernamePrefix: "-", GroupsPrefix: "-"}).GetUserInfo(bearerContext(token))
+	assert.Nil(t, err)
+	assert.Equal(t, &UserInfo{Name: "1234", Groups: []string{"admins", "data"}}, userInfo)
+}
+
+func TestOIDCAuthenticator_KeyRotation(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	provider.addRSAKey("old")
+	authenticator := newTestOIDCAuthenticator(t, provider, OIDCConfig{})
+	now := time.Now()
+	authenticator.keySet.now = func() time.Time { return now }
+
+	_, err := authenticator.GetUserIdentity(bearerContext(provider.sign("old", validClaims())))
+	assert.Nil(t, err)
+
+	provider.addRSAKey("new")
+	provider.removeKey("old")
+	token := provider.sign("new", validClaims())
+
+	// The JWKS was just fetched, so it is not fetched again for an unknown key.
+	_, err = authenticator.GetUserIdentity(bearerContext(token))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, provider.jwksRequests())
+
+	now = now.Add(minJWKSRefreshInterval)
+	_, err = authenticator.GetUserIdentity(bearerContext(token))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, provider.jwksRequests())
+
+	// The keys are fetched again after the refresh interval.
+	now = now.Add(defaultJWKSRefreshInterval)
+	_, err = authenticator.GetUserIdentity(bearerContext(token))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, provider.jwksRequests())
+}
+
+func TestOIDCAuthenticator_KeyRotationInProgress(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	provider.addRSAKey("old")
+	authenticator := newTestOIDCAuthenticator(t, provider, OIDCConfig{})
+	now := time.Now()
+	authenticator.keySet.now = func() time.Time { return now }
+	oldToken := provider.sign("old", validClaims())
+	_, err := authenticator.GetUserIdentity(bearerContext(oldToken))
+	assert.Nil(t, err)
+
+	provider.addRSAKey("new")
+	newToken := provider.sign("new", validClaims())
+	now = now.Add(minJWKSRefreshInterval)
+
+	// The identity provider is slow to serve the rotated keys.
+	provider.mu.Lock()
+	rotated := make(chan error)
+	go func() {
+		_, err := authenticator.GetUserIdentity(bearerContext(newToken))
+		rotated <- err
+	}()
+	assert.Eventually(t, func() bool {
+		authenticator.keySet.mu.Lock()
+		defer authenticator.keySet.mu.Unlock()
+		return authenticator.keySet.refreshing != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The tokens signed by the cached keys are verified meanwhile.
+	verified := make(chan error)
+	go func() {
+		_, err := authenticator.GetUserIdentity(bearerContext(oldToken))
+		verified <- err
+	}()
+	select {
+	case err := <-verified:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Error("The token signed by a cached key waited for the JWKS")
+	}
+	provider.mu.Unlock()
+	assert.Nil(t, <-rotated)
+	assert.Equal(t, 2, provider.jwksRequests())
+}
+
+func TestOIDCAuthenticator_InvalidToken(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	provider.addRSAKey("rsa")
+	provider.addECKey("ec")
+	other := newFakeIdentityProvider(t)
+	other.addRSAKey("rsa")
+	authenticator := newTestOIDCAuthenticator(t, provider, OIDCConfig{UsernameClaim: "email"})
+
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+	unsigned := func(claims map[string]interface{}) string {
+		header, _ := json.Marshal(map[string]string{"alg": "none"})
+		payload, _ := json.Marshal(claims)
+		return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"malformed", "not-a-token", "not a JWS"},
+		{"unsigned", unsigned(validClaims()), "unsupported signing algorithm"},
+		{"wrong key", other.sign("rsa", validClaims()), "invalid signature"},
+		{"wrong issuer", provider.sign("rsa", withClaim("iss", "https://other.example.com")), "unexpected issuer"},
+		{"wrong audience", provider.sign("ec", withClaim("aud", "other")), "does not contain"},
+		{"no expiry", provider.sign("rsa", withClaim("exp", nil)), "no expiry"},
+		{"expired", provider.sign("rsa", withClaim("exp", time.Now().Add(-time.Hour).Unix())), "expired"},
+		{"not yet valid", provider.sign("rsa", withClaim("nbf", time.Now().Add(time.Hour).Unix())), "not valid before"},
+		{"no username", provider.sign("rsa", withClaim("email", nil)), `no "email" claim`},
+		{"unverified email", provider.sign("rsa", withClaim("email_verified", false)), "not verified"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := authenticator.GetUserIdentity(bearerContext(test.token))
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), test.wantErr)
+		})
+	}
+}
+
+func TestOIDCAuthenticator_MissingHeader(t *testing.T) {
+	provider := newFakeIdentityProvider(t)
+	authenticator := newTestOIDCAuthenticator(t, provider, OIDCConfig{})
+
+	_, err := authenticator.GetUserIdentity(context.Background())
+	assert.Equal(t, IdentityHeaderMissingError, err)
+	assert.Equal(t, 0, provider.jwksRequests())
+}
+
+func TestNewOIDCAuthenticator_InvalidConfig(t *testing.T) {
+	_, err := NewOIDCAuthenticator(common.AuthorizationBearerTokenHeader, common.AuthorizationBearerTokenPrefix,
+		OIDCConfig{Audience: testAudience})
+	assert.NotNil(t, err)
+	_, err = NewOIDCAuthenticator(common.AuthorizationBearerTokenHeader, common.AuthorizationBearerTokenPrefix,
+		OIDCConfig{IssuerURL: testIssuer})
+	assert.NotNil(t, err)
+}
+
+func TestGetAuthenticators_OIDC(t *testing.T) {
+	viper.Set(common.Authenticators, "oidc, header")
+	viper.Set(common.OIDCIssuerURL, testIssuer)
+	viper.Set(common.OIDCAudience, testAudience)
+	viper.Set(common.OIDCGroupsClaim, "roles")
+	defer viper.Reset()
+
+	authenticators := GetAuthenticators(client.NewFakeTokenReviewClient())
+	assert.Len(t, authenticators, 2)
+	oidcAuthenticator, ok := authenticators[0].(*OIDCAuthenticator)
+	assert.True(t, ok)
+	assert.Equal(t, "roles", oidcAuthenticator.config.GroupsClaim)
+	assert.Equal(t, "sub", oidcAuthenticator.config.UsernameClaim)
+	_, ok = authenticators[1].(*HTTPHeaderAuthenticator)
+	assert.True(t, ok)
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// Authorizer decides whether an authenticated user can perform an action on a resource.
+type Authorizer interface {
+	// Authorize returns nil if the user is allowed to perform the action described by
+	// the resource attributes, and a PermissionDenied error otherwise.
+	Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error
+}
+
+// GetAuthorizer returns the authorizer selected by the AUTHORIZER config.
+func GetAuthorizer(subjectAccessReviewClient client.SubjectAccessReviewInterface) Authorizer {
+	switch name := common.GetAuthorizer(); name {
+	case common.SubjectAccessReviewAuthorizer:
+		return NewSubjectAccessReviewAuthorizer(subjectAccessReviewClient)
+	case common.RBACAuthorizer:
+		authorizer, err := NewRBACAuthorizerFromFile(common.GetStringConfig(common.RBACPolicyFile))
+		if err != nil {
+			glog.Fatalf("Failed to create the RBAC authorizer: %v", err)
+		}
+		return authorizer
+	default:
+		glog.Fatalf("Unknown authorizer %q in %s", name, common.Authorizer)
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// rbacWildcard matches any verb, resource or namespace in an RBAC policy.
+const rbacWildcard = "*"
+
+// RBACPolicy maps users and groups to the verbs they can perform on the resources of
+// the API server, per namespace. For example:
+//
+//	roles:
+//	- name: viewer
+//	  rules:
+//	  - resources: ["pipelines", "runs", "experiments", "jobs"]
+//	    verbs: ["get", "list"]
+//	bindings:
+//	- role: viewer
+//	  namespaces: ["team-a"]
+//	  users: ["alice@example.com"]
+//	  groups: ["team-a"]
+//
+// The wildcard "*" matches any resource, verb or namespace. Shared resources which belong
+// to no namespace, such as shared pipelines, are matched by the namespace "".
+type RBACPolicy struct {
+	Roles    []RBACRole    `json:"roles"`
+	Bindings []RBACBinding `json:"bindings"`
+}
+
+// RBACRole is a named set of rules.
+type RBACRole struct {
+	Name  string     `json:"name"`
+	Rules []RBACRule `json:"rules"`
+}
+
+// RBACRule allows the verbs on the resources.
+type RBACRule struct {
+	Resources []string `json:"resources"`
+	Verbs     []string `json:"verbs"`
+}
+
+// RBACBinding grants a role to users and groups in namespaces.
+type RBACBinding struct {
+	Role       string   `json:"role"`
+	Namespaces []string `json:"namespaces"`
+	Users      []string `json:"users,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+}
+
+// RBACAuthorizer authorizes requests with an RBACPolicy loaded from a config file,
+// without Kubernetes SubjectAccessReviews.
+type RBACAuthorizer struct {
+	policy *RBACPolicy
+	roles  map[string]*RBACRole
+}
+
+func NewRBACAuthorizer(policy *RBACPolicy) (*RBACAuthorizer, error) {
+	roles := make(map[string]*RBACRole, len(policy.Roles))
+	for i := range policy.Roles {
+		role := &policy.Roles[i]
+		if role.Name == "" {
+			return nil, errors.New("a role of the RBAC policy has no name")
+		}
+		if _, ok := roles[role.Name]; ok {
+			return nil, errors.Errorf("the role %q of the RBAC policy is defined twice", role.Name)
+		}
+		for _, rule := range role.Rules {
+			if len(rule.Resources) == 0 || len(rule.Verbs) == 0 {
+				return nil, errors.Errorf("a rule of the role %q of the RBAC policy has no resources or no verbs", role.Name)
+			}
+		}
+		roles[role.Name] = role
+	}
+	for _, binding := range policy.Bindings {
+		if _, ok := roles[binding.Role]; !ok {
+			return nil, errors.Errorf("a binding of the RBAC policy refers to the unknown role %q", binding.Role)
+		}
+		if len(binding.Namespaces) == 0 {
+			return nil, errors.Errorf("a binding of the role %q of the RBAC policy has no namespaces", binding.Role)
+		}
+		if len(binding.Users) == 0 && len(binding.Groups) == 0 {
+			return nil, errors.Errorf("a binding of the role %q of the RBAC policy has no users or groups", binding.Role)
+		}
+	}
+	return &RBACAuthorizer{policy: policy, roles: roles}, nil
+}
+
+// NewRBACAuthorizerFromFile loads the RBAC policy from a YAML or JSON file.
+func NewRBACAuthorizerFromFile(path string) (*RBACAuthorizer, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the RBAC policy %s", path)
+	}
+	policy := &RBACPolicy{}
+	if err := yaml.UnmarshalStrict(bytes, policy); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the RBAC policy %s", path)
+	}
+	return NewRBACAuthorizer(policy)
+}
+
+func (ra *RBACAuthorizer) Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	for _, binding := range ra.policy.Bindings {
+		if !binding.appliesTo(user) || !matches(binding.Namespaces, resourceAttributes.Namespace) {
+			continue
+		}
+		for _, rule := range ra.roles[binding.Role].Rules {
+			if matches(rule.Resources, resourceAttributes.Resource) && matches(rule.Verbs, resourceAttributes.Verb) {
+				return nil
+			}
+		}
+	}
+	err := util.NewPermissionDeniedError(
+		errors.New("Unauthorized access"),
+		"User '%s' is not authorized with reason: no binding of the RBAC policy allows it (request: %+v)",
+		user.Name,
+		resourceAttributes,
+	)
+	glog.Info(err.Error())
+	return err
+}
+
+func (b *RBACBinding) appliesTo(user *UserInfo) bool {
+	if contains(b.Users, user.Name) {
+		return true
+	}
+	for _, group := range user.Groups {
+		if contains(b.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether the values contain the value or the wildcard.
+func matches(values []string, value string) bool {
+	return contains(values, value) || contains(values, rbacWildcard)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
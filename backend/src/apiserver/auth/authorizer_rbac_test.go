@@ -0,0 +1,121 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const testRBACPolicy = `
+roles:
+- name: viewer
+  rules:
+  - resources: ["pipelines", "runs", "experiments"]
+    verbs: ["get", "list"]
+- name: editor
+  rules:
+  - resources: ["*"]
+    verbs: ["*"]
+bindings:
+- role: viewer
+  namespaces: ["*"]
+  groups: ["everyone"]
+- role: editor
+  namespaces: ["team-a"]
+  users: ["alice@example.com"]
+  groups: ["team-a-editors"]
+`
+
+func writeRBACPolicy(t *testing.T, policy string) string {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(policy), 0644))
+	return path
+}
+
+func TestRBACAuthorizer(t *testing.T) {
+	authorizer, err := NewRBACAuthorizerFromFile(writeRBACPolicy(t, testRBACPolicy))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name      string
+		user      *UserInfo
+		namespace string
+		resource  string
+		verb      string
+		allowed   bool
+	}{
+		{"user binding", &UserInfo{Name: "alice@example.com"}, "team-a", common.RbacResourceTypeRuns, common.RbacResourceVerbDelete, true},
+		{"user binding in another namespace", &UserInfo{Name: "alice@example.com"}, "team-b", common.RbacResourceTypeRuns, common.RbacResourceVerbDelete, false},
+		{"group binding", &UserInfo{Name: "bob@example.com", Groups: []string{"team-a-editors"}}, "team-a", common.RbacResourceTypeJobs, common.RbacResourceVerbCreate, true},
+		{"wildcard namespace", &UserInfo{Name: "carol@example.com", Groups: []string{"everyone"}}, "team-b", common.RbacResourceTypePipelines, common.RbacResourceVerbList, true},
+		{"shared resources", &UserInfo{Name: "carol@example.com", Groups: []string{"everyone"}}, "", common.RbacResourceTypePipelines, common.RbacResourceVerbGet, true},
+		{"verb not allowed", &UserInfo{Name: "carol@example.com", Groups: []string{"everyone"}}, "team-b", common.RbacResourceTypeRuns, common.RbacResourceVerbCreate, false},
+		{"resource not allowed", &UserInfo{Name: "carol@example.com", Groups: []string{"everyone"}}, "team-b", common.RbacResourceTypeJobs, common.RbacResourceVerbGet, false},
+		{"no binding", &UserInfo{Name: "dave@example.com", Groups: []string{"other"}}, "team-a", common.RbacResourceTypeRuns, common.RbacResourceVerbGet, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.Authorize(context.Background(), test.user, &authorizationv1.ResourceAttributes{
+				Namespace: test.namespace,
+				Verb:      test.verb,
+				Group:     common.RbacPipelinesGroup,
+				Version:   common.RbacPipelinesVersion,
+				Resource:  test.resource,
+			})
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+				assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).ExternalStatusCode())
+				assert.Contains(t, err.Error(), "no binding of the RBAC policy allows it")
+			}
+		})
+	}
+}
+
+func TestNewRBACAuthorizerFromFile_InvalidPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{"unknown field", "roles: []\nusers: []", "failed to parse"},
+		{"unnamed role", "roles:\n- rules: []", "has no name"},
+		{"duplicate role", "roles:\n- name: a\n- name: a", "defined twice"},
+		{"empty rule", "roles:\n- name: a\n  rules:\n  - verbs: [get]", "no resources or no verbs"},
+		{"unknown role", "bindings:\n- role: a\n  namespaces: ['*']\n  users: [alice]", "unknown role"},
+		{"no namespaces", "roles:\n- name: a\nbindings:\n- role: a\n  users: [alice]", "no namespaces"},
+		{"no subjects", "roles:\n- name: a\nbindings:\n- role: a\n  namespaces: ['*']", "no users or groups"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewRBACAuthorizerFromFile(writeRBACPolicy(t, test.policy))
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.wantErr)
+		})
+	}
+
+	_, err := NewRBACAuthorizerFromFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubjectAccessReviewAuthorizer authorizes requests with Kubernetes SubjectAccessReviews,
+// so that the Kubernetes RBAC of the cluster applies to the resources of the API server.
+type SubjectAccessReviewAuthorizer struct {
+	client client.SubjectAccessReviewInterface
+}
+
+func NewSubjectAccessReviewAuthorizer(subjectAccessReviewClient client.SubjectAccessReviewInterface) *SubjectAccessReviewAuthorizer {
+	return &SubjectAccessReviewAuthorizer{client: subjectAccessReviewClient}
+}
+
+func (sa *SubjectAccessReviewAuthorizer) Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	result, err := sa.client.Create(
+		ctx,
+		&authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: resourceAttributes,
+				User:               user.Name,
+				Groups:             user.Groups,
+			},
+		},
+		v1.CreateOptions{},
+	)
+	if err != nil {
+		if err, ok := err.(net.Error); ok && err.Timeout() {
+			reportErr := util.NewUnavailableServerError(
+				err,
+				"Failed to create SubjectAccessReview for user '%s' (request: %+v) - try again later",
+				user.Name,
+				resourceAttributes,
+			)
+			glog.Info(reportErr.Error())
+			return reportErr
+		} else {
+			reportErr := util.NewInternalServerError(
+				err,
+				"Failed to create SubjectAccessReview for user '%s' (request: %+v)",
+				user.Name,
+				resourceAttributes,
+			)
+			glog.Info(reportErr.Error())
+			return reportErr
+		}
+	}
+	if !result.Status.Allowed {
+		err := util.NewPermissionDeniedError(
+			errors.New("Unauthorized access"),
+			"User '%s' is not authorized with reason: %s (request: %+v)",
+			user.Name,
+			result.Status.Reason,
+			resourceAttributes,
+		)
+		glog.Info(err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// recordingSubjectAccessReviewClient records the SubjectAccessReviews it allows.
+type recordingSubjectAccessReviewClient struct {
+	reviews []*authorizationv1.SubjectAccessReview
+}
+
+func (c *recordingSubjectAccessReviewClient) Create(ctx context.Context, review *authorizationv1.SubjectAccessReview, opts v1.CreateOptions) (*authorizationv1.SubjectAccessReview, error) {
+	c.reviews = append(c.reviews, review)
+	return &authorizationv1.SubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+}
+
+var testResourceAttributes = &authorizationv1.ResourceAttributes{
+	Namespace: "ns1",
+	Verb:      common.RbacResourceVerbGet,
+	Group:     common.RbacPipelinesGroup,
+	Version:   common.RbacPipelinesVersion,
+	Resource:  common.RbacResourceTypeRuns,
+}
+
+func TestSubjectAccessReviewAuthorizer(t *testing.T) {
+	reviewClient := &recordingSubjectAccessReviewClient{}
+	authorizer := NewSubjectAccessReviewAuthorizer(reviewClient)
+
+	err := authorizer.Authorize(context.Background(),
+		&UserInfo{Name: "user@example.com", Groups: []string{"admins"}}, testResourceAttributes)
+	assert.Nil(t, err)
+	assert.Equal(t, []*authorizationv1.SubjectAccessReview{{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: testResourceAttributes,
+			User:               "user@example.com",
+			Groups:             []string{"admins"},
+		},
+	}}, reviewClient.reviews)
+}
+
+func TestSubjectAccessReviewAuthorizer_Unauthorized(t *testing.T) {
+	authorizer := NewSubjectAccessReviewAuthorizer(client.NewFakeSubjectAccessReviewClientUnauthorized())
+
+	err := authorizer.Authorize(context.Background(), &UserInfo{Name: "user@example.com"}, testResourceAttributes)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "this is not allowed")
+}
@@ -0,0 +1,311 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// The default time after which the keys of the JWKS are fetched again.
+	defaultJWKSRefreshInterval = time.Hour
+	// The minimum time between two fetches of the JWKS, to avoid fetching it for
+	// each token signed with an unknown key.
+	minJWKSRefreshInterval = 10 * time.Second
+	jwksRequestTimeout     = 10 * time.Second
+)
+
+// jsonWebKey is a public key of a JSON Web Key Set (RFC 7517).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// publicKey is a parsed key of the JWKS.
+type publicKey struct {
+	kid string
+	key crypto.PublicKey
+}
+
+// remoteKeySet caches the keys of a remote JWKS. The keys are fetched again after
+// the refresh interval, or when a token is signed by an unknown key because the
+// identity provider rotated its keys.
+type remoteKeySet struct {
+	// jwksURL is the URL of the JWKS. When empty, it is discovered from the OpenID
+	// configuration of the issuer.
+	jwksURL         string
+	issuerURL       string
+	refreshInterval time.Duration
+	client          *http.Client
+
+	mu        sync.Mutex
+	keys      []publicKey
+	fetchedAt time.Time
+	now       func() time.Time
+	// refreshing is closed when the refresh in progress, if any, completes with
+	// refreshErr.
+	refreshing chan struct{}
+	refreshErr error
+}
+
+func newRemoteKeySet(issuerURL, jwksURL string, refreshInterval time.Duration) *remoteKeySet {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+	return &remoteKeySet{
+		jwksURL:         jwksURL,
+		issuerURL:       issuerURL,
+		refreshInterval: refreshInterval,
+		client:          &http.Client{Timeout: jwksRequestTimeout},
+		now:             time.Now,
+	}
+}
+
+// keysFor returns the keys which may have signed a token with the given key ID. All the
+// keys are returned when the token has no key ID.
+func (s *remoteKeySet) keysFor(ctx context.Context, kid string) ([]publicKey, error) {
+	s.mu.Lock()
+	now := s.now()
+	stale := s.keys == nil || now.Sub(s.fetchedAt) >= s.refreshInterval
+	s.mu.Unlock()
+	if stale {
+		if err := s.refresh(ctx); err != nil {
+			s.mu.Lock()
+			cached := s.keys != nil
+			s.mu.Unlock()
+			if !cached {
+				return nil, err
+			}
+			glog.Warningf("Failed to refresh the JWKS, using the cached keys: %v", err)
+		}
+	}
+	s.mu.Lock()
+	keys := s.matchingKeys(kid)
+	rotated := len(keys) == 0 && now.Sub(s.fetchedAt) >= minJWKSRefreshInterval
+	s.mu.Unlock()
+	if rotated {
+		// The identity provider may have rotated its keys.
+		if err := s.refresh(ctx); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		keys = s.matchingKeys(kid)
+		s.mu.Unlock()
+	}
+	if len(keys) == 0 {
+		return nil, errors.Errorf("no key of the JWKS matches the key ID %q", kid)
+	}
+	return keys, nil
+}
+
+// matchingKeys returns the keys with a key ID. The caller must hold the lock.
+func (s *remoteKeySet) matchingKeys(kid string) []publicKey {
+	if kid == "" {
+		return s.keys
+	}
+	var result []publicKey
+	for _, key := range s.keys {
+		if key.kid == kid {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// refresh fetches the keys of the JWKS and swaps them in. The lock is not held
+// while the JWKS is fetched, so that the tokens signed by the cached keys are
+// verified meanwhile. A refresh already in progress is waited for instead of
+// fetching the JWKS again.
+func (s *remoteKeySet) refresh(ctx context.Context) error {
+	s.mu.Lock()
+	if done := s.refreshing; done != nil {
+		s.mu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.refreshErr
+	}
+	done := make(chan struct{})
+	s.refreshing = done
+	// Record the attempt even if it fails, so that a failing identity provider is not
+	// queried for each request.
+	s.fetchedAt = s.now()
+	jwksURL := s.jwksURL
+	s.mu.Unlock()
+
+	keys, jwksURL, err := s.fetch(ctx, jwksURL)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		s.keys = keys
+		s.jwksURL = jwksURL
+	}
+	s.refreshErr = err
+	s.refreshing = nil
+	close(done)
+	return err
+}
+
+// fetch fetches the keys of the JWKS, discovering its URL if it is empty. It
+// returns the keys and the URL of the JWKS.
+func (s *remoteKeySet) fetch(ctx context.Context, jwksURL string) ([]publicKey, string, error) {
+	if jwksURL == "" {
+		var err error
+		if jwksURL, err = s.discoverJWKSURL(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+	var keySet jsonWebKeySet
+	if err := s.getJSON(ctx, jwksURL, &keySet); err != nil {
+		return nil, "", errors.Wrap(err, "failed to fetch the JWKS")
+	}
+	keys := make([]publicKey, 0, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			glog.Warningf("Ignoring key %q of the JWKS %s: %v", jwk.Kid, jwksURL, err)
+			continue
+		}
+		keys = append(keys, publicKey{kid: jwk.Kid, key: key})
+	}
+	return keys, jwksURL, nil
+}
+
+// discoverJWKSURL reads the JWKS URL from the OpenID configuration of the issuer.
+func (s *remoteKeySet) discoverJWKSURL(ctx context.Context) (string, error) {
+	configURL := strings.TrimSuffix(s.issuerURL, "/") + "/.well-known/openid-configuration"
+	var config struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := s.getJSON(ctx, configURL, &config); err != nil {
+		return "", errors.Wrap(err, "failed to fetch the OpenID configuration of the issuer")
+	}
+	if config.JWKSURI == "" {
+		return "", errors.Errorf("the OpenID configuration %s has no jwks_uri", configURL)
+	}
+	return config.JWKSURI, nil
+}
+
+func (s *remoteKeySet) getJSON(ctx context.Context, url string, value interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("GET %s returned %s", url, response.Status)
+	}
+	return json.Unmarshal(body, value)
+}
+
+// publicKey parses an RSA or EC public key.
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid modulus")
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid exponent")
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid x coordinate")
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
@@ -20,11 +20,14 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 type FakeKuberneteCoreClient struct {
 	podClientFake *FakePodClient
+	ClientSetFake *fake.Clientset
 }
 
 func (c *FakeKuberneteCoreClient) PodClient(namespace string) v1.PodInterface {
@@ -34,8 +37,20 @@ func (c *FakeKuberneteCoreClient) PodClient(namespace string) v1.PodInterface {
 	return c.podClientFake
 }
 
+// SetPodLog sets the log of the main container of a pod.
+func (c *FakeKuberneteCoreClient) SetPodLog(podName string, log string) {
+	if c.podClientFake.logs == nil {
+		c.podClientFake.logs = make(map[string]string)
+	}
+	c.podClientFake.logs[podName] = log
+}
+
+func (c *FakeKuberneteCoreClient) GetClientSet() kubernetes.Interface {
+	return c.ClientSetFake
+}
+
 func NewFakeKuberneteCoresClient() *FakeKuberneteCoreClient {
-	return &FakeKuberneteCoreClient{&FakePodClient{}}
+	return &FakeKuberneteCoreClient{podClientFake: &FakePodClient{}, ClientSetFake: fake.NewSimpleClientset()}
 }
 
 type FakeKubernetesCoreClientWithBadPodClient struct {
@@ -50,6 +65,10 @@ func (c *FakeKubernetesCoreClientWithBadPodClient) PodClient(namespace string) v
 	return c.podClientFake
 }
 
+func (c *FakeKubernetesCoreClientWithBadPodClient) GetClientSet() kubernetes.Interface {
+	return fake.NewSimpleClientset()
+}
+
 func (c *FakePodClient) EvictV1(context.Context, *policyv1.Eviction) error {
 	return nil
 }
@@ -0,0 +1,180 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// PipelineRunContextTypeName is the MLMD context type of a KFP v2 run. The
+// context name is the run ID.
+const PipelineRunContextTypeName = "system.PipelineRun"
+
+// MetadataClientInterface is the subset of the ML Metadata store API used by the
+// API server to look up v2 artifacts.
+type MetadataClientInterface interface {
+	// GetArtifactsByID returns the artifacts with the given IDs. Unknown IDs are ignored.
+	GetArtifactsByID(ctx context.Context, ids []int64) ([]*pb.Artifact, error)
+	// GetRunContext returns the context of a v2 run, or a not found error.
+	GetRunContext(ctx context.Context, runID string) (*pb.Context, error)
+	GetContextsByExecution(ctx context.Context, executionID int64) ([]*pb.Context, error)
+	GetExecutionsByContext(ctx context.Context, contextID int64) ([]*pb.Execution, error)
+	GetArtifactsByContext(ctx context.Context, contextID int64) ([]*pb.Artifact, error)
+	GetEventsByExecutionIDs(ctx context.Context, ids []int64) ([]*pb.Event, error)
+	GetEventsByArtifactIDs(ctx context.Context, ids []int64) ([]*pb.Event, error)
+	// GetContextsByType returns all contexts of a type, e.g. the contexts of all v2 runs.
+	GetContextsByType(ctx context.Context, typeName string) ([]*pb.Context, error)
+	// UpdateArtifactsState sets the state of the given artifacts.
+	UpdateArtifactsState(ctx context.Context, artifacts []*pb.Artifact, state pb.Artifact_State) error
+}
+
+type MetadataClient struct {
+	svc pb.MetadataStoreServiceClient
+}
+
+func (c *MetadataClient) GetArtifactsByID(ctx context.Context, ids []int64) ([]*pb.Artifact, error) {
+	res, err := c.svc.GetArtifactsByID(ctx, &pb.GetArtifactsByIDRequest{ArtifactIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get artifacts %v from ML Metadata", ids)
+	}
+	return res.GetArtifacts(), nil
+}
+
+func (c *MetadataClient) GetRunContext(ctx context.Context, runID string) (*pb.Context, error) {
+	res, err := c.svc.GetContextByTypeAndName(ctx, &pb.GetContextByTypeAndNameRequest{
+		TypeName:    proto.String(PipelineRunContextTypeName),
+		ContextName: proto.String(runID),
+	})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata context of run %v", runID)
+	}
+	if res.GetContext() == nil {
+		return nil, util.NewResourceNotFoundError("ML Metadata run context", runID)
+	}
+	return res.GetContext(), nil
+}
+
+func (c *MetadataClient) GetContextsByExecution(ctx context.Context, executionID int64) ([]*pb.Context, error) {
+	res, err := c.svc.GetContextsByExecution(ctx, &pb.GetContextsByExecutionRequest{ExecutionId: proto.Int64(executionID)})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata contexts of execution %v", executionID)
+	}
+	return res.GetContexts(), nil
+}
+
+func (c *MetadataClient) GetExecutionsByContext(ctx context.Context, contextID int64) ([]*pb.Execution, error) {
+	res, err := c.svc.GetExecutionsByContext(ctx, &pb.GetExecutionsByContextRequest{ContextId: proto.Int64(contextID)})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata executions of context %v", contextID)
+	}
+	return res.GetExecutions(), nil
+}
+
+func (c *MetadataClient) GetArtifactsByContext(ctx context.Context, contextID int64) ([]*pb.Artifact, error) {
+	res, err := c.svc.GetArtifactsByContext(ctx, &pb.GetArtifactsByContextRequest{ContextId: proto.Int64(contextID)})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata artifacts of context %v", contextID)
+	}
+	return res.GetArtifacts(), nil
+}
+
+func (c *MetadataClient) GetEventsByExecutionIDs(ctx context.Context, ids []int64) ([]*pb.Event, error) {
+	res, err := c.svc.GetEventsByExecutionIDs(ctx, &pb.GetEventsByExecutionIDsRequest{ExecutionIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata events of executions %v", ids)
+	}
+	return res.GetEvents(), nil
+}
+
+func (c *MetadataClient) GetEventsByArtifactIDs(ctx context.Context, ids []int64) ([]*pb.Event, error) {
+	res, err := c.svc.GetEventsByArtifactIDs(ctx, &pb.GetEventsByArtifactIDsRequest{ArtifactIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata events of artifacts %v", ids)
+	}
+	return res.GetEvents(), nil
+}
+
+func (c *MetadataClient) GetContextsByType(ctx context.Context, typeName string) ([]*pb.Context, error) {
+	var contexts []*pb.Context
+	options := &pb.ListOperationOptions{MaxResultSize: proto.Int32(100)}
+	for {
+		res, err := c.svc.GetContextsByType(ctx, &pb.GetContextsByTypeRequest{TypeName: proto.String(typeName), Options: options})
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the ML Metadata contexts of type %v", typeName)
+		}
+		contexts = append(contexts, res.GetContexts()...)
+		if res.GetNextPageToken() == "" {
+			return contexts, nil
+		}
+		options.NextPageToken = res.NextPageToken
+	}
+}
+
+func (c *MetadataClient) UpdateArtifactsState(ctx context.Context, artifacts []*pb.Artifact, state pb.Artifact_State) error {
+	if len(artifacts) == 0 {
+		return nil
+	}
+	updated := make([]*pb.Artifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		artifact = proto.Clone(artifact).(*pb.Artifact)
+		artifact.State = state.Enum()
+		updated = append(updated, artifact)
+	}
+	if _, err := c.svc.PutArtifacts(ctx, &pb.PutArtifactsRequest{Artifacts: updated}); err != nil {
+		return util.NewInternalServerError(err, "Failed to update the state of %v ML Metadata artifacts", len(artifacts))
+	}
+	return nil
+}
+
+func createMetadataClient(address string, port string) (MetadataClientInterface, error) {
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%s", address, port), grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return &MetadataClient{svc: pb.NewMetadataStoreServiceClient(conn)}, nil
+}
+
+// CreateMetadataClientOrFatal creates a new client for the ML Metadata gRPC service.
+// When address or port are empty, the in-cluster metadata-grpc-service is used.
+func CreateMetadataClientOrFatal(address string, port string, initConnectionTimeout time.Duration) MetadataClientInterface {
+	if address == "" || port == "" {
+		defaultConfig := metadata.DefaultConfig()
+		address, port = defaultConfig.Address, defaultConfig.Port
+	}
+	var client MetadataClientInterface
+	var err error
+	operation := func() error {
+		client, err = createMetadataClient(address, port)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+
+	if err != nil {
+		glog.Fatalf("Failed to create ML Metadata client. Error: %v", err)
+	}
+	return client
+}
@@ -0,0 +1,242 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// FakeMetadataClient is an in-memory ML Metadata store.
+type FakeMetadataClient struct {
+	mu                  sync.Mutex
+	nextID              int64
+	contexts            map[int64]*pb.Context
+	executions          map[int64]*pb.Execution
+	artifacts           map[int64]*pb.Artifact
+	events              []*pb.Event
+	executionContextIDs map[int64][]int64
+	artifactContextIDs  map[int64][]int64
+}
+
+func NewFakeMetadataClient() *FakeMetadataClient {
+	return &FakeMetadataClient{
+		contexts:            make(map[int64]*pb.Context),
+		executions:          make(map[int64]*pb.Execution),
+		artifacts:           make(map[int64]*pb.Artifact),
+		executionContextIDs: make(map[int64][]int64),
+		artifactContextIDs:  make(map[int64][]int64),
+	}
+}
+
+func (c *FakeMetadataClient) newID() int64 {
+	c.nextID++
+	return c.nextID
+}
+
+// AddRunContext creates the context of a v2 run of the pipeline named "pipeline",
+// whose artifacts are stored under pipelineRoot.
+func (c *FakeMetadataClient) AddRunContext(runID string, pipelineRoot string) *pb.Context {
+	return c.AddRunContextInNamespace(runID, "", pipelineRoot)
+}
+
+// AddRunContextInNamespace creates the context of a v2 run in a namespace.
+func (c *FakeMetadataClient) AddRunContextInNamespace(runID string, namespace string, pipelineRoot string) *pb.Context {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	context := &pb.Context{
+		Id:   proto.Int64(c.newID()),
+		Name: proto.String(runID),
+		Type: proto.String(PipelineRunContextTypeName),
+		CustomProperties: map[string]*pb.Value{
+			"namespace":     {Value: &pb.Value_StringValue{StringValue: namespace}},
+			"pipeline_root": {Value: &pb.Value_StringValue{StringValue: strings.TrimRight(pipelineRoot, "/") + "/pipeline/" + runID}},
+		},
+	}
+	c.contexts[context.GetId()] = context
+	return context
+}
+
+// AddExecution creates an execution for a task and associates it with the given contexts.
+func (c *FakeMetadataClient) AddExecution(taskName string, contextIDs ...int64) *pb.Execution {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	execution := &pb.Execution{
+		Id: proto.Int64(c.newID()),
+		CustomProperties: map[string]*pb.Value{
+			"task_name": {Value: &pb.Value_StringValue{StringValue: taskName}},
+		},
+	}
+	c.executions[execution.GetId()] = execution
+	c.executionContextIDs[execution.GetId()] = contextIDs
+	return execution
+}
+
+// AddOutputArtifact creates an artifact with the given URI and records it as the
+// output named outputName of the execution and as attributed to the execution contexts.
+func (c *FakeMetadataClient) AddOutputArtifact(executionID int64, outputName string, uri string) *pb.Artifact {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	artifact := &pb.Artifact{
+		Id:  proto.Int64(c.newID()),
+		Uri: proto.String(uri),
+	}
+	c.artifacts[artifact.GetId()] = artifact
+	c.artifactContextIDs[artifact.GetId()] = c.executionContextIDs[executionID]
+	c.events = append(c.events, &pb.Event{
+		ArtifactId:  proto.Int64(artifact.GetId()),
+		ExecutionId: proto.Int64(executionID),
+		Type:        pb.Event_OUTPUT.Enum(),
+		Path: &pb.Event_Path{Steps: []*pb.Event_Path_Step{{
+			Value: &pb.Event_Path_Step_Key{Key: outputName},
+		}}},
+	})
+	return artifact
+}
+
+// AddInputEvent records an existing artifact as the input named inputName of the execution.
+func (c *FakeMetadataClient) AddInputEvent(executionID int64, artifactID int64, inputName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, &pb.Event{
+		ArtifactId:  proto.Int64(artifactID),
+		ExecutionId: proto.Int64(executionID),
+		Type:        pb.Event_INPUT.Enum(),
+		Path: &pb.Event_Path{Steps: []*pb.Event_Path_Step{{
+			Value: &pb.Event_Path_Step_Key{Key: inputName},
+		}}},
+	})
+}
+
+func (c *FakeMetadataClient) GetArtifactsByID(ctx context.Context, ids []int64) ([]*pb.Artifact, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var artifacts []*pb.Artifact
+	for _, id := range ids {
+		if artifact, ok := c.artifacts[id]; ok {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts, nil
+}
+
+func (c *FakeMetadataClient) GetRunContext(ctx context.Context, runID string) (*pb.Context, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, context := range c.contexts {
+		if context.GetType() == PipelineRunContextTypeName && context.GetName() == runID {
+			return context, nil
+		}
+	}
+	return nil, util.NewResourceNotFoundError("ML Metadata run context", runID)
+}
+
+func (c *FakeMetadataClient) GetContextsByExecution(ctx context.Context, executionID int64) ([]*pb.Context, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var contexts []*pb.Context
+	for _, id := range c.executionContextIDs[executionID] {
+		contexts = append(contexts, c.contexts[id])
+	}
+	return contexts, nil
+}
+
+func (c *FakeMetadataClient) GetExecutionsByContext(ctx context.Context, contextID int64) ([]*pb.Execution, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var executions []*pb.Execution
+	for id, contextIDs := range c.executionContextIDs {
+		if containsID(contextIDs, contextID) {
+			executions = append(executions, c.executions[id])
+		}
+	}
+	return executions, nil
+}
+
+func (c *FakeMetadataClient) GetArtifactsByContext(ctx context.Context, contextID int64) ([]*pb.Artifact, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var artifacts []*pb.Artifact
+	for id, contextIDs := range c.artifactContextIDs {
+		if containsID(contextIDs, contextID) {
+			artifacts = append(artifacts, c.artifacts[id])
+		}
+	}
+	return artifacts, nil
+}
+
+func (c *FakeMetadataClient) GetEventsByExecutionIDs(ctx context.Context, ids []int64) ([]*pb.Event, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var events []*pb.Event
+	for _, event := range c.events {
+		if containsID(ids, event.GetExecutionId()) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (c *FakeMetadataClient) GetEventsByArtifactIDs(ctx context.Context, ids []int64) ([]*pb.Event, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var events []*pb.Event
+	for _, event := range c.events {
+		if containsID(ids, event.GetArtifactId()) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (c *FakeMetadataClient) GetContextsByType(ctx context.Context, typeName string) ([]*pb.Context, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var contexts []*pb.Context
+	for _, context := range c.contexts {
+		if context.GetType() == typeName {
+			contexts = append(contexts, context)
+		}
+	}
+	return contexts, nil
+}
+
+func (c *FakeMetadataClient) UpdateArtifactsState(ctx context.Context, artifacts []*pb.Artifact, state pb.Artifact_State) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, artifact := range artifacts {
+		stored, ok := c.artifacts[artifact.GetId()]
+		if !ok {
+			return util.NewResourceNotFoundError("ML Metadata artifact", fmt.Sprint(artifact.GetId()))
+		}
+		stored.State = state.Enum()
+	}
+	return nil
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
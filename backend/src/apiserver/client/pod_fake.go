@@ -3,6 +3,10 @@ package client
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/golang/glog"
 	corev1 "k8s.io/api/core/v1"
@@ -12,9 +16,13 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	applyv1 "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
 )
 
-type FakePodClient struct{}
+type FakePodClient struct {
+	// logs are the logs of the main containers of the pods, by pod name.
+	logs map[string]string
+}
 
 func (FakePodClient) UpdateEphemeralContainers(context.Context, string, *corev1.Pod, v1.UpdateOptions) (*corev1.Pod, error) {
 	glog.Error("This fake method is not yet implemented.")
@@ -85,9 +93,26 @@ func (FakePodClient) Evict(ctx context.Context, eviction *v1beta1.Eviction) erro
 	return nil
 }
 
-func (FakePodClient) GetLogs(name string, opts *corev1.PodLogOptions) *rest.Request {
-	glog.Error("This fake method is not yet implemented")
-	return nil
+// GetLogs returns a request streaming the log of a pod set by SetPodLog, or
+// failing with 404 Not Found.
+func (c FakePodClient) GetLogs(name string, opts *corev1.PodLogOptions) *rest.Request {
+	httpClient := fakerest.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+		log, ok := c.logs[name]
+		if !ok {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(log)),
+		}, nil
+	})
+	return rest.NewRequestWithClient(&url.URL{Scheme: "http", Host: "localhost"}, "", rest.ClientContentConfig{}, httpClient).
+		Resource("pods").Name(name).SubResource("log")
 }
 
 func (FakePodClient) ProxyGet(scheme, name, port, path string, params map[string]string) rest.ResponseWrapper {
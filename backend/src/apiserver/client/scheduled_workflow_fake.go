@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
@@ -29,6 +30,7 @@ import (
 
 type FakeScheduledWorkflowClient struct {
 	scheduledWorkflows map[string]*v1beta1.ScheduledWorkflow
+	resourceVersion    int
 }
 
 func NewScheduledWorkflowClientFake() *FakeScheduledWorkflowClient {
@@ -66,9 +68,20 @@ func (c *FakeScheduledWorkflowClient) Get(ctx context.Context, name string, opti
 	return nil, k8errors.NewNotFound(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), name)
 }
 
-func (c *FakeScheduledWorkflowClient) Update(context.Context, *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
-	glog.Error("This fake method is not yet implemented.")
-	return nil, nil
+func (c *FakeScheduledWorkflowClient) Update(ctx context.Context, scheduledWorkflow *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
+	existing, ok := c.scheduledWorkflows[scheduledWorkflow.Name]
+	if !ok {
+		return nil, k8errors.NewNotFound(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), scheduledWorkflow.Name)
+	}
+	// Like the API server, reject the updates of a stale scheduled workflow.
+	if existing.ResourceVersion != scheduledWorkflow.ResourceVersion {
+		return nil, k8errors.NewConflict(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), scheduledWorkflow.Name,
+			errors.New("the object has been modified"))
+	}
+	c.resourceVersion++
+	scheduledWorkflow.ResourceVersion = strconv.Itoa(c.resourceVersion)
+	c.scheduledWorkflows[scheduledWorkflow.Name] = scheduledWorkflow
+	return scheduledWorkflow, nil
 }
 
 func (c *FakeScheduledWorkflowClient) DeleteCollection(ctx context.Context, options *v1.DeleteOptions, listOptions v1.ListOptions) error {
@@ -105,3 +118,7 @@ func (FakeBadScheduledWorkflowClient) Patch(ctx context.Context, name string, pt
 func (c *FakeBadScheduledWorkflowClient) Delete(ctx context.Context, name string, options *v1.DeleteOptions) error {
 	return errors.New("some error")
 }
+
+func (FakeBadScheduledWorkflowClient) Update(ctx context.Context, scheduledWorkflow *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
+	return nil, errors.New("some error")
+}
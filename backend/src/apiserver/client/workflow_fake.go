@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/golang/glog"
@@ -32,6 +33,8 @@ import (
 )
 
 type FakeWorkflowClient struct {
+	// mutex guards the workflows, which can be created concurrently.
+	mutex           sync.Mutex
 	workflows       map[string]*v1alpha1.Workflow
 	lastGeneratedId int
 }
@@ -48,6 +51,8 @@ func (c *FakeWorkflowClient) Create(ctx context.Context, execSpec util.Execution
 	if !ok {
 		return nil, fmt.Errorf("not a valid ExecutionSpec for Workflow")
 	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	if workflow.GenerateName != "" {
 		c.lastGeneratedId += 1
 		workflow.Name = workflow.GenerateName + strconv.Itoa(c.lastGeneratedId)
@@ -58,6 +63,8 @@ func (c *FakeWorkflowClient) Create(ctx context.Context, execSpec util.Execution
 }
 
 func (c *FakeWorkflowClient) Get(ctx context.Context, name string, options v1.GetOptions) (util.ExecutionSpec, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	workflow, ok := c.workflows[name]
 	if ok {
 		return util.NewWorkflow(workflow), nil
@@ -148,11 +155,11 @@ type FakeBadWorkflowClient struct {
 	FakeWorkflowClient
 }
 
-func (FakeBadWorkflowClient) Create(context.Context, util.ExecutionSpec, v1.CreateOptions) (util.ExecutionSpec, error) {
+func (c *FakeBadWorkflowClient) Create(context.Context, util.ExecutionSpec, v1.CreateOptions) (util.ExecutionSpec, error) {
 	return nil, errors.New("some error")
 }
 
-func (FakeBadWorkflowClient) Get(ctx context.Context, name string, options v1.GetOptions) (util.ExecutionSpec, error) {
+func (c *FakeBadWorkflowClient) Get(ctx context.Context, name string, options v1.GetOptions) (util.ExecutionSpec, error) {
 	return nil, errors.New("some error")
 }
 
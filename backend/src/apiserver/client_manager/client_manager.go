@@ -58,15 +58,25 @@ const (
 	postgresPassword = "DBConfig.PostgreSQLConfig.Password"
 	postgresDBName   = "DBConfig.PostgreSQLConfig.DBName"
 
-	archiveLogFileName   = "ARCHIVE_CONFIG_LOG_FILE_NAME"
-	archiveLogPathPrefix = "ARCHIVE_CONFIG_LOG_PATH_PREFIX"
-	dbConMaxLifeTime     = "DBConfig.ConMaxLifeTime"
+	archiveLogFileName        = "ARCHIVE_CONFIG_LOG_FILE_NAME"
+	archiveLogPathPrefix      = "ARCHIVE_CONFIG_LOG_PATH_PREFIX"
+	archiveLogBackend         = "ARCHIVE_CONFIG_LOG_BACKEND"
+	archiveLogLokiAddress     = "ARCHIVE_CONFIG_LOKI_ADDRESS"
+	archiveLogLokiQuery       = "ARCHIVE_CONFIG_LOKI_QUERY"
+	archiveLogLokiOrgId       = "ARCHIVE_CONFIG_LOKI_ORG_ID"
+	archiveLogLokiLimit       = "ARCHIVE_CONFIG_LOKI_LIMIT"
+	archiveLogHTTPURLTemplate = "ARCHIVE_CONFIG_HTTP_URL_TEMPLATE"
+	archiveLogHTTPHeaders     = "ARCHIVE_CONFIG_HTTP_HEADERS"
+	dbConMaxLifeTime          = "DBConfig.ConMaxLifeTime"
 
 	VisualizationServiceHost = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_HOST"
 	VisualizationServicePort = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_PORT"
 
 	initConnectionTimeout = "InitConnectionTimeout"
 
+	metadataGrpcServiceHost = "METADATA_GRPC_SERVICE_HOST"
+	metadataGrpcServicePort = "METADATA_GRPC_SERVICE_PORT"
+
 	clientQPS   = "ClientQPS"
 	clientBurst = "ClientBurst"
 )
@@ -82,16 +92,21 @@ type ClientManager struct {
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
+	auditEventStore           storage.AuditEventStoreInterface
+	runEventStore             storage.RunEventStoreInterface
+	notificationStore         storage.NotificationStoreInterface
 	objectStore               storage.ObjectStoreInterface
 	execClient                util.ExecutionClient
 	swfClient                 client.SwfClientInterface
 	k8sCoreClient             client.KubernetesCoreInterface
+	metadataClient            client.MetadataClientInterface
 	subjectAccessReviewClient client.SubjectAccessReviewInterface
 	tokenReviewClient         client.TokenReviewInterface
 	logArchive                archive.LogArchiveInterface
 	time                      util.TimeInterface
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []auth.Authenticator
+	authorizer                auth.Authorizer
 }
 
 func (c *ClientManager) TaskStore() storage.TaskStoreInterface {
@@ -126,6 +141,18 @@ func (c *ClientManager) DefaultExperimentStore() storage.DefaultExperimentStoreI
 	return c.defaultExperimentStore
 }
 
+func (c *ClientManager) AuditEventStore() storage.AuditEventStoreInterface {
+	return c.auditEventStore
+}
+
+func (c *ClientManager) RunEventStore() storage.RunEventStoreInterface {
+	return c.runEventStore
+}
+
+func (c *ClientManager) NotificationStore() storage.NotificationStoreInterface {
+	return c.notificationStore
+}
+
 func (c *ClientManager) ObjectStore() storage.ObjectStoreInterface {
 	return c.objectStore
 }
@@ -142,6 +169,10 @@ func (c *ClientManager) KubernetesCoreClient() client.KubernetesCoreInterface {
 	return c.k8sCoreClient
 }
 
+func (c *ClientManager) MetadataClient() client.MetadataClientInterface {
+	return c.metadataClient
+}
+
 func (c *ClientManager) SubjectAccessReviewClient() client.SubjectAccessReviewInterface {
 	return c.subjectAccessReviewClient
 }
@@ -166,6 +197,10 @@ func (c *ClientManager) Authenticators() []auth.Authenticator {
 	return c.authenticators
 }
 
+func (c *ClientManager) Authorizer() auth.Authorizer {
+	return c.authorizer
+}
+
 func (c *ClientManager) init() {
 	glog.Info("Initializing client manager")
 	db := InitDBClient(common.GetDurationConfig(initConnectionTimeout))
@@ -185,6 +220,9 @@ func (c *ClientManager) init() {
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
+	c.auditEventStore = storage.NewAuditEventStore(db, c.uuid)
+	c.runEventStore = storage.NewRunEventStore(db)
+	c.notificationStore = storage.NewNotificationStore(db, c.time, c.uuid)
 	c.objectStore = initMinioClient(common.GetDurationConfig(initConnectionTimeout))
 
 	// Use default value of client QPS (5) & burst (10) defined in
@@ -200,16 +238,22 @@ func (c *ClientManager) init() {
 
 	c.k8sCoreClient = client.CreateKubernetesCoreOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 
+	c.metadataClient = client.CreateMetadataClientOrFatal(
+		common.GetStringConfigWithDefault(metadataGrpcServiceHost, ""),
+		common.GetStringConfigWithDefault(metadataGrpcServicePort, ""),
+		common.GetDurationConfig(initConnectionTimeout))
+
 	runStore := storage.NewRunStore(db, c.time)
 	c.runStore = runStore
 
 	// Log archive
-	c.logArchive = initLogArchive()
+	c.logArchive = initLogArchive(c.objectStore)
 
 	if common.IsMultiUserMode() {
 		c.subjectAccessReviewClient = client.CreateSubjectAccessReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.tokenReviewClient = client.CreateTokenReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.authenticators = auth.GetAuthenticators(c.tokenReviewClient)
+		c.authorizer = auth.GetAuthorizer(c.subjectAccessReviewClient)
 	}
 	glog.Infof("Client manager initialized successfully")
 }
@@ -254,6 +298,10 @@ func InitDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		&model.RunMetric{},
 		&model.Task{},
 		&model.ResourceReference{},
+		&model.AuditEvent{},
+		&model.RunEvent{},
+		&model.NotificationSubscription{},
+		&model.NotificationDelivery{},
 	)
 
 	if ignoreAlreadyExistError(driverName, response.Error) != nil {
@@ -511,12 +559,35 @@ func createMinioBucket(minioClient *minio.Client, bucketName, region string) {
 	glog.Infof("Successfully created bucket %s\n", bucketName)
 }
 
-func initLogArchive() (logArchive archive.LogArchiveInterface) {
-	logFileName := common.GetStringConfigWithDefault(archiveLogFileName, "")
-	logPathPrefix := common.GetStringConfigWithDefault(archiveLogPathPrefix, "")
+func initLogArchive(objectStore storage.ObjectStoreInterface) (logArchive archive.LogArchiveInterface) {
+	switch backend := common.GetStringConfigWithDefault(archiveLogBackend, common.ObjectStoreLogArchive); backend {
+	case common.ObjectStoreLogArchive:
+		logFileName := common.GetStringConfigWithDefault(archiveLogFileName, "")
+		logPathPrefix := common.GetStringConfigWithDefault(archiveLogPathPrefix, "")
 
-	if logFileName != "" && logPathPrefix != "" {
-		logArchive = archive.NewLogArchive(logPathPrefix, logFileName)
+		if logFileName != "" && logPathPrefix != "" {
+			logArchive = archive.NewLogArchive(objectStore, logPathPrefix, logFileName)
+		}
+	case common.LokiLogArchive:
+		lokiLogArchive, err := archive.NewLokiLogArchive(
+			common.GetStringConfig(archiveLogLokiAddress),
+			common.GetStringConfigWithDefault(archiveLogLokiQuery, archive.DefaultLokiQuery),
+			common.GetStringConfigWithDefault(archiveLogLokiOrgId, ""),
+			common.GetIntConfigWithDefault(archiveLogLokiLimit, archive.DefaultLokiLimit))
+		if err != nil {
+			glog.Fatalf("Failed to create the Loki log archive: %v", err)
+		}
+		logArchive = lokiLogArchive
+	case common.HTTPLogArchive:
+		httpLogArchive, err := archive.NewHTTPLogArchive(
+			common.GetStringConfig(archiveLogHTTPURLTemplate),
+			common.GetMapConfig(archiveLogHTTPHeaders))
+		if err != nil {
+			glog.Fatalf("Failed to create the HTTP log archive: %v", err)
+		}
+		logArchive = httpLogArchive
+	default:
+		glog.Fatalf("Unknown log archive backend %q", backend)
 	}
 
 	return
@@ -16,6 +16,7 @@ package common
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -32,12 +33,89 @@ const (
 	KubeflowUserIDPrefix                    string = "KUBEFLOW_USERID_PREFIX"
 	UpdatePipelineVersionByDefault          string = "AUTO_UPDATE_PIPELINE_DEFAULT_VERSION"
 	TokenReviewAudience                     string = "TOKEN_REVIEW_AUDIENCE"
+	ArtifactSignedURLRedirect               string = "ARTIFACT_SIGNED_URL_REDIRECT"
+	ArtifactSignedURLExpiry                 string = "ARTIFACT_SIGNED_URL_EXPIRY"
+	ArtifactGCEnabled                       string = "ArtifactGC.Enabled"
+	ArtifactGCDryRun                        string = "ArtifactGC.DryRun"
+	ArtifactGCInterval                      string = "ArtifactGC.Interval"
+	ArtifactGCDefaultRetention              string = "ArtifactGC.DefaultRetention"
+	ArtifactGCNamespaceRetention            string = "ArtifactGC.NamespaceRetention"
+	Authenticators                          string = "AUTHENTICATORS"
+	OIDCIssuerURL                           string = "OIDC.IssuerURL"
+	OIDCJWKSURL                             string = "OIDC.JWKSURL"
+	OIDCAudience                            string = "OIDC.Audience"
+	OIDCUsernameClaim                       string = "OIDC.UsernameClaim"
+	OIDCUsernamePrefix                      string = "OIDC.UsernamePrefix"
+	OIDCGroupsClaim                         string = "OIDC.GroupsClaim"
+	OIDCGroupsPrefix                        string = "OIDC.GroupsPrefix"
+	OIDCJWKSRefreshInterval                 string = "OIDC.JWKSRefreshInterval"
+	Authorizer                              string = "AUTHORIZER"
+	RBACPolicyFile                          string = "RBAC_POLICY_FILE"
+	AuditEnabled                            string = "Audit.Enabled"
+	AuditSinks                              string = "Audit.Sinks"
+	AuditFilePath                           string = "Audit.FilePath"
+	AuditExcludedMethods                    string = "Audit.ExcludedMethods"
+	AuditRetention                          string = "Audit.Retention"
+	RateLimitEnabled                        string = "RateLimit.Enabled"
+	RateLimitTrustedProxies                 string = "RateLimit.TrustedProxies"
+	RateLimitExcludedMethods                string = "RateLimit.ExcludedMethods"
+	RunEventsBufferSize                     string = "RunEvents.BufferSize"
+	RunEventsRetention                      string = "RunEvents.Retention"
+	NotificationWorkers                     string = "Notifications.Workers"
+	NotificationQueueSize                   string = "Notifications.QueueSize"
+	NotificationMaxAttempts                 string = "Notifications.MaxAttempts"
+	NotificationTimeout                     string = "Notifications.Timeout"
+	NotificationAllowedNetworks             string = "Notifications.AllowedNetworks"
 )
 
 func IsPipelineVersionUpdatedByDefault() bool {
 	return GetBoolConfigWithDefault(UpdatePipelineVersionByDefault, true)
 }
 
+// IsArtifactSignedURLRedirectEnabled reports whether artifact downloads may be
+// redirected to signed object store URLs instead of being streamed.
+func IsArtifactSignedURLRedirectEnabled() bool {
+	return GetBoolConfigWithDefault(ArtifactSignedURLRedirect, false)
+}
+
+func GetArtifactSignedURLExpiry() time.Duration {
+	return GetDurationConfigWithDefault(ArtifactSignedURLExpiry, 15*time.Minute)
+}
+
+func IsArtifactGCEnabled() bool {
+	return GetBoolConfigWithDefault(ArtifactGCEnabled, false)
+}
+
+// IsArtifactGCDryRun reports whether artifact garbage collection only reports
+// the artifacts it would delete.
+func IsArtifactGCDryRun() bool {
+	return GetBoolConfigWithDefault(ArtifactGCDryRun, false)
+}
+
+func GetArtifactGCInterval() time.Duration {
+	return GetDurationConfigWithDefault(ArtifactGCInterval, time.Hour)
+}
+
+// GetArtifactGCDefaultRetention returns how long artifacts are kept after their
+// run finished. Zero keeps them until the run is deleted.
+func GetArtifactGCDefaultRetention() time.Duration {
+	return GetDurationConfigWithDefault(ArtifactGCDefaultRetention, 0)
+}
+
+// GetArtifactGCNamespaceRetention returns the per-namespace overrides of the
+// artifact retention.
+func GetArtifactGCNamespaceRetention() map[string]time.Duration {
+	retentions := make(map[string]time.Duration)
+	for namespace, value := range GetMapConfig(ArtifactGCNamespaceRetention) {
+		retention, err := time.ParseDuration(value)
+		if err != nil {
+			glog.Fatalf("Failed to parse the artifact retention %q of namespace %s: %v", value, namespace, err)
+		}
+		retentions[namespace] = retention
+	}
+	return retentions
+}
+
 func GetStringConfig(configName string) string {
 	if !viper.IsSet(configName) {
 		glog.Fatalf("Please specify flag %s", configName)
@@ -92,6 +170,13 @@ func GetDurationConfig(configName string) time.Duration {
 	return viper.GetDuration(configName)
 }
 
+func GetDurationConfigWithDefault(configName string, value time.Duration) time.Duration {
+	if !viper.IsSet(configName) {
+		return value
+	}
+	return viper.GetDuration(configName)
+}
+
 func IsMultiUserMode() bool {
 	return GetBoolConfigWithDefault(MultiUserMode, false)
 }
@@ -127,3 +212,126 @@ func GetKubeflowUserIDPrefix() string {
 func GetTokenReviewAudience() string {
 	return GetStringConfigWithDefault(TokenReviewAudience, DefaultTokenReviewAudience)
 }
+
+// GetAuthorizer returns the authorizer of requests in multi-user mode.
+func GetAuthorizer() string {
+	return GetStringConfigWithDefault(Authorizer, SubjectAccessReviewAuthorizer)
+}
+
+// GetAuthenticators returns the authenticators of requests, in the order in which
+// they are tried.
+func GetAuthenticators() []string {
+	return splitList(GetStringConfigWithDefault(Authenticators, DefaultAuthenticators))
+}
+
+// IsAuditEnabled reports whether mutating API calls are recorded in the audit log.
+func IsAuditEnabled() bool {
+	return GetBoolConfigWithDefault(AuditEnabled, false)
+}
+
+// GetAuditSinks returns the sinks to which audit events are written.
+func GetAuditSinks() []string {
+	return splitList(GetStringConfigWithDefault(AuditSinks, DefaultAuditSink))
+}
+
+// GetAuditFilePath returns the path of the JSON lines file of the file audit sink.
+func GetAuditFilePath() string {
+	return GetStringConfigWithDefault(AuditFilePath, "/var/log/kfp/audit.log")
+}
+
+// GetAuditExcludedMethods returns the RPC methods and services which are not
+// audited. By default, the reports of the persistence agent are not audited.
+func GetAuditExcludedMethods() []string {
+	return splitList(GetStringConfigWithDefault(AuditExcludedMethods, "ReportService,ReportRunMetricsV1"))
+}
+
+// GetAuditRetention returns how long audit events are kept in the DB, 0 to keep
+// them forever.
+func GetAuditRetention() time.Duration {
+	return GetDurationConfigWithDefault(AuditRetention, 90*24*time.Hour)
+}
+
+// IsRateLimitEnabled reports whether API calls are rate limited per user and namespace.
+func IsRateLimitEnabled() bool {
+	return GetBoolConfigWithDefault(RateLimitEnabled, false)
+}
+
+// GetRateLimitTrustedProxies returns the networks, in CIDR notation, of the
+// proxies whose x-forwarded-for header identifies the clients of rate limited
+// calls. By default, only the API server's own HTTP proxy is trusted.
+func GetRateLimitTrustedProxies() []string {
+	return splitList(GetStringConfigWithDefault(RateLimitTrustedProxies, "127.0.0.0/8,::1/128"))
+}
+
+// GetRateLimitExcludedMethods returns the RPC methods and services which are
+// not rate limited. By default, the reports of the persistence agent are not
+// rate limited, so that throttling them does not delay the status of the runs.
+func GetRateLimitExcludedMethods() []string {
+	return splitList(GetStringConfigWithDefault(RateLimitExcludedMethods, "ReportService"))
+}
+
+// GetRateLimit returns the rate, in calls per second, and the burst of the API
+// calls of a method class allowed per user and namespace. A rate of zero
+// disables the rate limiting of the class.
+func GetRateLimit(methodClass string) (float64, int) {
+	defaultQPS, defaultBurst := 20.0, 50
+	switch methodClass {
+	case ListMethodClass:
+		defaultQPS, defaultBurst = 5, 20
+	case MutateMethodClass:
+		defaultQPS, defaultBurst = 10, 20
+	}
+	prefix := "RateLimit." + methodClass
+	return GetFloat64ConfigWithDefault(prefix+".QPS", defaultQPS), GetIntConfigWithDefault(prefix+".Burst", defaultBurst)
+}
+
+// GetRunEventsBufferSize returns the number of the last run events kept in
+// memory for the watches of runs.
+func GetRunEventsBufferSize() int {
+	return GetIntConfigWithDefault(RunEventsBufferSize, 1024)
+}
+
+// GetRunEventsRetention returns how long run events are kept in the DB to
+// resume the watches of runs.
+func GetRunEventsRetention() time.Duration {
+	return GetDurationConfigWithDefault(RunEventsRetention, 24*time.Hour)
+}
+
+// GetNotificationWorkers returns the number of notifications of run state
+// changes posted concurrently.
+func GetNotificationWorkers() int {
+	return GetIntConfigWithDefault(NotificationWorkers, 4)
+}
+
+// GetNotificationQueueSize returns the number of notifications waiting to be posted.
+func GetNotificationQueueSize() int {
+	return GetIntConfigWithDefault(NotificationQueueSize, 1000)
+}
+
+// GetNotificationMaxAttempts returns the number of attempts to post a notification.
+func GetNotificationMaxAttempts() int {
+	return GetIntConfigWithDefault(NotificationMaxAttempts, 5)
+}
+
+// GetNotificationTimeout returns the timeout of an attempt to post a notification.
+func GetNotificationTimeout() time.Duration {
+	return GetDurationConfigWithDefault(NotificationTimeout, 10*time.Second)
+}
+
+// GetNotificationAllowedNetworks returns the networks, in CIDR notation, of the
+// internal addresses to which notifications may be posted, e.g. the receivers
+// deployed in the cluster. Other internal addresses are blocked.
+func GetNotificationAllowedNetworks() []string {
+	return splitList(GetStringConfigWithDefault(NotificationAllowedNetworks, ""))
+}
+
+// splitList returns the non-empty items of a comma-separated list.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
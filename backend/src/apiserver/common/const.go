@@ -27,6 +27,9 @@ const (
 	RbacResourceTypeVisualizations     = "visualizations"
 	RbacResourceTypeScheduledWorkflows = "scheduledworkflows"
 	RbacResourceTypeWorkflows          = "workflows"
+	RbacResourceTypeAuditEvents        = "auditevents"
+
+	RbacResourceTypeNotificationSubscriptions = "notificationsubscriptions"
 
 	RbacResourceVerbArchive       = "archive"
 	RbacResourceVerbUpdate        = "update"
@@ -42,6 +45,7 @@ const (
 	RbacResourceVerbReportMetrics = "reportMetrics"
 	RbacResourceVerbReadArtifact  = "readArtifact"
 	RbacResourceVerbReport        = "report"
+	RbacResourceVerbBackfill      = "backfill"
 )
 
 const (
@@ -53,6 +57,41 @@ const (
 
 const DefaultTokenReviewAudience string = "pipelines.kubeflow.org"
 
+// The authenticators of requests.
+const (
+	HTTPHeaderAuthenticator  string = "header"
+	TokenReviewAuthenticator string = "tokenreview"
+	OIDCAuthenticator        string = "oidc"
+	DefaultAuthenticators    string = HTTPHeaderAuthenticator + "," + TokenReviewAuthenticator
+)
+
+// The authorizers of requests.
+const (
+	SubjectAccessReviewAuthorizer string = "subjectaccessreview"
+	RBACAuthorizer                string = "rbac"
+)
+
+// The sinks of audit events.
+const (
+	DBAuditSink      string = "db"
+	FileAuditSink    string = "file"
+	DefaultAuditSink string = DBAuditSink
+)
+
+// The backends of the archived logs.
+const (
+	ObjectStoreLogArchive string = "objectstore"
+	LokiLogArchive        string = "loki"
+	HTTPLogArchive        string = "http"
+)
+
+// The classes of API methods, which are rate limited separately.
+const (
+	ListMethodClass   string = "List"
+	GetMethodClass    string = "Get"
+	MutateMethodClass string = "Mutate"
+)
+
 const (
 	DefaultPipelineRunnerServiceAccount = "pipeline-runner"
 	HasDefaultBucketEnvVar              = "HAS_DEFAULT_BUCKET"
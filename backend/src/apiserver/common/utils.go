@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const (
@@ -117,3 +118,40 @@ func ValidatePipelineName(pipelineName string) error {
 	}
 	return nil
 }
+
+// The prefixes of the names of the mutating RPC methods.
+var mutatingMethodPrefixes = []string{
+	"Create", "Delete", "Update", "Archive", "Unarchive", "Terminate", "Retry",
+	"Enable", "Disable", "Backfill", "Report", "Upload",
+}
+
+// GetMethodName returns the name of a full RPC method name, e.g. CreateRun for
+// /kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun.
+func GetMethodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// IsMutatingMethod reports whether a full RPC method name is a call modifying
+// resources.
+func IsMutatingMethod(fullMethod string) bool {
+	name := GetMethodName(fullMethod)
+	for _, prefix := range mutatingMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNamespaceFromRequest returns the namespace field of a request proto, if any.
+func GetNamespaceFromRequest(request interface{}) string {
+	m, ok := request.(protoreflect.ProtoMessage)
+	if !ok {
+		return ""
+	}
+	field := m.ProtoReflect().Descriptor().Fields().ByName("namespace")
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	return m.ProtoReflect().Get(field).String()
+}
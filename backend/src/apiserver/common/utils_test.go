@@ -247,3 +247,23 @@ func Test_validatePipelineName(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMutatingMethod(t *testing.T) {
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun", true},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/TerminateRun", true},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/BackfillRecurringRun", true},
+		{"/api.ReportService/ReportWorkflowV1", true},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/GetRun", false},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRuns", false},
+		{"/kubeflow.pipelines.backend.api.v2beta1.AuditService/ListAuditEvents", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsMutatingMethod(tt.method))
+		})
+	}
+}
@@ -37,3 +37,18 @@ func apiServerInterceptor(ctx context.Context, req interface{}, info *grpc.Unary
 	glog.Infof("%v handler finished", info.FullMethod)
 	return
 }
+
+// apiServerStreamInterceptor implements StreamServerInterceptor with the same logic
+// as apiServerInterceptor, for the streaming API handlers.
+func apiServerStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	glog.Infof("%v handler starting", info.FullMethod)
+	err = handler(srv, stream)
+	if err != nil {
+		util.LogError(util.Wrapf(err, "%s call failed", info.FullMethod))
+		// Convert error to gRPC errors
+		err = util.ToGRPCError(err)
+		return
+	}
+	glog.Infof("%v handler finished", info.FullMethod)
+	return
+}
@@ -35,9 +35,11 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	apiv1beta1 "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	cm "github.com/kubeflow/pipelines/backend/src/apiserver/client_manager"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/ratelimit"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -77,8 +79,33 @@ func main() {
 		}
 	}
 
-	go startRpcServer(resourceManager)
-	startHttpProxy(resourceManager)
+	if common.IsArtifactGCEnabled() {
+		go resourceManager.RunArtifactGC(context.Background(), common.GetArtifactGCInterval(), &resource.ArtifactGCOptions{
+			DryRun:             common.IsArtifactGCDryRun(),
+			DefaultRetention:   common.GetArtifactGCDefaultRetention(),
+			NamespaceRetention: common.GetArtifactGCNamespaceRetention(),
+		})
+	}
+
+	go resourceManager.RunNotificationDispatcher(context.Background())
+
+	var auditLogger *audit.Logger
+	if common.IsAuditEnabled() {
+		auditLogger = audit.NewLogger(audit.GetSinks(clientManager.AuditEventStore()), clientManager.Authenticators(),
+			common.GetAuditExcludedMethods(), clientManager.Time())
+	}
+
+	var limiter *ratelimit.Limiter
+	if common.IsRateLimitEnabled() {
+		opts, err := ratelimit.GetOptions()
+		if err != nil {
+			glog.Fatalf("Invalid %v config: %v", common.RateLimitTrustedProxies, err)
+		}
+		limiter = ratelimit.NewLimiter(ratelimit.GetLimits(), clientManager.Authenticators(), opts, clientManager.Time())
+	}
+
+	go startRpcServer(resourceManager, auditLogger, limiter)
+	startHttpProxy(resourceManager, auditLogger, limiter)
 
 	clientManager.Close()
 }
@@ -92,13 +119,37 @@ func grpcCustomMatcher(key string) (string, bool) {
 	return strings.ToLower(key), false
 }
 
-func startRpcServer(resourceManager *resource.ResourceManager) {
+// A custom gRPC metadata matcher to return the retry-after metadata of throttled
+// calls as the standard Retry-After HTTP header.
+func grpcOutgoingMatcher(key string) (string, bool) {
+	if key == ratelimit.RetryAfterKey {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+func startRpcServer(resourceManager *resource.ResourceManager, auditLogger *audit.Logger, limiter *ratelimit.Limiter) {
 	glog.Info("Starting RPC server")
 	listener, err := net.Listen("tcp", *rpcPortFlag)
 	if err != nil {
 		glog.Fatalf("Failed to start RPC server: %v", err)
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
+	interceptors := []grpc.UnaryServerInterceptor{apiServerInterceptor}
+	if limiter != nil {
+		interceptors = append([]grpc.UnaryServerInterceptor{limiter.UnaryServerInterceptor}, interceptors...)
+	}
+	if auditLogger != nil {
+		// The audit interceptor runs first, to record the gRPC errors returned to callers.
+		interceptors = append([]grpc.UnaryServerInterceptor{auditLogger.UnaryServerInterceptor}, interceptors...)
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{apiServerStreamInterceptor}
+	if limiter != nil {
+		streamInterceptors = append([]grpc.StreamServerInterceptor{limiter.StreamServerInterceptor}, streamInterceptors...)
+	}
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.MaxRecvMsgSize(math.MaxInt32))
 
 	sharedExperimentServer := server.NewExperimentServer(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag})
 	sharedPipelineServer := server.NewPipelineServer(
@@ -130,6 +181,9 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	apiv2beta1.RegisterPipelineServiceServer(s, sharedPipelineServer)
 	apiv2beta1.RegisterRecurringRunServiceServer(s, sharedJobServer)
 	apiv2beta1.RegisterRunServiceServer(s, sharedRunServer)
+	apiv2beta1.RegisterAuditServiceServer(s, server.NewAuditServer(resourceManager))
+	apiv2beta1.RegisterRunLogServiceServer(s, server.NewRunLogServer(resourceManager))
+	apiv2beta1.RegisterNotificationServiceServer(s, server.NewNotificationServer(resourceManager))
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
@@ -139,7 +193,7 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	glog.Info("RPC server started")
 }
 
-func startHttpProxy(resourceManager *resource.ResourceManager) {
+func startHttpProxy(resourceManager *resource.ResourceManager, auditLogger *audit.Logger, limiter *ratelimit.Limiter) {
 	glog.Info("Starting Http Proxy")
 
 	ctx := context.Background()
@@ -147,7 +201,7 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	defer cancel()
 
 	// Create gRPC HTTP MUX and register services for v1beta1 api.
-	runtimeMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(grpcCustomMatcher))
+	runtimeMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(grpcCustomMatcher), runtime.WithOutgoingHeaderMatcher(grpcOutgoingMatcher))
 	registerHttpHandlerFromEndpoint(apiv1beta1.RegisterPipelineServiceHandlerFromEndpoint, "PipelineService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv1beta1.RegisterExperimentServiceHandlerFromEndpoint, "ExperimentService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv1beta1.RegisterJobServiceHandlerFromEndpoint, "JobService", ctx, runtimeMux)
@@ -162,24 +216,41 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterPipelineServiceHandlerFromEndpoint, "PipelineService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterRecurringRunServiceHandlerFromEndpoint, "RecurringRunService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterRunServiceHandlerFromEndpoint, "RunService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterAuditServiceHandlerFromEndpoint, "AuditService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterNotificationServiceHandlerFromEndpoint, "NotificationService", ctx, runtimeMux)
 
 	// Create a top level mux to include both pipeline upload server and gRPC servers.
 	topMux := mux.NewRouter()
 
+	// The gRPC calls are audited by the gRPC server, the HTTP calls by their handlers.
+	audited := func(handler http.HandlerFunc) http.HandlerFunc {
+		if auditLogger == nil {
+			return handler
+		}
+		return auditLogger.HTTPHandler(handler)
+	}
+	// The gRPC calls are rate limited by the gRPC server, the HTTP calls by their handlers.
+	limited := func(class string, handler http.HandlerFunc) http.HandlerFunc {
+		if limiter == nil {
+			return handler
+		}
+		return limiter.HTTPHandler(class, handler)
+	}
+
 	// multipart upload is only supported in HTTP. In long term, we should have gRPC endpoints that
 	// accept pipeline url for importing.
 	// https://github.com/grpc-ecosystem/grpc-gateway/issues/410
 	sharedPipelineUploadServer := server.NewPipelineUploadServer(resourceManager, &server.PipelineUploadServerOptions{CollectMetrics: *collectMetricsFlag})
 	// API v1beta1
-	topMux.HandleFunc("/apis/v1beta1/pipelines/upload", sharedPipelineUploadServer.UploadPipelineV1)
-	topMux.HandleFunc("/apis/v1beta1/pipelines/upload_version", sharedPipelineUploadServer.UploadPipelineVersionV1)
+	topMux.HandleFunc("/apis/v1beta1/pipelines/upload", audited(limited(common.MutateMethodClass, sharedPipelineUploadServer.UploadPipelineV1)))
+	topMux.HandleFunc("/apis/v1beta1/pipelines/upload_version", audited(limited(common.MutateMethodClass, sharedPipelineUploadServer.UploadPipelineVersionV1)))
 	topMux.HandleFunc("/apis/v1beta1/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		io.WriteString(w, `{"commit_sha":"`+common.GetStringConfigWithDefault("COMMIT_SHA", "unknown")+`", "tag_name":"`+common.GetStringConfigWithDefault("TAG_NAME", "unknown")+`", "multi_user":`+strconv.FormatBool(common.IsMultiUserMode())+`}`)
 	})
 	// API v2beta1
-	topMux.HandleFunc("/apis/v2beta1/pipelines/upload", sharedPipelineUploadServer.UploadPipeline)
-	topMux.HandleFunc("/apis/v2beta1/pipelines/upload_version", sharedPipelineUploadServer.UploadPipelineVersion)
+	topMux.HandleFunc("/apis/v2beta1/pipelines/upload", audited(limited(common.MutateMethodClass, sharedPipelineUploadServer.UploadPipeline)))
+	topMux.HandleFunc("/apis/v2beta1/pipelines/upload_version", audited(limited(common.MutateMethodClass, sharedPipelineUploadServer.UploadPipelineVersion)))
 	topMux.HandleFunc("/apis/v2beta1/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		io.WriteString(w, `{"commit_sha":"`+common.GetStringConfigWithDefault("COMMIT_SHA", "unknown")+`", "tag_name":"`+common.GetStringConfigWithDefault("TAG_NAME", "unknown")+`", "multi_user":`+strconv.FormatBool(common.IsMultiUserMode())+`}`)
@@ -187,7 +258,19 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 
 	// log streaming is provided via HTTP.
 	runLogServer := server.NewRunLogServer(resourceManager)
-	topMux.HandleFunc("/apis/v1alpha1/runs/{run_id}/nodes/{node_id}/log", runLogServer.ReadRunLogV1)
+	topMux.HandleFunc("/apis/v1alpha1/runs/{run_id}/nodes/{node_id}/log", audited(limited(common.GetMethodClass, runLogServer.ReadRunLogV1)))
+	topMux.HandleFunc("/apis/v2beta1/runs/{run_id}/logs", audited(limited(common.GetMethodClass, runLogServer.ReadRunLogs))).Methods(http.MethodGet)
+	topMux.HandleFunc("/apis/v2beta1/runs/{run_id}/logs:stream", audited(limited(common.GetMethodClass, runLogServer.StreamRunLogEvents))).Methods(http.MethodGet)
+
+	// artifact streaming is provided via HTTP, with support for range requests.
+	artifactServer := server.NewArtifactServer(resourceManager, &server.ArtifactServerOptions{
+		SignedURLRedirect: common.IsArtifactSignedURLRedirectEnabled(),
+		SignedURLExpiry:   common.GetArtifactSignedURLExpiry(),
+	})
+	topMux.HandleFunc("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:download", audited(limited(common.GetMethodClass, artifactServer.DownloadRunArtifactV1))).Methods(http.MethodGet, http.MethodHead)
+	topMux.HandleFunc("/apis/v2beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:download", audited(limited(common.GetMethodClass, artifactServer.DownloadRunArtifactV1))).Methods(http.MethodGet, http.MethodHead)
+	topMux.HandleFunc("/apis/v2beta1/runs/{run_id}/tasks/{task_name}/outputs/{output_name}:download", audited(limited(common.GetMethodClass, artifactServer.DownloadTaskOutputArtifact))).Methods(http.MethodGet, http.MethodHead)
+	topMux.HandleFunc("/apis/v2beta1/artifacts/{artifact_id}:download", audited(limited(common.GetMethodClass, artifactServer.DownloadArtifact))).Methods(http.MethodGet, http.MethodHead)
 
 	topMux.PathPrefix("/apis/").Handler(runtimeMux)
 
@@ -0,0 +1,96 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// AuditEvent records a mutating API call.
+type AuditEvent struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key;"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null; index;"`
+	User           string `gorm:"column:User; not null;"`
+	Method         string `gorm:"column:Method; not null;"`
+	// ResourceIds are the comma-separated IDs of the resources of the call.
+	ResourceIds  string `gorm:"column:ResourceIds; not null; size:1024;"`
+	Namespace    string `gorm:"column:Namespace; not null;"`
+	Code         string `gorm:"column:Code; not null;"`
+	ErrorMessage string `gorm:"column:ErrorMessage; not null; size:65535;"`
+}
+
+func (e AuditEvent) GetValueOfPrimaryKey() string {
+	return e.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model AuditEvent.
+func (e *AuditEvent) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model AuditEvent.
+func (e *AuditEvent) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var auditEventAPIToModelFieldMap = map[string]string{
+	"audit_event_id": "UUID",
+	"created_at":     "CreatedAtInSec",
+	"user":           "User",
+	"method":         "Method",
+	"namespace":      "Namespace",
+	"code":           "Code",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// AuditEvent.
+func (e *AuditEvent) APIToModelFieldMap() map[string]string {
+	return auditEventAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix.
+func (e *AuditEvent) GetModelName() string {
+	return "audit_events"
+}
+
+func (e *AuditEvent) GetField(name string) (string, bool) {
+	if field, ok := auditEventAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (e *AuditEvent) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return e.UUID
+	case "CreatedAtInSec":
+		return e.CreatedAtInSec
+	case "User":
+		return e.User
+	case "Method":
+		return e.Method
+	case "Namespace":
+		return e.Namespace
+	case "Code":
+		return e.Code
+	default:
+		return nil
+	}
+}
+
+func (e *AuditEvent) GetSortByFieldPrefix(name string) string {
+	return "audit_events."
+}
+
+func (e *AuditEvent) GetKeyFieldPrefix() string {
+	return "audit_events."
+}
@@ -94,10 +94,22 @@ type Job struct {
 	Description    string `gorm:"column:Description; not null;"`
 	MaxConcurrency int64  `gorm:"column:MaxConcurrency; not null;"`
 	NoCatchup      bool   `gorm:"column:NoCatchup; not null;"`
-	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null;"` /* The time this record is stored in DB*/
-	UpdatedAtInSec int64  `gorm:"column:UpdatedAtInSec; default:0;"`
-	Enabled        bool   `gorm:"column:Enabled; not null;"`
-	ExperimentId   string `gorm:"column:ExperimentUUID; not null;"`
+	// ConcurrencyPolicy is the concurrency policy of the ScheduledWorkflow. Empty means Allow.
+	ConcurrencyPolicy string `gorm:"column:ConcurrencyPolicy; default:null;"`
+	// MaxConsecutiveFailures is the number of consecutive failed runs after which the
+	// ScheduledWorkflow is disabled. 0 means the ScheduledWorkflow is never disabled.
+	MaxConsecutiveFailures int64 `gorm:"column:MaxConsecutiveFailures; default:0;"`
+	// FailureWebhookURL is notified when the ScheduledWorkflow is disabled after consecutive failed runs.
+	FailureWebhookURL string `gorm:"column:FailureWebhookURL; default:null;"`
+	// ConsecutiveFailures is the number of consecutive failed runs reported by the ScheduledWorkflow.
+	ConsecutiveFailures int64 `gorm:"column:ConsecutiveFailures; default:0;"`
+	// UseLatestPipelineVersion is true if each run uses the latest version of the pipeline
+	// at the time it is created instead of PipelineSpec.PipelineVersionId.
+	UseLatestPipelineVersion bool   `gorm:"column:UseLatestPipelineVersion; default:false;"`
+	CreatedAtInSec           int64  `gorm:"column:CreatedAtInSec; not null;"` /* The time this record is stored in DB*/
+	UpdatedAtInSec           int64  `gorm:"column:UpdatedAtInSec; default:0;"`
+	Enabled                  bool   `gorm:"column:Enabled; not null;"`
+	ExperimentId             string `gorm:"column:ExperimentUUID; not null;"`
 	// ResourceReferences are deprecated. Use Namespace, ExperimentId
 	// PipelineSpec.PipelineId, PipelineSpec.PipelineVersionId
 	ResourceReferences []*ResourceReference
@@ -187,6 +199,10 @@ type Trigger struct {
 	CronSchedule
 	// Create workflows periodically.
 	PeriodicSchedule
+	// Create a workflow for each new object stored under a prefix.
+	ObjectStoreTrigger
+	// Create a workflow for each completed run of an experiment.
+	RunCompletionTrigger
 }
 
 type CronSchedule struct {
@@ -201,6 +217,10 @@ type CronSchedule struct {
 	// Cron string describing when a workflow should be created within the
 	// time interval defined by StartTime and EndTime.
 	Cron *string `gorm:"column:Schedule;"`
+
+	// IANA time zone in which the cron string is evaluated.
+	// If no time zone is specified, the time zone of the controller is used.
+	CronScheduleTimeZone *string `gorm:"column:CronScheduleTimeZone;"`
 }
 
 type PeriodicSchedule struct {
@@ -217,6 +237,25 @@ type PeriodicSchedule struct {
 	IntervalSecond *int64 `gorm:"column:IntervalSecond;"`
 }
 
+type ObjectStoreTrigger struct {
+	// URI of the prefix to watch.
+	ObjectStoreTriggerURI *string `gorm:"column:ObjectStoreTriggerURI;"`
+
+	// Number of seconds between two listings of the prefix.
+	ObjectStoreTriggerPollIntervalSecond *int64 `gorm:"column:ObjectStoreTriggerPollIntervalSecond;"`
+}
+
+type RunCompletionTrigger struct {
+	// ID of the experiment whose runs are watched.
+	RunCompletionTriggerExperimentId *string `gorm:"column:RunCompletionTriggerExperimentId;"`
+
+	// ID of the pipeline whose runs are watched. Empty for any pipeline.
+	RunCompletionTriggerPipelineId *string `gorm:"column:RunCompletionTriggerPipelineId;"`
+
+	// Comma-separated final states of the runs which create a workflow.
+	RunCompletionTriggerStates *string `gorm:"column:RunCompletionTriggerStates;"`
+}
+
 func (j Job) GetValueOfPrimaryKey() string {
 	return fmt.Sprint(j.UUID)
 }
@@ -0,0 +1,232 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "strings"
+
+// NotificationSubscription posts notifications to a URL when the runs of a
+// namespace or of an experiment change state.
+type NotificationSubscription struct {
+	UUID         string `gorm:"column:UUID; not null; primary_key;"`
+	DisplayName  string `gorm:"column:DisplayName; not null;"`
+	Namespace    string `gorm:"column:Namespace; not null; index;"`
+	ExperimentId string `gorm:"column:ExperimentUUID; not null;"`
+	// States are the comma-separated notified states of the runs, all the
+	// states if empty.
+	States          string `gorm:"column:States; not null;"`
+	TargetURL       string `gorm:"column:TargetURL; not null; size:2048;"`
+	PayloadTemplate string `gorm:"column:PayloadTemplate; not null; size:65535;"`
+	// Secret is the key of the HMAC signatures of the notifications.
+	Secret         string `gorm:"column:Secret; not null;"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null;"`
+}
+
+// GetStates returns the notified states of the runs.
+func (s *NotificationSubscription) GetStates() []RuntimeState {
+	var states []RuntimeState
+	for _, state := range strings.Split(s.States, ",") {
+		if state != "" {
+			states = append(states, RuntimeState(state))
+		}
+	}
+	return states
+}
+
+// SetStates sets the notified states of the runs.
+func (s *NotificationSubscription) SetStates(states []RuntimeState) {
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, state.ToString())
+	}
+	s.States = strings.Join(values, ",")
+}
+
+// Matches reports whether a state of a run is notified.
+func (s *NotificationSubscription) Matches(state RuntimeState) bool {
+	states := s.GetStates()
+	if len(states) == 0 {
+		return true
+	}
+	for _, notified := range states {
+		if notified == state.ToV2() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s NotificationSubscription) GetValueOfPrimaryKey() string {
+	return s.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model NotificationSubscription.
+func (s *NotificationSubscription) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model NotificationSubscription.
+func (s *NotificationSubscription) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var notificationSubscriptionAPIToModelFieldMap = map[string]string{
+	"subscription_id": "UUID",
+	"display_name":    "DisplayName",
+	"namespace":       "Namespace",
+	"experiment_id":   "ExperimentUUID",
+	"target_url":      "TargetURL",
+	"created_at":      "CreatedAtInSec",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// NotificationSubscription.
+func (s *NotificationSubscription) APIToModelFieldMap() map[string]string {
+	return notificationSubscriptionAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix.
+func (s *NotificationSubscription) GetModelName() string {
+	return "notification_subscriptions"
+}
+
+func (s *NotificationSubscription) GetField(name string) (string, bool) {
+	if field, ok := notificationSubscriptionAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (s *NotificationSubscription) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return s.UUID
+	case "DisplayName":
+		return s.DisplayName
+	case "Namespace":
+		return s.Namespace
+	case "ExperimentUUID":
+		return s.ExperimentId
+	case "TargetURL":
+		return s.TargetURL
+	case "CreatedAtInSec":
+		return s.CreatedAtInSec
+	default:
+		return nil
+	}
+}
+
+func (s *NotificationSubscription) GetSortByFieldPrefix(name string) string {
+	return "notification_subscriptions."
+}
+
+func (s *NotificationSubscription) GetKeyFieldPrefix() string {
+	return "notification_subscriptions."
+}
+
+// NotificationDeliveryStatus is the status of the delivery of a notification.
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "PENDING"
+	NotificationDeliverySucceeded NotificationDeliveryStatus = "SUCCEEDED"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "FAILED"
+)
+
+// NotificationDelivery records the delivery of a notification of a subscription.
+type NotificationDelivery struct {
+	UUID           string       `gorm:"column:UUID; not null; primary_key;"`
+	SubscriptionId string       `gorm:"column:SubscriptionUUID; not null; index;"`
+	RunId          string       `gorm:"column:RunUUID; not null;"`
+	State          RuntimeState `gorm:"column:State; not null;"`
+	// Payload is the body of the notification.
+	Payload         string                     `gorm:"column:Payload; not null; size:65535;"`
+	Status          NotificationDeliveryStatus `gorm:"column:Status; not null; index;"`
+	Attempts        int32                      `gorm:"column:Attempts; not null;"`
+	ResponseCode    int32                      `gorm:"column:ResponseCode; not null;"`
+	ErrorMessage    string                     `gorm:"column:ErrorMessage; not null; size:65535;"`
+	CreatedAtInSec  int64                      `gorm:"column:CreatedAtInSec; not null;"`
+	FinishedAtInSec int64                      `gorm:"column:FinishedAtInSec; not null;"`
+}
+
+func (d NotificationDelivery) GetValueOfPrimaryKey() string {
+	return d.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model NotificationDelivery.
+func (d *NotificationDelivery) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model NotificationDelivery.
+func (d *NotificationDelivery) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var notificationDeliveryAPIToModelFieldMap = map[string]string{
+	"delivery_id":   "UUID",
+	"run_id":        "RunUUID",
+	"state":         "State",
+	"status":        "Status",
+	"response_code": "ResponseCode",
+	"created_at":    "CreatedAtInSec",
+	"finished_at":   "FinishedAtInSec",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// NotificationDelivery.
+func (d *NotificationDelivery) APIToModelFieldMap() map[string]string {
+	return notificationDeliveryAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix.
+func (d *NotificationDelivery) GetModelName() string {
+	return "notification_deliveries"
+}
+
+func (d *NotificationDelivery) GetField(name string) (string, bool) {
+	if field, ok := notificationDeliveryAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (d *NotificationDelivery) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return d.UUID
+	case "RunUUID":
+		return d.RunId
+	case "State":
+		return d.State
+	case "Status":
+		return d.Status
+	case "ResponseCode":
+		return d.ResponseCode
+	case "CreatedAtInSec":
+		return d.CreatedAtInSec
+	case "FinishedAtInSec":
+		return d.FinishedAtInSec
+	default:
+		return nil
+	}
+}
+
+func (d *NotificationDelivery) GetSortByFieldPrefix(name string) string {
+	return "notification_deliveries."
+}
+
+func (d *NotificationDelivery) GetKeyFieldPrefix() string {
+	return "notification_deliveries."
+}
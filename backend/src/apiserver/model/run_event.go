@@ -0,0 +1,46 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RunEventType is the type of a lifecycle event of a run or of one of its tasks.
+type RunEventType string
+
+const (
+	RunEventTypeRunCreated       RunEventType = "RUN_CREATED"
+	RunEventTypeRunStateChanged  RunEventType = "RUN_STATE_CHANGED"
+	RunEventTypeRunDeleted       RunEventType = "RUN_DELETED"
+	RunEventTypeTaskCreated      RunEventType = "TASK_CREATED"
+	RunEventTypeTaskStateChanged RunEventType = "TASK_STATE_CHANGED"
+)
+
+// IsTaskEvent reports whether the event type is the type of a task event.
+func (t RunEventType) IsTaskEvent() bool {
+	return t == RunEventTypeTaskCreated || t == RunEventTypeTaskStateChanged
+}
+
+// RunEvent records a lifecycle event of a run or of one of its tasks. The events
+// are ordered by their sequence number, which resumes watches. The sequence
+// numbers are assigned by the DB, so that they are unique across API servers.
+type RunEvent struct {
+	Sequence       int64        `gorm:"column:Sequence; not null; primary_key; AUTO_INCREMENT"`
+	Type           RunEventType `gorm:"column:Type; not null;"`
+	RunId          string       `gorm:"column:RunUUID; not null;"`
+	ExperimentId   string       `gorm:"column:ExperimentUUID; not null;"`
+	Namespace      string       `gorm:"column:Namespace; not null;"`
+	TaskId         string       `gorm:"column:TaskId; not null;"`
+	TaskName       string       `gorm:"column:TaskName; not null;"`
+	State          RuntimeState `gorm:"column:State; not null;"`
+	CreatedAtInSec int64        `gorm:"column:CreatedAtInSec; not null; index;"`
+}
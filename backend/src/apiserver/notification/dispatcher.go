@@ -0,0 +1,275 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// The interval before the first retry of a notification, which doubles with
+// each retry.
+var initialRetryInterval = time.Second
+
+const (
+	// The maximum length of the error messages of deliveries.
+	maxErrorMessageLength = 1024
+	// The maximum length of the response bodies read to reuse the connections.
+	maxResponseBodyLength = 64 * 1024
+)
+
+// Options configure a Dispatcher.
+type Options struct {
+	// Workers is the number of notifications posted concurrently.
+	Workers int
+	// QueueSize is the number of notifications waiting to be posted. Notifications
+	// fail when the queue is full.
+	QueueSize int
+	// MaxAttempts is the number of attempts to post a notification.
+	MaxAttempts int
+	// Timeout is the timeout of an attempt.
+	Timeout time.Duration
+	// TargetPolicy restricts the addresses to which notifications are posted.
+	// Internal addresses are blocked if it is nil.
+	TargetPolicy *util.TargetPolicy
+}
+
+// Dispatcher posts the notifications of subscriptions, retrying failed
+// attempts with an exponential backoff, and records their deliveries.
+type Dispatcher struct {
+	store  storage.NotificationStoreInterface
+	time   util.TimeInterface
+	policy *util.TargetPolicy
+	client *http.Client
+	opts   Options
+	queue  chan *model.NotificationDelivery
+}
+
+// NewDispatcher creates a Dispatcher.
+func NewDispatcher(store storage.NotificationStoreInterface, time util.TimeInterface, opts Options) *Dispatcher {
+	policy := opts.TargetPolicy
+	if policy == nil {
+		policy = &util.TargetPolicy{}
+	}
+	return &Dispatcher{
+		store:  store,
+		time:   time,
+		policy: policy,
+		client: &http.Client{Transport: policy.NewTransport(opts.Timeout), Timeout: opts.Timeout},
+		opts:   opts,
+		queue:  make(chan *model.NotificationDelivery, opts.QueueSize),
+	}
+}
+
+// NewDispatcherFromConfig creates a Dispatcher configured by the API server config.
+func NewDispatcherFromConfig(store storage.NotificationStoreInterface, time util.TimeInterface) *Dispatcher {
+	policy, err := util.NewTargetPolicy(common.GetNotificationAllowedNetworks())
+	if err != nil {
+		glog.Fatalf("Invalid %v config: %v", common.NotificationAllowedNetworks, err)
+	}
+	return NewDispatcher(store, time, Options{
+		Workers:      common.GetNotificationWorkers(),
+		QueueSize:    common.GetNotificationQueueSize(),
+		MaxAttempts:  common.GetNotificationMaxAttempts(),
+		Timeout:      common.GetNotificationTimeout(),
+		TargetPolicy: policy,
+	})
+}
+
+// ValidateTargetURL checks that notifications may be posted to a target URL.
+func (d *Dispatcher) ValidateTargetURL(targetURL string) error {
+	return d.policy.ValidateTargetURL(targetURL)
+}
+
+// Notify records a pending delivery of the notification of an event to a
+// subscription, and queues it to be posted.
+func (d *Dispatcher) Notify(subscription *model.NotificationSubscription, event *Event) error {
+	delivery := &model.NotificationDelivery{
+		SubscriptionId: subscription.UUID,
+		RunId:          event.RunId,
+		State:          model.RuntimeState(event.State),
+		Status:         model.NotificationDeliveryPending,
+		CreatedAtInSec: event.Time.Unix(),
+	}
+	payload, err := RenderPayload(subscription.PayloadTemplate, event)
+	if err != nil {
+		delivery.Status = model.NotificationDeliveryFailed
+		delivery.ErrorMessage = err.Error()
+		delivery.FinishedAtInSec = d.time.Now().Unix()
+	}
+	delivery.Payload = string(payload)
+	delivery, err = d.store.CreateNotificationDelivery(delivery)
+	if err != nil {
+		return util.Wrapf(err, "Failed to notify subscription %v of run %v", subscription.UUID, event.RunId)
+	}
+	if delivery.Status == model.NotificationDeliveryPending {
+		d.enqueue(delivery)
+	}
+	return nil
+}
+
+// enqueue queues a delivery, or fails it if the queue is full.
+func (d *Dispatcher) enqueue(delivery *model.NotificationDelivery) {
+	select {
+	case d.queue <- delivery:
+	default:
+		delivery.ErrorMessage = "The notification queue is full"
+		d.finish(delivery, model.NotificationDeliveryFailed)
+	}
+}
+
+// Run posts the queued notifications until the context is done. The pending
+// deliveries of a previous API server are queued first.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery := <-d.queue:
+					d.deliver(ctx, delivery)
+				}
+			}
+		}()
+	}
+	pending, err := d.store.ListPendingNotificationDeliveries()
+	if err != nil {
+		glog.Errorf("Failed to list the pending notification deliveries: %v", err)
+	}
+	for _, delivery := range pending {
+		select {
+		case d.queue <- delivery:
+		case <-ctx.Done():
+		}
+	}
+	wg.Wait()
+}
+
+// deliver posts a notification until it succeeds, fails permanently or runs out
+// of attempts. A delivery interrupted by the end of the context stays pending.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *model.NotificationDelivery) {
+	subscription, err := d.store.GetNotificationSubscription(delivery.SubscriptionId)
+	if err != nil {
+		if util.IsNotFound(err) {
+			// The subscription and its deliveries were deleted.
+			return
+		}
+		delivery.ErrorMessage = err.Error()
+		d.finish(delivery, model.NotificationDeliveryFailed)
+		return
+	}
+
+	policy := backoff.NewExponentialBackOff()
+	policy.InitialInterval = initialRetryInterval
+	policy.MaxElapsedTime = 0
+	attempts := d.opts.MaxAttempts - int(delivery.Attempts)
+	if attempts < 1 {
+		attempts = 1
+	}
+	err = backoff.Retry(func() error {
+		delivery.Attempts++
+		responseCode, err := d.post(ctx, subscription, delivery)
+		delivery.ResponseCode = int32(responseCode)
+		if err != nil {
+			delivery.ErrorMessage = truncate(err.Error())
+			if err := d.store.UpdateNotificationDelivery(delivery); err != nil {
+				glog.Warningf("Failed to record the attempt of notification delivery %v: %v", delivery.UUID, err)
+			}
+			return err
+		}
+		delivery.ErrorMessage = ""
+		return nil
+	}, backoff.WithContext(backoff.WithMaxRetries(policy, uint64(attempts-1)), ctx))
+	if err == nil {
+		d.finish(delivery, model.NotificationDeliverySucceeded)
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	glog.Warningf("Failed to deliver notification %v of subscription %v after %v attempts: %v", delivery.UUID, subscription.UUID, delivery.Attempts, err)
+	d.finish(delivery, model.NotificationDeliveryFailed)
+}
+
+// post posts a notification and returns the status code of the response.
+// Client errors are permanent, except timeouts and throttling.
+func (d *Dispatcher) post(ctx context.Context, subscription *model.NotificationSubscription, delivery *model.NotificationDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.TargetURL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, backoff.Permanent(err)
+	}
+	timestamp := strconv.FormatInt(d.time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(DeliveryHeader, delivery.UUID)
+	request.Header.Set(TimestampHeader, timestamp)
+	if subscription.Secret != "" {
+		request.Header.Set(SignatureHeader, Sign(subscription.Secret, timestamp, payload))
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		if util.IsBlockedTargetError(err) {
+			return 0, backoff.Permanent(err)
+		}
+		return 0, err
+	}
+	defer response.Body.Close()
+	// The body is not recorded, since the receiver may not be trusted with
+	// the content shown to the users of the subscription.
+	io.Copy(ioutil.Discard, io.LimitReader(response.Body, maxResponseBodyLength))
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return response.StatusCode, nil
+	}
+	err = errors.Errorf("Notification rejected with status %v", response.Status)
+	if response.StatusCode >= 400 && response.StatusCode < 500 &&
+		response.StatusCode != http.StatusRequestTimeout && response.StatusCode != http.StatusTooManyRequests {
+		return response.StatusCode, backoff.Permanent(err)
+	}
+	return response.StatusCode, err
+}
+
+// finish records the final status of a delivery.
+func (d *Dispatcher) finish(delivery *model.NotificationDelivery, status model.NotificationDeliveryStatus) {
+	delivery.Status = status
+	delivery.FinishedAtInSec = d.time.Now().Unix()
+	if err := d.store.UpdateNotificationDelivery(delivery); err != nil {
+		glog.Errorf("Failed to record notification delivery %v: %v", delivery.UUID, err)
+	}
+}
+
+func truncate(message string) string {
+	if len(message) > maxErrorMessageLength {
+		return message[:maxErrorMessageLength]
+	}
+	return message
+}
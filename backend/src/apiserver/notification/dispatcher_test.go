@@ -0,0 +1,256 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func initWithSubscription(t *testing.T, targetURL string, queueSize int) (*storage.DB, *storage.NotificationStore, *Dispatcher, *model.NotificationSubscription) {
+	db := storage.NewFakeDBOrFatal()
+	store := storage.NewNotificationStore(db, util.NewFakeTimeForEpoch(), util.NewUUIDGenerator())
+	subscription, err := store.CreateNotificationSubscription(&model.NotificationSubscription{
+		DisplayName: "failures",
+		Namespace:   "ns1",
+		States:      "FAILED",
+		TargetURL:   targetURL,
+		Secret:      "secret",
+	})
+	require.Nil(t, err)
+	// The test servers listen on the loopback address.
+	policy, err := util.NewTargetPolicy([]string{"127.0.0.0/8"})
+	require.Nil(t, err)
+	dispatcher := NewDispatcher(store, util.NewFakeTimeForEpoch(), Options{
+		Workers:      1,
+		QueueSize:    queueSize,
+		MaxAttempts:  3,
+		Timeout:      time.Second,
+		TargetPolicy: policy,
+	})
+	return db, store, dispatcher, subscription
+}
+
+func listDeliveries(t *testing.T, store *storage.NotificationStore, subscriptionId string) []*model.NotificationDelivery {
+	opts, err := list.NewOptions(&model.NotificationDelivery{}, 10, "", nil)
+	require.Nil(t, err)
+	deliveries, _, _, err := store.ListNotificationDeliveries(subscriptionId, opts)
+	require.Nil(t, err)
+	return deliveries
+}
+
+// notifyAndDeliver notifies the subscription of the test event and posts the
+// queued notification.
+func notifyAndDeliver(t *testing.T, dispatcher *Dispatcher, subscription *model.NotificationSubscription) {
+	defer func(interval time.Duration) { initialRetryInterval = interval }(initialRetryInterval)
+	initialRetryInterval = time.Millisecond
+	require.Nil(t, dispatcher.Notify(subscription, testEvent))
+	dispatcher.deliver(context.Background(), <-dispatcher.queue)
+}
+
+func TestDispatcher_Succeeded(t *testing.T) {
+	var request *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+	db, store, dispatcher, subscription := initWithSubscription(t, server.URL, 1)
+	defer db.Close()
+
+	notifyAndDeliver(t, dispatcher, subscription)
+
+	deliveries := listDeliveries(t, store, subscription.UUID)
+	require.Len(t, deliveries, 1)
+	delivery := deliveries[0]
+	assert.Equal(t, model.NotificationDeliverySucceeded, delivery.Status)
+	assert.Equal(t, int32(1), delivery.Attempts)
+	assert.Equal(t, int32(http.StatusOK), delivery.ResponseCode)
+	assert.Equal(t, "run1", delivery.RunId)
+	assert.Equal(t, model.RuntimeStateFailed, delivery.State)
+	assert.NotZero(t, delivery.FinishedAtInSec)
+
+	payload, err := RenderPayload("", testEvent)
+	require.Nil(t, err)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+	assert.Equal(t, delivery.UUID, request.Header.Get(DeliveryHeader))
+	assert.Equal(t, Sign("secret", request.Header.Get(TimestampHeader), body), request.Header.Get(SignatureHeader))
+}
+
+func TestDispatcher_Retried(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	db, store, dispatcher, subscription := initWithSubscription(t, server.URL, 1)
+	defer db.Close()
+
+	notifyAndDeliver(t, dispatcher, subscription)
+
+	deliveries := listDeliveries(t, store, subscription.UUID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, model.NotificationDeliverySucceeded, deliveries[0].Status)
+	assert.Equal(t, int32(2), deliveries[0].Attempts)
+	assert.Empty(t, deliveries[0].ErrorMessage)
+}
+
+func TestDispatcher_Failed(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		attempts int32
+	}{
+		{"server error", http.StatusInternalServerError, 3},
+		{"throttled", http.StatusTooManyRequests, 3},
+		{"client error", http.StatusBadRequest, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "internal details", tt.code)
+			}))
+			defer server.Close()
+			db, store, dispatcher, subscription := initWithSubscription(t, server.URL, 1)
+			defer db.Close()
+
+			notifyAndDeliver(t, dispatcher, subscription)
+
+			deliveries := listDeliveries(t, store, subscription.UUID)
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, model.NotificationDeliveryFailed, deliveries[0].Status)
+			assert.Equal(t, tt.attempts, deliveries[0].Attempts)
+			assert.Equal(t, int32(tt.code), deliveries[0].ResponseCode)
+			assert.Contains(t, deliveries[0].ErrorMessage, "Notification rejected with status")
+			assert.NotContains(t, deliveries[0].ErrorMessage, "internal details")
+		})
+	}
+}
+
+func TestDispatcher_BlockedTarget(t *testing.T) {
+	var posted int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posted, 1)
+	}))
+	defer server.Close()
+	db, store, dispatcher, subscription := initWithSubscription(t, server.URL, 1)
+	defer db.Close()
+	// The default policy blocks the loopback address of the test server.
+	blocking := NewDispatcher(store, util.NewFakeTimeForEpoch(), Options{
+		Workers:     1,
+		QueueSize:   1,
+		MaxAttempts: 3,
+		Timeout:     time.Second,
+	})
+	blocking.queue = dispatcher.queue
+
+	notifyAndDeliver(t, blocking, subscription)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&posted))
+	deliveries := listDeliveries(t, store, subscription.UUID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, model.NotificationDeliveryFailed, deliveries[0].Status)
+	assert.Equal(t, int32(1), deliveries[0].Attempts)
+	assert.Contains(t, deliveries[0].ErrorMessage, "Notifications can't be posted to address 127.0.0.1")
+}
+
+func TestDispatcher_InvalidTemplate(t *testing.T) {
+	db, store, dispatcher, subscription := initWithSubscription(t, "http://localhost", 1)
+	defer db.Close()
+	subscription.PayloadTemplate = "{{.Unknown}}"
+
+	require.Nil(t, dispatcher.Notify(subscription, testEvent))
+
+	assert.Len(t, dispatcher.queue, 0)
+	deliveries := listDeliveries(t, store, subscription.UUID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, model.NotificationDeliveryFailed, deliveries[0].Status)
+	assert.Equal(t, int32(0), deliveries[0].Attempts)
+	assert.Contains(t, deliveries[0].ErrorMessage, "Failed to render the payload template")
+}
+
+func TestDispatcher_QueueFull(t *testing.T) {
+	db, store, dispatcher, subscription := initWithSubscription(t, "http://localhost", 1)
+	defer db.Close()
+
+	require.Nil(t, dispatcher.Notify(subscription, testEvent))
+	require.Nil(t, dispatcher.Notify(subscription, testEvent))
+
+	statuses := make(map[model.NotificationDeliveryStatus]int)
+	for _, delivery := range listDeliveries(t, store, subscription.UUID) {
+		statuses[delivery.Status]++
+		if delivery.Status == model.NotificationDeliveryFailed {
+			assert.Equal(t, "The notification queue is full", delivery.ErrorMessage)
+		}
+	}
+	assert.Equal(t, map[model.NotificationDeliveryStatus]int{
+		model.NotificationDeliveryPending: 1,
+		model.NotificationDeliveryFailed:  1,
+	}, statuses)
+}
+
+func TestDispatcher_RunResumesPendingDeliveries(t *testing.T) {
+	delivered := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- r.Header.Get(DeliveryHeader)
+	}))
+	defer server.Close()
+	db, store, dispatcher, subscription := initWithSubscription(t, server.URL, 1)
+	defer db.Close()
+	// A delivery left pending by a previous API server.
+	pending, err := store.CreateNotificationDelivery(&model.NotificationDelivery{
+		SubscriptionId: subscription.UUID,
+		RunId:          "run1",
+		State:          model.RuntimeStateFailed,
+		Payload:        "{}",
+		Status:         model.NotificationDeliveryPending,
+	})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		dispatcher.Run(ctx)
+	}()
+	select {
+	case id := <-delivered:
+		assert.Equal(t, pending.UUID, id)
+	case <-time.After(10 * time.Second):
+		t.Fatal("The pending delivery was not posted")
+	}
+	// The delivery is recorded after the response.
+	assert.Eventually(t, func() bool {
+		return listDeliveries(t, store, subscription.UUID)[0].Status == model.NotificationDeliverySucceeded
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	<-stopped
+}
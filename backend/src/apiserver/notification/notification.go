@@ -0,0 +1,98 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notification posts notifications of the state changes of runs to the
+// URLs of notification subscriptions.
+package notification
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"text/template"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	// DeliveryHeader is the header of the ID of the delivery of a notification.
+	DeliveryHeader = "X-KFP-Delivery"
+	// TimestampHeader is the header of the time, in seconds since the epoch, at
+	// which a notification was posted.
+	TimestampHeader = "X-KFP-Timestamp"
+	// SignatureHeader is the header of the HMAC-SHA256 signature of a notification.
+	SignatureHeader = "X-KFP-Signature-256"
+)
+
+// Event is a state change of a run, from which the payloads of notifications
+// are rendered.
+type Event struct {
+	RunId         string    `json:"run_id"`
+	RunName       string    `json:"run_name"`
+	ExperimentId  string    `json:"experiment_id"`
+	Namespace     string    `json:"namespace"`
+	State         string    `json:"state"`
+	PreviousState string    `json:"previous_state"`
+	Time          time.Time `json:"time"`
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// ValidatePayloadTemplate checks that a payload template parses.
+func ValidatePayloadTemplate(payloadTemplate string) error {
+	if _, err := template.New("payload").Funcs(templateFuncs).Parse(payloadTemplate); err != nil {
+		return util.NewInvalidInputError("Invalid payload template: %v", err)
+	}
+	return nil
+}
+
+// RenderPayload renders the payload of a notification of an event with a
+// template, or as a JSON document if the template is empty.
+func RenderPayload(payloadTemplate string, event *Event) ([]byte, error) {
+	if payloadTemplate == "" {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to marshal the notification of run %v", event.RunId)
+		}
+		return payload, nil
+	}
+	tmpl, err := template.New("payload").Funcs(templateFuncs).Option("missingkey=error").Parse(payloadTemplate)
+	if err != nil {
+		return nil, util.NewInvalidInputError("Invalid payload template: %v", err)
+	}
+	var payload bytes.Buffer
+	if err := tmpl.Execute(&payload, event); err != nil {
+		return nil, util.NewInvalidInputError("Failed to render the payload template: %v", err)
+	}
+	return payload.Bytes(), nil
+}
+
+// Sign returns the signature of a notification posted at a time, in seconds
+// since the epoch: sha256= followed by the hex HMAC-SHA256 of the timestamp, a
+// dot and the payload.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
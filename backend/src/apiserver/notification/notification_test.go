@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvent = &Event{
+	RunId:         "run1",
+	RunName:       `train "model"`,
+	ExperimentId:  "experiment1",
+	Namespace:     "ns1",
+	State:         "FAILED",
+	PreviousState: "RUNNING",
+	Time:          time.Unix(60, 0).UTC(),
+}
+
+func TestRenderPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		payload  string
+	}{
+		{
+			"default",
+			"",
+			`{"run_id":"run1","run_name":"train \"model\"","experiment_id":"experiment1","namespace":"ns1","state":"FAILED","previous_state":"RUNNING","time":"1970-01-01T00:01:00Z"}`,
+		},
+		{
+			"slack",
+			`{"text": {{json (printf "Run %s %s" .RunName .State)}}}`,
+			`{"text": "Run train \"model\" FAILED"}`,
+		},
+		{
+			"time",
+			`{{.Time.Unix}}`,
+			`60`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := RenderPayload(tt.template, testEvent)
+			require.Nil(t, err)
+			assert.Equal(t, tt.payload, string(payload))
+		})
+	}
+}
+
+func TestRenderPayload_Error(t *testing.T) {
+	_, err := RenderPayload(`{{.Unknown}}`, testEvent)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to render the payload template")
+
+	_, err = RenderPayload(`{{.RunId`, testEvent)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid payload template")
+}
+
+func TestValidatePayloadTemplate(t *testing.T) {
+	assert.Nil(t, ValidatePayloadTemplate(""))
+	assert.Nil(t, ValidatePayloadTemplate(`{"text": {{json .RunName}}}`))
+	assert.NotNil(t, ValidatePayloadTemplate(`{{if .State}}`))
+	assert.NotNil(t, ValidatePayloadTemplate(`{{unknown .State}}`))
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '60.{}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=dab8c36dca4be0b7d9249e585ddfa50287a811af9d702ee3eef1670f4f21bc6e", Sign("secret", "60", []byte("{}")))
+	assert.NotEqual(t, Sign("secret", "60", []byte("{}")), Sign("secret", "61", []byte("{}")))
+	assert.NotEqual(t, Sign("secret", "60", []byte("{}")), Sign("other", "60", []byte("{}")))
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	apiv1beta1 "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterKey is the metadata key of the number of seconds after which
+// throttled calls can be retried.
+const RetryAfterKey = "retry-after"
+
+// retryAfterSeconds rounds up a duration to whole seconds.
+func retryAfterSeconds(retryAfter time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+}
+
+// UnaryServerInterceptor rejects the RPC calls exceeding the rate limits with
+// RESOURCE_EXHAUSTED. The excluded methods are not limited.
+func (l *Limiter) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if l.excludes(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	clientAddress := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		clientAddress = hostOf(p.Addr.String())
+	}
+	class := GetMethodClass(info.FullMethod)
+	allowed, retryAfter := l.allow(ctx, clientAddress, common.GetNamespaceFromRequest(req), class)
+	if !allowed {
+		throttledRequests.WithLabelValues(info.FullMethod, class).Inc()
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, retryAfterSeconds(retryAfter))); err != nil {
+			glog.Warningf("Failed to set the retry-after header of %v: %v", info.FullMethod, err)
+		}
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"Rate limit exceeded for %v calls, retry after %v", class, retryAfter.Round(time.Millisecond))
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects the streaming RPC calls exceeding the rate
+// limits with RESOURCE_EXHAUSTED. The calls are limited when the streams are
+// opened, before their requests are received, so regardless of their namespace.
+// The excluded methods are not limited.
+func (l *Limiter) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if l.excludes(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	ctx := stream.Context()
+	clientAddress := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		clientAddress = hostOf(p.Addr.String())
+	}
+	class := GetMethodClass(info.FullMethod)
+	allowed, retryAfter := l.allow(ctx, clientAddress, "", class)
+	if !allowed {
+		throttledRequests.WithLabelValues(info.FullMethod, class).Inc()
+		if err := stream.SetHeader(metadata.Pairs(RetryAfterKey, retryAfterSeconds(retryAfter))); err != nil {
+			glog.Warningf("Failed to set the retry-after header of %v: %v", info.FullMethod, err)
+		}
+		return status.Errorf(codes.ResourceExhausted,
+			"Rate limit exceeded for %v calls, retry after %v", class, retryAfter.Round(time.Millisecond))
+	}
+	return handler(srv, stream)
+}
+
+// HTTPHandler rejects the calls of an HTTP handler of a method class exceeding
+// the rate limits with 429 Too Many Requests.
+func (l *Limiter) HTTPHandler(class string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		md := metadata.MD{}
+		for key, values := range r.Header {
+			md.Set(key, values...)
+		}
+		ctx := metadata.NewIncomingContext(r.Context(), md)
+		allowed, retryAfter := l.allow(ctx, hostOf(r.RemoteAddr), r.URL.Query().Get("namespace"), class)
+		if !allowed {
+			throttledRequests.WithLabelValues(r.Method+" "+r.URL.Path, class).Inc()
+			message := fmt.Sprintf("Rate limit exceeded for %v calls, retry after %v", class, retryAfter.Round(time.Millisecond))
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Retry-After", retryAfterSeconds(retryAfter))
+			w.WriteHeader(http.StatusTooManyRequests)
+			errBytes, err := json.Marshal(&apiv1beta1.Error{ErrorMessage: message, ErrorDetails: message})
+			if err != nil {
+				w.Write([]byte(message))
+				return
+			}
+			w.Write(errBytes)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// hostOf returns the host of an address, or the address if it has no port.
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
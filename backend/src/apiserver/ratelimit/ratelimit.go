@@ -0,0 +1,312 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/metadata"
+)
+
+var throttledRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "rate_limiter_throttled_requests",
+	Help: "The number of API calls rejected by the rate limiter",
+}, []string{"method", "class"})
+
+// Limit is the rate and burst of a token bucket.
+type Limit struct {
+	// QPS is the rate at which tokens are added, zero for no limit.
+	QPS float64
+	// Burst is the capacity of the bucket.
+	Burst int
+}
+
+const (
+	// The interval at which the idle buckets and the expired identities are removed.
+	sweepInterval = time.Minute
+	// How long the identity of the credentials of a caller is cached.
+	identityTTL = time.Minute
+	// The maximum number of cached identities.
+	maxIdentities = 10000
+)
+
+// Options configure how a Limiter identifies the callers.
+type Options struct {
+	// CredentialHeaders are the headers read by the authenticators. The
+	// identities are cached per credentials, so that a caller is not
+	// authenticated again, e.g. with a TokenReview, on each call.
+	CredentialHeaders []string
+	// TrustedProxies are the networks of the proxies whose x-forwarded-for
+	// header gives the address of their client. The API server's own HTTP
+	// proxy connects from the loopback address.
+	TrustedProxies []*net.IPNet
+	// ExcludedMethods are the names of the RPC methods and services which are
+	// not rate limited, e.g. ReportService.
+	ExcludedMethods []string
+}
+
+// Limiter limits the rate of the API calls of each user in each namespace,
+// with a token bucket per user, namespace and method class.
+type Limiter struct {
+	limits map[string]Limit
+	// authenticators identify the callers. Calls are limited per client
+	// address when no authenticator identifies the caller.
+	authenticators []auth.Authenticator
+	opts           Options
+	time           util.TimeInterface
+
+	mu         sync.Mutex
+	buckets    map[bucketKey]*bucket
+	identities map[[sha256.Size]byte]*identity
+	lastSweep  time.Time
+}
+
+// identity is the cached identity of credentials, empty if no authenticator
+// identifies them.
+type identity struct {
+	user    string
+	expires time.Time
+}
+
+type bucketKey struct {
+	user      string
+	namespace string
+	class     string
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter creates a Limiter with limits per method class. Classes without a
+// limit are not rate limited.
+func NewLimiter(limits map[string]Limit, authenticators []auth.Authenticator, opts Options, time util.TimeInterface) *Limiter {
+	return &Limiter{
+		limits:         limits,
+		authenticators: authenticators,
+		opts:           opts,
+		time:           time,
+		buckets:        make(map[bucketKey]*bucket),
+		identities:     make(map[[sha256.Size]byte]*identity),
+	}
+}
+
+// GetLimits returns the configured limits of all method classes.
+func GetLimits() map[string]Limit {
+	limits := make(map[string]Limit)
+	for _, class := range []string{common.ListMethodClass, common.GetMethodClass, common.MutateMethodClass} {
+		qps, burst := common.GetRateLimit(class)
+		limits[class] = Limit{QPS: qps, Burst: burst}
+	}
+	return limits
+}
+
+// GetOptions returns the configured options of the identification of the callers.
+func GetOptions() (Options, error) {
+	var trustedProxies []*net.IPNet
+	for _, cidr := range common.GetRateLimitTrustedProxies() {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return Options{}, errors.Wrapf(err, "Invalid trusted proxy network %q", cidr)
+		}
+		trustedProxies = append(trustedProxies, network)
+	}
+	return Options{
+		CredentialHeaders: []string{common.AuthorizationBearerTokenHeader, common.GetKubeflowUserIDHeader()},
+		TrustedProxies:    trustedProxies,
+		ExcludedMethods:   common.GetRateLimitExcludedMethods(),
+	}, nil
+}
+
+// GetMethodClass returns the class of a full RPC method name.
+func GetMethodClass(fullMethod string) string {
+	if common.IsMutatingMethod(fullMethod) {
+		return common.MutateMethodClass
+	}
+	if name := common.GetMethodName(fullMethod); len(name) >= 4 && name[:4] == "List" {
+		return common.ListMethodClass
+	}
+	return common.GetMethodClass
+}
+
+// excludes reports whether a full RPC method name is excluded from the rate limits.
+func (l *Limiter) excludes(fullMethod string) bool {
+	// The full method name is /<package>.<service>/<method>.
+	service := strings.TrimPrefix(fullMethod[:strings.LastIndex(fullMethod, "/")], "/")
+	service = service[strings.LastIndex(service, ".")+1:]
+	method := common.GetMethodName(fullMethod)
+	for _, excluded := range l.opts.ExcludedMethods {
+		if excluded == method || excluded == service {
+			return true
+		}
+	}
+	return false
+}
+
+// allow takes a token from the bucket of the caller of a call. It returns
+// how long to wait before retrying if the bucket is empty.
+func (l *Limiter) allow(ctx context.Context, clientAddress string, namespace string, class string) (bool, time.Duration) {
+	limit, ok := l.limits[class]
+	if !ok || limit.QPS <= 0 {
+		return true, 0
+	}
+	now := l.time.Now()
+	key := bucketKey{user: l.identify(ctx, clientAddress, now), namespace: namespace, class: class}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.QPS)
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	retryAfter := time.Duration((1 - b.tokens) / limit.QPS * float64(time.Second))
+	return false, retryAfter
+}
+
+// sweep removes the buckets which are full again, as they are equivalent to
+// new buckets, and the expired identities.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		limit := l.limits[key.class]
+		if b.tokens+now.Sub(b.last).Seconds()*limit.QPS >= float64(limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+	for key, cached := range l.identities {
+		if !now.Before(cached.expires) {
+			delete(l.identities, key)
+		}
+	}
+}
+
+// identify returns the caller of a call, or its client address if no
+// authenticator identifies it. The callers are only authenticated when their
+// credentials are not cached yet.
+func (l *Limiter) identify(ctx context.Context, peerAddress string, now time.Time) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	clientAddress := l.clientAddress(md, peerAddress)
+	credentials, ok := l.credentialsKey(md)
+	if !ok {
+		return clientAddress
+	}
+
+	l.mu.Lock()
+	cached, ok := l.identities[credentials]
+	l.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		if cached.user == "" {
+			return clientAddress
+		}
+		return cached.user
+	}
+
+	user := ""
+	for _, authenticator := range l.authenticators {
+		if identity, err := authenticator.GetUserIdentity(ctx); err == nil {
+			user = identity
+			break
+		}
+	}
+	l.mu.Lock()
+	if _, ok := l.identities[credentials]; ok || len(l.identities) < maxIdentities {
+		l.identities[credentials] = &identity{user: user, expires: now.Add(identityTTL)}
+	}
+	l.mu.Unlock()
+	if user == "" {
+		return clientAddress
+	}
+	return user
+}
+
+// credentialsKey returns the hash of the credential headers of a call, or
+// false if the call has no credentials.
+func (l *Limiter) credentialsKey(md metadata.MD) ([sha256.Size]byte, bool) {
+	hash := sha256.New()
+	found := false
+	for _, header := range l.opts.CredentialHeaders {
+		values := md.Get(header)
+		found = found || len(values) > 0
+		hash.Write([]byte(strings.ToLower(header)))
+		for _, value := range values {
+			hash.Write([]byte{0})
+			hash.Write([]byte(value))
+		}
+		hash.Write([]byte{0, 0})
+	}
+	var key [sha256.Size]byte
+	copy(key[:], hash.Sum(nil))
+	return key, found
+}
+
+// clientAddress returns the address of the client of a call. The
+// x-forwarded-for header is only trusted from the trusted proxies: its
+// addresses are read from the right, skipping the trusted proxies, since the
+// client may set any address on the left.
+func (l *Limiter) clientAddress(md metadata.MD, peerAddress string) string {
+	if !l.isTrustedProxy(peerAddress) {
+		return peerAddress
+	}
+	forwardedFor := md.Get("x-forwarded-for")
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		addresses := strings.Split(forwardedFor[i], ",")
+		for j := len(addresses) - 1; j >= 0; j-- {
+			address := strings.TrimSpace(addresses[j])
+			if address != "" && !l.isTrustedProxy(address) {
+				return address
+			}
+		}
+	}
+	return peerAddress
+}
+
+func (l *Limiter) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, network := range l.opts.TrustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
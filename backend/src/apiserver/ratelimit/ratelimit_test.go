@@ -0,0 +1,325 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const userIDHeader = "kubeflow-userid"
+
+type manualTime struct {
+	now time.Time
+}
+
+func (t *manualTime) Now() time.Time {
+	return t.now
+}
+
+func (t *manualTime) advance(d time.Duration) {
+	t.now = t.now.Add(d)
+}
+
+// countingAuthenticator counts the authentications of the callers.
+type countingAuthenticator struct {
+	auth.Authenticator
+	calls int
+}
+
+func (a *countingAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
+	a.calls++
+	return a.Authenticator.GetUserIdentity(ctx)
+}
+
+func newTestLimiterWithAuthenticator() (*Limiter, *manualTime, *countingAuthenticator) {
+	clock := &manualTime{now: time.Unix(1000, 0)}
+	limits := map[string]Limit{
+		common.ListMethodClass:   {QPS: 1, Burst: 2},
+		common.MutateMethodClass: {QPS: 0.5, Burst: 1},
+		common.GetMethodClass:    {QPS: 0},
+	}
+	authenticator := &countingAuthenticator{Authenticator: auth.NewHTTPHeaderAuthenticator(userIDHeader, "")}
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	opts := Options{CredentialHeaders: []string{userIDHeader}, TrustedProxies: []*net.IPNet{loopback}, ExcludedMethods: []string{"ReportService", "DeleteRun"}}
+	return NewLimiter(limits, []auth.Authenticator{authenticator}, opts, clock), clock, authenticator
+}
+
+func newTestLimiter() (*Limiter, *manualTime) {
+	limiter, clock, _ := newTestLimiterWithAuthenticator()
+	return limiter, clock
+}
+
+func contextForUser(user string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDHeader, user))
+}
+
+func TestGetMethodClass(t *testing.T) {
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRuns", common.ListMethodClass},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/GetRun", common.GetMethodClass},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/ReadArtifact", common.GetMethodClass},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun", common.MutateMethodClass},
+		{"/kubeflow.pipelines.backend.api.v2beta1.RunService/TerminateRun", common.MutateMethodClass},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetMethodClass(tt.method))
+		})
+	}
+}
+
+func TestAllow_TokenBucket(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	ctx := contextForUser("user1@google.com")
+
+	// The bucket starts full.
+	for i := 0; i < 2; i++ {
+		allowed, _ := limiter.allow(ctx, "", "ns1", common.ListMethodClass)
+		assert.True(t, allowed)
+	}
+	allowed, retryAfter := limiter.allow(ctx, "", "ns1", common.ListMethodClass)
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// Tokens are added at the rate of the limit.
+	clock.advance(500 * time.Millisecond)
+	allowed, retryAfter = limiter.allow(ctx, "", "ns1", common.ListMethodClass)
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+	clock.advance(500 * time.Millisecond)
+	allowed, _ = limiter.allow(ctx, "", "ns1", common.ListMethodClass)
+	assert.True(t, allowed)
+
+	// Up to the burst.
+	clock.advance(time.Hour)
+	for i := 0; i < 2; i++ {
+		allowed, _ := limiter.allow(ctx, "", "ns1", common.ListMethodClass)
+		assert.True(t, allowed)
+	}
+	allowed, _ = limiter.allow(ctx, "", "ns1", common.ListMethodClass)
+	assert.False(t, allowed)
+}
+
+func TestAllow_SeparateBuckets(t *testing.T) {
+	limiter, _ := newTestLimiter()
+
+	allowed, _ := limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.MutateMethodClass)
+	assert.True(t, allowed)
+	allowed, _ = limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.MutateMethodClass)
+	assert.False(t, allowed)
+
+	// Each user, namespace and method class has its own bucket.
+	allowed, _ = limiter.allow(contextForUser("user2@google.com"), "", "ns1", common.MutateMethodClass)
+	assert.True(t, allowed)
+	allowed, _ = limiter.allow(contextForUser("user1@google.com"), "", "ns2", common.MutateMethodClass)
+	assert.True(t, allowed)
+	allowed, _ = limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.ListMethodClass)
+	assert.True(t, allowed)
+
+	// Unidentified callers are limited per client address.
+	allowed, _ = limiter.allow(context.Background(), "10.0.0.1", "ns1", common.MutateMethodClass)
+	assert.True(t, allowed)
+	allowed, _ = limiter.allow(context.Background(), "10.0.0.2", "ns1", common.MutateMethodClass)
+	assert.True(t, allowed)
+	allowed, _ = limiter.allow(context.Background(), "10.0.0.1", "ns1", common.MutateMethodClass)
+	assert.False(t, allowed)
+
+	// Classes without a rate are not limited.
+	for i := 0; i < 10; i++ {
+		allowed, _ = limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.GetMethodClass)
+		assert.True(t, allowed)
+	}
+}
+
+func TestAllow_ClientAddress(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	forwardedFor := func(addresses ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", strings.Join(addresses, ", ")))
+	}
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		peerAddress string
+		want        string
+	}{
+		{"no proxy", context.Background(), "10.0.0.1", "10.0.0.1"},
+		{"header set by an untrusted client", forwardedFor("10.0.0.9"), "10.0.0.1", "10.0.0.1"},
+		{"header set by a trusted proxy", forwardedFor("10.0.0.1"), "127.0.0.1", "10.0.0.1"},
+		{"address spoofed by the client of a trusted proxy", forwardedFor("10.0.0.9", "10.0.0.1"), "127.0.0.1", "10.0.0.1"},
+		{"chain of trusted proxies", forwardedFor("10.0.0.1", "127.0.0.2"), "127.0.0.1", "10.0.0.1"},
+		{"trusted proxy without header", context.Background(), "127.0.0.1", "127.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, _ := metadata.FromIncomingContext(tt.ctx)
+			assert.Equal(t, tt.want, limiter.clientAddress(md, tt.peerAddress))
+		})
+	}
+}
+
+func TestAllow_CachesIdentities(t *testing.T) {
+	limiter, clock, authenticator := newTestLimiterWithAuthenticator()
+
+	for i := 0; i < 3; i++ {
+		limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.ListMethodClass)
+	}
+	assert.Equal(t, 1, authenticator.calls)
+	limiter.allow(contextForUser("user2@google.com"), "", "ns1", common.ListMethodClass)
+	assert.Equal(t, 2, authenticator.calls)
+
+	// Calls without credentials are not authenticated.
+	limiter.allow(context.Background(), "10.0.0.1", "ns1", common.ListMethodClass)
+	assert.Equal(t, 2, authenticator.calls)
+
+	// The identities expire.
+	clock.advance(identityTTL)
+	limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.ListMethodClass)
+	assert.Equal(t, 3, authenticator.calls)
+	assert.Equal(t, 1, len(limiter.identities))
+}
+
+func TestAllow_SweepsFullBuckets(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	limiter.allow(contextForUser("user1@google.com"), "", "ns1", common.ListMethodClass)
+	limiter.allow(contextForUser("user2@google.com"), "", "ns1", common.MutateMethodClass)
+	assert.Equal(t, 2, len(limiter.buckets))
+
+	clock.advance(sweepInterval)
+	limiter.allow(contextForUser("user3@google.com"), "", "ns1", common.ListMethodClass)
+	assert.Equal(t, 1, len(limiter.buckets))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	info := &grpc.UnaryServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRuns"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &apiv2beta1.ListRunsResponse{}, nil
+	}
+	ctx := contextForUser("user1@google.com")
+	request := &apiv2beta1.ListRunsRequest{Namespace: "ns1"}
+
+	for i := 0; i < 2; i++ {
+		_, err := limiter.UnaryServerInterceptor(ctx, request, info, handler)
+		assert.Nil(t, err)
+	}
+	_, err := limiter.UnaryServerInterceptor(ctx, request, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "Rate limit exceeded for List calls")
+
+	// Other namespaces have their own bucket.
+	_, err = limiter.UnaryServerInterceptor(ctx, &apiv2beta1.ListRunsRequest{Namespace: "ns2"}, info, handler)
+	assert.Nil(t, err)
+}
+
+func TestUnaryServerInterceptor_ExcludedMethods(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &empty.Empty{}, nil
+	}
+	ctx := contextForUser("user1@google.com")
+
+	// The excluded services and methods are never throttled.
+	for _, fullMethod := range []string{
+		"/api.ReportService/ReportWorkflowV1",
+		"/kubeflow.pipelines.backend.api.v2beta1.ReportService/ReportWorkflows",
+		"/kubeflow.pipelines.backend.api.v2beta1.RunService/DeleteRun",
+	} {
+		info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+		for i := 0; i < 5; i++ {
+			_, err := limiter.UnaryServerInterceptor(ctx, &empty.Empty{}, info, handler)
+			assert.Nil(t, err, fullMethod)
+		}
+	}
+
+	// The other calls of the class still are.
+	info := &grpc.UnaryServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.RunService/ArchiveRun"}
+	_, err := limiter.UnaryServerInterceptor(ctx, &empty.Empty{}, info, handler)
+	assert.Nil(t, err)
+	_, err = limiter.UnaryServerInterceptor(ctx, &empty.Empty{}, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// fakeServerStream is a server stream of the given context, recording its header.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	info := &grpc.StreamServerInfo{FullMethod: "/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRuns", IsServerStream: true}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+	stream := &fakeServerStream{ctx: contextForUser("user1@google.com")}
+
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, limiter.StreamServerInterceptor(nil, stream, info, handler))
+	}
+	err := limiter.StreamServerInterceptor(nil, stream, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"1"}, stream.header.Get(RetryAfterKey))
+
+	// Other users have their own bucket.
+	assert.Nil(t, limiter.StreamServerInterceptor(nil, &fakeServerStream{ctx: contextForUser("user2@google.com")}, info, handler))
+}
+
+func TestHTTPHandler(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	handler := limiter.HTTPHandler(common.MutateMethodClass, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/apis/v2beta1/pipelines/upload?namespace=ns1", nil)
+	request.Header.Set(userIDHeader, "user1@google.com")
+	response := httptest.NewRecorder()
+	handler(response, request)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	response = httptest.NewRecorder()
+	handler(response, request)
+	assert.Equal(t, http.StatusTooManyRequests, response.Code)
+	assert.Equal(t, "2", response.Header().Get("Retry-After"))
+	assert.Contains(t, response.Body.String(), "Rate limit exceeded for Mutate calls")
+}
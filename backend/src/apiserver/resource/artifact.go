@@ -0,0 +1,340 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// Artifact is a single file produced by a run, which can be streamed from the
+// object store holding it.
+type Artifact interface {
+	// Name is the file name suggested to clients downloading the artifact.
+	Name() string
+	Size() int64
+	ModTime() time.Time
+	ContentType() string
+	// NewRangeReader reads length bytes starting at offset. A negative length
+	// reads until the end of the artifact.
+	NewRangeReader(ctx context.Context, offset int64, length int64) (io.ReadCloser, error)
+	// SignedURL returns a URL which allows downloading the artifact directly
+	// from the object store until expiry elapses.
+	SignedURL(ctx context.Context, expiry time.Duration) (string, error)
+	// Close releases the object store connection, if any.
+	Close() error
+}
+
+// objectStoreArtifact is a v1 artifact stored by Argo in the default object store.
+type objectStoreArtifact struct {
+	objectStore storage.ObjectStoreInterface
+	key         string
+	info        *storage.ObjectInfo
+}
+
+func (a *objectStoreArtifact) Name() string        { return path.Base(a.key) }
+func (a *objectStoreArtifact) Size() int64         { return a.info.Size }
+func (a *objectStoreArtifact) ModTime() time.Time  { return a.info.ModTime }
+func (a *objectStoreArtifact) ContentType() string { return a.info.ContentType }
+func (a *objectStoreArtifact) Close() error        { return nil }
+
+func (a *objectStoreArtifact) NewRangeReader(ctx context.Context, offset int64, length int64) (io.ReadCloser, error) {
+	return a.objectStore.GetFileRange(a.key, offset, length)
+}
+
+func (a *objectStoreArtifact) SignedURL(ctx context.Context, expiry time.Duration) (string, error) {
+	return a.objectStore.GetSignedURL(a.key, expiry)
+}
+
+// blobArtifact is a v2 artifact stored in the pipeline root of a run.
+type blobArtifact struct {
+	bucket *blob.Bucket
+	key    string
+	attrs  *blob.Attributes
+}
+
+func (a *blobArtifact) Name() string        { return path.Base(a.key) }
+func (a *blobArtifact) Size() int64         { return a.attrs.Size }
+func (a *blobArtifact) ModTime() time.Time  { return a.attrs.ModTime }
+func (a *blobArtifact) ContentType() string { return a.attrs.ContentType }
+func (a *blobArtifact) Close() error        { return a.bucket.Close() }
+
+func (a *blobArtifact) NewRangeReader(ctx context.Context, offset int64, length int64) (io.ReadCloser, error) {
+	reader, err := a.bucket.NewRangeReader(ctx, a.key, offset, length, nil)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to read artifact %v", a.key)
+	}
+	return reader, nil
+}
+
+func (a *blobArtifact) SignedURL(ctx context.Context, expiry time.Duration) (string, error) {
+	signedURL, err := a.bucket.SignedURL(ctx, a.key, &blob.SignedURLOptions{Expiry: expiry})
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.Unimplemented {
+			return "", util.NewInvalidInputError("The object store of artifact %v does not support signed URLs", a.key)
+		}
+		return "", util.NewInternalServerError(err, "Failed to sign a URL for artifact %v", a.key)
+	}
+	return signedURL, nil
+}
+
+// OpenRunArtifact opens a v1 artifact, which is an output of an Argo workflow node
+// stored in the default object store.
+func (r *ResourceManager) OpenRunArtifact(runID string, nodeID string, artifactName string) (Artifact, error) {
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.WorkflowRuntimeManifest == "" {
+		return nil, util.NewInvalidInputError("Run %v has no Argo artifacts, use the task output or artifact ID of a v2 run instead", runID)
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.WorkflowRuntimeManifest))
+	if err != nil {
+		// This should never happen.
+		return nil, util.NewInternalServerError(
+			err, "failed to unmarshal workflow '%s'", run.WorkflowRuntimeManifest)
+	}
+	artifactPath := execSpec.ExecutionStatus().FindObjectStoreArtifactKeyOrEmpty(nodeID, artifactName)
+	if artifactPath == "" {
+		return nil, util.NewResourceNotFoundError(
+			"artifact", common.CreateArtifactPath(runID, nodeID, artifactName))
+	}
+	info, err := r.objectStore.StatFile(artifactPath)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open artifact %v", common.CreateArtifactPath(runID, nodeID, artifactName))
+	}
+	return &objectStoreArtifact{objectStore: r.objectStore, key: artifactPath, info: info}, nil
+}
+
+// OpenTaskOutputArtifact opens the v2 output artifact named outputName of a task in a run.
+func (r *ResourceManager) OpenTaskOutputArtifact(ctx context.Context, runID string, taskName string, outputName string) (Artifact, error) {
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		return nil, err
+	}
+	runContext, err := r.metadataClient.GetRunContext(ctx, runID)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open the output artifact %v of task %v in run %v", outputName, taskName, runID)
+	}
+	executions, err := r.metadataClient.GetExecutionsByContext(ctx, runContext.GetId())
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open the output artifact %v of task %v in run %v", outputName, taskName, runID)
+	}
+	var executionIDs []int64
+	for _, execution := range executions {
+		if execution.GetCustomProperties()["task_name"].GetStringValue() == taskName {
+			executionIDs = append(executionIDs, execution.GetId())
+		}
+	}
+	if len(executionIDs) == 0 {
+		return nil, util.NewResourceNotFoundError("task", fmt.Sprintf("runs/%s/tasks/%s", runID, taskName))
+	}
+	events, err := r.metadataClient.GetEventsByExecutionIDs(ctx, executionIDs)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open the output artifact %v of task %v in run %v", outputName, taskName, runID)
+	}
+	for _, event := range events {
+		if event.GetType() != pb.Event_OUTPUT || len(event.GetPath().GetSteps()) == 0 {
+			continue
+		}
+		if event.GetPath().GetSteps()[0].GetKey() == outputName {
+			return r.openMetadataArtifact(ctx, runContext, run, event.GetArtifactId())
+		}
+	}
+	return nil, util.NewResourceNotFoundError("artifact", fmt.Sprintf("runs/%s/tasks/%s/outputs/%s", runID, taskName, outputName))
+}
+
+// GetArtifactRunId returns the ID of the run which produced a v2 artifact, so
+// that callers can authorize access before opening it.
+func (r *ResourceManager) GetArtifactRunId(ctx context.Context, artifactID int64) (string, error) {
+	events, err := r.metadataClient.GetEventsByArtifactIDs(ctx, []int64{artifactID})
+	if err != nil {
+		return "", util.Wrapf(err, "Failed to find the run of artifact %v", artifactID)
+	}
+	for _, event := range events {
+		if event.GetType() != pb.Event_OUTPUT {
+			continue
+		}
+		contexts, err := r.metadataClient.GetContextsByExecution(ctx, event.GetExecutionId())
+		if err != nil {
+			return "", util.Wrapf(err, "Failed to find the run of artifact %v", artifactID)
+		}
+		for _, c := range contexts {
+			if c.GetType() == client.PipelineRunContextTypeName {
+				return c.GetName(), nil
+			}
+		}
+	}
+	return "", util.NewResourceNotFoundError("artifact", fmt.Sprint(artifactID))
+}
+
+// OpenArtifact opens a v2 artifact by its ML Metadata ID. runID must be the run
+// returned by GetArtifactRunId, its namespace holds the object store credentials.
+func (r *ResourceManager) OpenArtifact(ctx context.Context, runID string, artifactID int64) (Artifact, error) {
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		return nil, err
+	}
+	runContext, err := r.metadataClient.GetRunContext(ctx, runID)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open artifact %v", artifactID)
+	}
+	return r.openMetadataArtifact(ctx, runContext, run, artifactID)
+}
+
+// openMetadataArtifact opens a v2 artifact of a run. Only the artifacts stored
+// under the pipeline root of the run can be opened, as the object store is
+// accessed with the credentials of the run namespace.
+func (r *ResourceManager) openMetadataArtifact(ctx context.Context, runContext *pb.Context, run *model.Run, artifactID int64) (Artifact, error) {
+	artifacts, err := r.metadataClient.GetArtifactsByID(ctx, []int64{artifactID})
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open artifact %v", artifactID)
+	}
+	if len(artifacts) == 0 {
+		return nil, util.NewResourceNotFoundError("artifact", fmt.Sprint(artifactID))
+	}
+	uri := artifacts[0].GetUri()
+	if uri == "" {
+		return nil, util.NewInvalidInputError("Artifact %v has no URI", artifactID)
+	}
+	namespace := run.Namespace
+	if r.IsEmptyNamespace(namespace) {
+		namespace = common.GetPodNamespace()
+	}
+	artifactRoot, err := r.getRunArtifactRoot(ctx, runContext, run, namespace)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to open artifact %v", artifactID)
+	}
+	if !isUnderURI(uri, artifactRoot) {
+		return nil, util.NewPermissionDeniedError(
+			fmt.Errorf("artifact URI %v is not under %v", uri, artifactRoot),
+			"Artifact %v is not stored under the pipeline root of run %v", artifactID, run.UUID)
+	}
+	return r.openBlobArtifact(ctx, namespace, uri)
+}
+
+// getRunArtifactRoot returns the URI prefix under which the launcher stores the
+// artifacts of a run, "<pipeline root>/<pipeline name>/<run ID>/". It's read from
+// the ML Metadata context of the run, which the pods of the run can write, so it
+// must be under the pipeline root the run was created with, or else the default
+// pipeline root of the run namespace. run is nil when the run was deleted.
+func (r *ResourceManager) getRunArtifactRoot(ctx context.Context, runContext *pb.Context, run *model.Run, namespace string) (string, error) {
+	runId := runContext.GetName()
+	root := strings.TrimRight(runContext.GetCustomProperties()["pipeline_root"].GetStringValue(), "/")
+	if root == "" || path.Base(root) != runId || hasDotSegment(root) {
+		return "", util.NewInternalServerError(fmt.Errorf("invalid pipeline root %q", root), "Failed to get the pipeline root of run %v", runId)
+	}
+	pipelineRoot := ""
+	if run != nil {
+		pipelineRoot = run.PipelineSpec.RuntimeConfig.PipelineRoot
+	}
+	if pipelineRoot == "" {
+		if r.IsEmptyNamespace(namespace) {
+			namespace = common.GetPodNamespace()
+		}
+		launcherConfig, err := config.FromConfigMap(ctx, r.k8sCoreClient.GetClientSet(), namespace)
+		if err != nil {
+			return "", util.NewInternalServerError(err, "Failed to read the launcher config of namespace %v", namespace)
+		}
+		pipelineRoot = launcherConfig.DefaultPipelineRoot()
+	}
+	if !isUnderURI(root, pipelineRoot) {
+		return "", util.NewInternalServerError(
+			fmt.Errorf("pipeline root %q is not under %q", root, pipelineRoot), "Failed to get the pipeline root of run %v", runId)
+	}
+	return root + "/", nil
+}
+
+// isUnderURI returns whether uri is under the URI prefix. The local file system
+// resolves "." and ".." segments, so URIs with such segments are never under it.
+func isUnderURI(uri string, prefix string) bool {
+	prefix = strings.TrimRight(prefix, "/") + "/"
+	return prefix != "/" && strings.HasPrefix(uri, prefix) && !hasDotSegment(uri)
+}
+
+func hasDotSegment(uri string) bool {
+	for _, segment := range strings.Split(uri, "/") {
+		if segment == "." || segment == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+// openArtifactBucket opens the bucket holding an artifact in a pipeline root,
+// using the object store settings and credentials configured for the launcher
+// in the run namespace. It returns the bucket and the key of the artifact in it.
+func (r *ResourceManager) openArtifactBucket(ctx context.Context, namespace string, uri string) (*blob.Bucket, string, error) {
+	bucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(uri)
+	if err != nil {
+		return nil, "", util.NewInvalidInputError("Failed to parse artifact URI %v: %v", uri, err.Error())
+	}
+	key, err := bucketConfig.KeyFromURI(uri)
+	if err != nil {
+		return nil, "", util.NewInvalidInputError("Failed to parse artifact URI %v: %v", uri, err.Error())
+	}
+	clientSet := r.k8sCoreClient.GetClientSet()
+	launcherConfig, err := config.FromConfigMap(ctx, clientSet, namespace)
+	if err != nil {
+		return nil, "", util.NewInternalServerError(err, "Failed to read the launcher config of namespace %v", namespace)
+	}
+	providers, err := launcherConfig.BucketProviders()
+	if err != nil {
+		return nil, "", util.NewInternalServerError(err, "Failed to read the object store providers of namespace %v", namespace)
+	}
+	if bucketConfig.Settings, err = providers.SettingsFor(uri); err != nil {
+		return nil, "", util.NewInternalServerError(err, "Failed to get the object store settings of artifact %v", uri)
+	}
+	bucket, err := objectstore.OpenBucket(ctx, clientSet, namespace, bucketConfig)
+	if err != nil {
+		return nil, "", util.NewInternalServerError(err, "Failed to open the bucket of artifact %v", uri)
+	}
+	return bucket, key, nil
+}
+
+func (r *ResourceManager) openBlobArtifact(ctx context.Context, namespace string, uri string) (Artifact, error) {
+	bucket, key, err := r.openArtifactBucket(ctx, namespace, uri)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := bucket.Attributes(ctx, key)
+	if err == nil {
+		return &blobArtifact{bucket: bucket, key: key, attrs: attrs}, nil
+	}
+	defer bucket.Close()
+	if gcerrors.Code(err) != gcerrors.NotFound {
+		return nil, util.NewInternalServerError(err, "Failed to get the attributes of artifact %v", uri)
+	}
+	// Artifacts such as models are often directories, which can't be streamed as a single file.
+	if _, listErr := bucket.List(&blob.ListOptions{Prefix: key + "/"}).Next(ctx); listErr == nil {
+		return nil, util.NewInvalidInputError("Artifact %v is a directory, only single file artifacts can be downloaded", uri)
+	}
+	return nil, util.NewResourceNotFoundError("artifact", uri)
+}
@@ -0,0 +1,455 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gocloud.dev/blob"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// Count the artifacts removed by artifact garbage collection.
+	artifactGCDeletedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_artifact_gc_deleted_artifacts",
+		Help: "The number of artifacts deleted by artifact garbage collection",
+	})
+
+	// Count the archived logs removed by artifact garbage collection.
+	artifactGCDeletedLogsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_artifact_gc_deleted_logs",
+		Help: "The number of archived logs deleted by artifact garbage collection",
+	})
+
+	// Count the artifacts which could not be garbage collected.
+	artifactGCErrorCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_artifact_gc_errors",
+		Help: "The number of errors during artifact garbage collection",
+	})
+)
+
+// ArtifactGCOptions configures artifact garbage collection.
+type ArtifactGCOptions struct {
+	// DryRun only reports the artifacts which would be deleted.
+	DryRun bool
+	// DefaultRetention is how long artifacts are kept after their run finished.
+	// Zero keeps the artifacts until the run is deleted.
+	DefaultRetention time.Duration
+	// NamespaceRetention overrides DefaultRetention for runs in a namespace.
+	NamespaceRetention map[string]time.Duration
+}
+
+func (o *ArtifactGCOptions) retention(namespace string) time.Duration {
+	if retention, ok := o.NamespaceRetention[namespace]; ok {
+		return retention
+	}
+	return o.DefaultRetention
+}
+
+// ArtifactGCEntry is an artifact considered by artifact garbage collection.
+type ArtifactGCEntry struct {
+	RunId      string
+	Namespace  string
+	ArtifactId int64
+	URI        string
+	// Reason explains why the artifact is deleted or kept.
+	Reason string
+}
+
+// ArtifactGCReport lists what a garbage collection pass deleted, or would delete in dry-run mode.
+type ArtifactGCReport struct {
+	DryRun      bool
+	Deleted     []ArtifactGCEntry
+	Kept        []ArtifactGCEntry
+	DeletedLogs []string
+	Errors      []error
+}
+
+func (r *ArtifactGCReport) addError(err error) {
+	artifactGCErrorCounter.Inc()
+	r.Errors = append(r.Errors, err)
+}
+
+// Log writes a summary of the report, and every deleted artifact in dry-run mode.
+func (r *ArtifactGCReport) Log() {
+	verb := "Deleted"
+	if r.DryRun {
+		verb = "Dry run, would delete"
+		for _, entry := range r.Deleted {
+			glog.Infof("Artifact GC dry run: would delete artifact %v (%v) of run %v: %v", entry.ArtifactId, entry.URI, entry.RunId, entry.Reason)
+		}
+		for _, log := range r.DeletedLogs {
+			glog.Infof("Artifact GC dry run: would delete archived log %v", log)
+		}
+	}
+	glog.Infof("Artifact GC: %s %d artifacts and %d archived logs, kept %d referenced artifacts, %d errors",
+		verb, len(r.Deleted), len(r.DeletedLogs), len(r.Kept), len(r.Errors))
+	for _, err := range r.Errors {
+		glog.Warningf("Artifact GC error: %v", err)
+	}
+}
+
+// collectedRuns remembers the expired runs whose archived logs were deleted, so
+// that the next passes don't stat their logs again.
+type collectedRuns struct {
+	mu   sync.Mutex
+	runs map[string]bool
+}
+
+func (c *collectedRuns) contains(runId string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.runs[runId]
+}
+
+func (c *collectedRuns) add(runId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.runs == nil {
+		c.runs = make(map[string]bool)
+	}
+	c.runs[runId] = true
+}
+
+// retain forgets the runs which are no longer garbage collection candidates,
+// e.g. because they were deleted.
+func (c *collectedRuns) retain(runIds map[string]bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for runId := range c.runs {
+		if !runIds[runId] {
+			delete(c.runs, runId)
+		}
+	}
+}
+
+// gcRun is a v2 run whose artifacts are eligible for garbage collection.
+type gcRun struct {
+	runId     string
+	namespace string
+	context   *pb.Context
+	// run is nil when the run was deleted.
+	run    *model.Run
+	reason string
+}
+
+// CollectArtifactGarbage deletes the artifacts of deleted runs, and of runs which
+// finished longer ago than the retention of their namespace. Runs and their
+// artifacts are found through ML Metadata lineage. Artifacts which are still used
+// by a live run, or which are outputs of a cache entry, are kept.
+func (r *ResourceManager) CollectArtifactGarbage(ctx context.Context, opts *ArtifactGCOptions) (*ArtifactGCReport, error) {
+	report := &ArtifactGCReport{DryRun: opts.DryRun}
+	runContexts, err := r.metadataClient.GetContextsByType(ctx, client.PipelineRunContextTypeName)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list the runs for artifact garbage collection")
+	}
+
+	now := r.time.Now()
+	liveContextIds := make(map[int64]string)
+	var candidates []*gcRun
+	for _, runContext := range runContexts {
+		runId := runContext.GetName()
+		namespace := runContext.GetCustomProperties()["namespace"].GetStringValue()
+		run, err := r.runStore.GetRun(runId)
+		if err != nil {
+			if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				// Treat the run as live, deleting artifacts of a run in an unknown state is unsafe.
+				report.addError(util.Wrapf(err, "Failed to get run %v", runId))
+				liveContextIds[runContext.GetId()] = runId
+				continue
+			}
+			candidates = append(candidates, &gcRun{runId: runId, namespace: namespace, context: runContext, reason: "run was deleted"})
+			continue
+		}
+		if run.Namespace != "" {
+			namespace = run.Namespace
+		}
+		retention := opts.retention(namespace)
+		if retention > 0 && run.FinishedAtInSec > 0 && now.Sub(time.Unix(run.FinishedAtInSec, 0)) > retention {
+			candidates = append(candidates, &gcRun{
+				runId:     runId,
+				namespace: namespace,
+				context:   runContext,
+				run:       run,
+				reason:    fmt.Sprintf("run finished more than %v ago", retention),
+			})
+			continue
+		}
+		liveContextIds[runContext.GetId()] = runId
+	}
+
+	expiredRunIds := make(map[string]bool)
+	for _, candidate := range candidates {
+		r.collectRunArtifacts(ctx, candidate, liveContextIds, opts, report)
+		if candidate.run != nil {
+			expiredRunIds[candidate.runId] = true
+			if !r.collectedLogRuns.contains(candidate.runId) {
+				r.collectRunArchivedLogs(candidate.run, opts, report)
+			}
+		}
+	}
+	r.collectedLogRuns.retain(expiredRunIds)
+	return report, nil
+}
+
+func (r *ResourceManager) collectRunArtifacts(ctx context.Context, candidate *gcRun, liveContextIds map[int64]string, opts *ArtifactGCOptions, report *ArtifactGCReport) {
+	allArtifacts, err := r.metadataClient.GetArtifactsByContext(ctx, candidate.context.GetId())
+	if err != nil {
+		report.addError(util.Wrapf(err, "Failed to list the artifacts of run %v", candidate.runId))
+		return
+	}
+	artifacts := make(map[int64]*pb.Artifact)
+	var artifactIds []int64
+	for _, artifact := range allArtifacts {
+		if artifact.GetState() == pb.Artifact_DELETED || artifact.GetUri() == "" {
+			continue
+		}
+		artifacts[artifact.GetId()] = artifact
+		artifactIds = append(artifactIds, artifact.GetId())
+	}
+	if len(artifactIds) == 0 {
+		return
+	}
+
+	// Only the files under the pipeline root of the run are deleted, the URIs in
+	// ML Metadata are written by the pods of the run and could point anywhere.
+	artifactRoot, err := r.getRunArtifactRoot(ctx, candidate.context, candidate.run, candidate.namespace)
+	if err != nil {
+		report.addError(err)
+		return
+	}
+	keepReasons, err := r.getArtifactReferences(ctx, artifactIds, liveContextIds)
+	if err != nil {
+		report.addError(util.Wrapf(err, "Failed to find the references to the artifacts of run %v", candidate.runId))
+		return
+	}
+
+	var deleted []*pb.Artifact
+	for _, id := range artifactIds {
+		artifact := artifacts[id]
+		entry := ArtifactGCEntry{
+			RunId:      candidate.runId,
+			Namespace:  candidate.namespace,
+			ArtifactId: id,
+			URI:        artifact.GetUri(),
+			Reason:     candidate.reason,
+		}
+		if reason, ok := keepReasons[id]; ok {
+			entry.Reason = reason
+			report.Kept = append(report.Kept, entry)
+			continue
+		}
+		if !isUnderURI(artifact.GetUri(), artifactRoot) {
+			glog.Warningf("Artifact GC: skipping artifact %v (%v) of run %v, it's not under the pipeline root %v of the run",
+				id, artifact.GetUri(), candidate.runId, artifactRoot)
+			entry.Reason = fmt.Sprintf("not under the pipeline root %v of the run", artifactRoot)
+			report.Kept = append(report.Kept, entry)
+			continue
+		}
+		if !opts.DryRun {
+			if err := r.deleteArtifactFiles(ctx, candidate.namespace, artifact.GetUri()); err != nil {
+				report.addError(util.Wrapf(err, "Failed to delete artifact %v of run %v", id, candidate.runId))
+				continue
+			}
+			artifactGCDeletedCounter.Inc()
+			deleted = append(deleted, artifact)
+		}
+		report.Deleted = append(report.Deleted, entry)
+	}
+	// Mark the artifacts as deleted, so they are skipped by the next passes.
+	if err := r.metadataClient.UpdateArtifactsState(ctx, deleted, pb.Artifact_DELETED); err != nil {
+		report.addError(util.Wrapf(err, "Failed to mark the artifacts of run %v as deleted", candidate.runId))
+	}
+}
+
+// getArtifactReferences returns, for each artifact which must be kept, why it's still in use.
+func (r *ResourceManager) getArtifactReferences(ctx context.Context, artifactIds []int64, liveContextIds map[int64]string) (map[int64]string, error) {
+	events, err := r.metadataClient.GetEventsByArtifactIDs(ctx, artifactIds)
+	if err != nil {
+		return nil, err
+	}
+	keepReasons := make(map[int64]string)
+	executionRuns := make(map[int64]string)
+	producers := make(map[string][]int64)
+	for _, event := range events {
+		artifactId, executionId := event.GetArtifactId(), event.GetExecutionId()
+		if event.GetType() == pb.Event_OUTPUT {
+			key := strconv.FormatInt(executionId, 10)
+			producers[key] = append(producers[key], artifactId)
+		}
+		liveRunId, ok := executionRuns[executionId]
+		if !ok {
+			contexts, err := r.metadataClient.GetContextsByExecution(ctx, executionId)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range contexts {
+				if runId, live := liveContextIds[c.GetId()]; live {
+					liveRunId = runId
+					break
+				}
+			}
+			executionRuns[executionId] = liveRunId
+		}
+		if liveRunId != "" {
+			keepReasons[artifactId] = fmt.Sprintf("used by run %v", liveRunId)
+		}
+	}
+
+	// Cache hits reuse the outputs of the cached execution, so they must outlive its run.
+	var producerIds []string
+	for id := range producers {
+		producerIds = append(producerIds, id)
+	}
+	cacheEntries, err := r.taskStore.ListCacheEntries(producerIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range cacheEntries {
+		for _, artifactId := range producers[task.MLMDExecutionID] {
+			if _, ok := keepReasons[artifactId]; !ok {
+				keepReasons[artifactId] = fmt.Sprintf("output of cache entry %v", task.UUID)
+			}
+		}
+	}
+	return keepReasons, nil
+}
+
+// deleteArtifactFiles deletes an artifact from its object store. Artifacts which
+// are directories are deleted recursively.
+func (r *ResourceManager) deleteArtifactFiles(ctx context.Context, namespace string, uri string) error {
+	if r.IsEmptyNamespace(namespace) {
+		namespace = common.GetPodNamespace()
+	}
+	bucket, key, err := r.openArtifactBucket(ctx, namespace, uri)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+	keys := []string{key}
+	iter := bucket.List(&blob.ListOptions{Prefix: key + "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to list the files of artifact %v", uri)
+		}
+		keys = append(keys, obj.Key)
+	}
+	for _, k := range keys {
+		exists, err := bucket.Exists(ctx, k)
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to delete artifact file %v", k)
+		}
+		if !exists {
+			continue
+		}
+		if err := bucket.Delete(ctx, k); err != nil {
+			return util.NewInternalServerError(err, "Failed to delete artifact file %v", k)
+		}
+	}
+	return nil
+}
+
+// collectRunArchivedLogs deletes the logs archived by Argo for the nodes of a run.
+// Once all of them are deleted, the run is skipped by the next passes.
+func (r *ResourceManager) collectRunArchivedLogs(run *model.Run, opts *ArtifactGCOptions, report *ArtifactGCReport) {
+	logKeys, err := r.getArchivedLogKeys(run)
+	if err != nil {
+		report.addError(err)
+		return
+	}
+	collected := true
+	for _, key := range logKeys {
+		if _, err := r.objectStore.StatFile(key); err != nil {
+			if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				report.addError(util.Wrapf(err, "Failed to delete archived log %v of run %v", key, run.UUID))
+				collected = false
+			}
+			continue
+		}
+		if !opts.DryRun {
+			if err := r.objectStore.DeleteFile(key); err != nil {
+				report.addError(util.Wrapf(err, "Failed to delete archived log %v of run %v", key, run.UUID))
+				collected = false
+				continue
+			}
+			artifactGCDeletedLogsCounter.Inc()
+		}
+		report.DeletedLogs = append(report.DeletedLogs, key)
+	}
+	if collected && !opts.DryRun {
+		r.collectedLogRuns.add(run.UUID)
+	}
+}
+
+func (r *ResourceManager) getArchivedLogKeys(run *model.Run) ([]string, error) {
+	if run.WorkflowRuntimeManifest == "" {
+		return nil, nil
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.WorkflowRuntimeManifest))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to read the archived logs of run %v", run.UUID)
+	}
+	var keys []string
+	for nodeId := range execSpec.ExecutionStatus().NodeStatuses() {
+		key, err := r.logArchive.GetLogObjectKey(execSpec, nodeId)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to read the archived logs of run %v", run.UUID)
+		}
+		// The logs archived outside of the object store are not collected.
+		if key == "" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// RunArtifactGC collects artifact garbage every interval until ctx is done.
+func (r *ResourceManager) RunArtifactGC(ctx context.Context, interval time.Duration, opts *ArtifactGCOptions) {
+	glog.Infof("Starting artifact garbage collection every %v, dry run: %v", interval, opts.DryRun)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		report, err := r.CollectArtifactGarbage(ctx, opts)
+		if err != nil {
+			glog.Errorf("Artifact garbage collection failed: %v", err)
+		} else {
+			report.Log()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// gcNow is the time of the garbage collection passes in the tests.
+var gcNow = time.Unix(100*24*3600, 0)
+
+// initWithArtifactGC returns a pipeline root on the local file system, which is
+// the default pipeline root of the namespaces used by the tests.
+func initWithArtifactGC(t *testing.T) (*FakeClientManager, *ResourceManager, string) {
+	initEnvVars()
+	store, err := NewFakeClientManager(util.NewFakeTime(gcNow), util.NewUUIDGenerator())
+	require.Nil(t, err)
+	root := t.TempDir()
+	// ns1 is also the namespace of the API server.
+	for _, namespace := range []string{"ns1", "ns2"} {
+		_, err := store.KubernetesCoreClient().GetClientSet().CoreV1().ConfigMaps(namespace).Create(context.Background(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kfp-launcher"},
+			Data:       map[string]string{"defaultPipelineRoot": "file://" + filepath.ToSlash(root)},
+		}, metav1.CreateOptions{})
+		require.Nil(t, err)
+	}
+	return store, NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false}), root
+}
+
+// createGCRun stores a run which finished some time before gcNow.
+func createGCRun(t *testing.T, store *FakeClientManager, runId string, namespace string, finishedAgo time.Duration) {
+	_, err := store.RunStore().CreateRun(&model.Run{
+		UUID:         runId,
+		K8SName:      runId,
+		DisplayName:  runId,
+		Namespace:    namespace,
+		StorageState: model.StorageStateAvailable,
+		RunDetails: model.RunDetails{
+			CreatedAtInSec:  1,
+			FinishedAtInSec: gcNow.Add(-finishedAgo).Unix(),
+			State:           model.RuntimeStateSucceeded,
+		},
+	})
+	require.Nil(t, err)
+}
+
+// addGCArtifact records the output artifact of a task, and writes it under root.
+func addGCArtifact(t *testing.T, store *FakeClientManager, root string, runContext *pb.Context, taskName string) (*pb.Execution, *pb.Artifact, string) {
+	path := filepath.Join(root, "pipeline", runContext.GetName(), taskName, "model")
+	require.Nil(t, os.MkdirAll(path, 0755))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(path, "weights"), []byte("weights"), 0644))
+	execution := store.MetadataClientFake.AddExecution(taskName, runContext.GetId())
+	artifact := store.MetadataClientFake.AddOutputArtifact(execution.GetId(), "model", "file://"+filepath.ToSlash(path))
+	return execution, artifact, path
+}
+
+func assertArtifactGCEntries(t *testing.T, entries []ArtifactGCEntry, artifacts ...*pb.Artifact) {
+	var ids []int64
+	for _, entry := range entries {
+		ids = append(ids, entry.ArtifactId)
+	}
+	var expected []int64
+	for _, artifact := range artifacts {
+		expected = append(expected, artifact.GetId())
+	}
+	assert.ElementsMatch(t, expected, ids)
+}
+
+func TestCollectArtifactGarbage(t *testing.T) {
+	store, manager, root := initWithArtifactGC(t)
+	metadata := store.MetadataClientFake
+	pipelineRoot := "file://" + filepath.ToSlash(root)
+
+	// Deleted run, its outputs are garbage.
+	deletedContext := metadata.AddRunContextInNamespace("deleted-run", "ns1", pipelineRoot)
+	_, deletedArtifact, deletedPath := addGCArtifact(t, store, root, deletedContext, "trainer")
+
+	// Deleted run, whose output is used by a live run.
+	sharedContext := metadata.AddRunContextInNamespace("shared-run", "ns1", pipelineRoot)
+	_, sharedArtifact, sharedPath := addGCArtifact(t, store, root, sharedContext, "trainer")
+	createGCRun(t, store, "live-run", "ns1", time.Hour)
+	liveContext := metadata.AddRunContextInNamespace("live-run", "ns1", pipelineRoot)
+	liveExecution, liveArtifact, livePath := addGCArtifact(t, store, root, liveContext, "evaluator")
+	metadata.AddInputEvent(liveExecution.GetId(), sharedArtifact.GetId(), "model")
+
+	// Deleted run, whose output is reused by the cache.
+	cachedContext := metadata.AddRunContextInNamespace("cached-run", "ns1", pipelineRoot)
+	cachedExecution, cachedArtifact, cachedPath := addGCArtifact(t, store, root, cachedContext, "trainer")
+	_, err := store.TaskStore().CreateTask(&model.Task{
+		Namespace:       "ns1",
+		RunId:           "cached-run",
+		Fingerprint:     "fingerprint",
+		MLMDExecutionID: strconv.FormatInt(cachedExecution.GetId(), 10),
+	})
+	require.Nil(t, err)
+
+	// Runs which finished before and after the retention period of their namespace.
+	createGCRun(t, store, "expired-run", "ns2", 48*time.Hour)
+	expiredContext := metadata.AddRunContextInNamespace("expired-run", "ns2", pipelineRoot)
+	_, expiredArtifact, expiredPath := addGCArtifact(t, store, root, expiredContext, "trainer")
+	createGCRun(t, store, "retained-run", "ns1", 48*time.Hour)
+	retainedContext := metadata.AddRunContextInNamespace("retained-run", "ns1", pipelineRoot)
+	_, retainedArtifact, retainedPath := addGCArtifact(t, store, root, retainedContext, "trainer")
+
+	opts := &ArtifactGCOptions{
+		DefaultRetention:   7 * 24 * time.Hour,
+		NamespaceRetention: map[string]time.Duration{"ns2": 24 * time.Hour},
+	}
+	report, err := manager.CollectArtifactGarbage(context.Background(), opts)
+	require.Nil(t, err)
+	assert.Empty(t, report.Errors)
+	assertArtifactGCEntries(t, report.Deleted, deletedArtifact, expiredArtifact)
+	assertArtifactGCEntries(t, report.Kept, sharedArtifact, cachedArtifact)
+
+	for _, path := range []string{deletedPath, expiredPath} {
+		_, err := os.Stat(filepath.Join(path, "weights"))
+		assert.True(t, os.IsNotExist(err), path)
+	}
+	for _, path := range []string{sharedPath, cachedPath, livePath, retainedPath} {
+		_, err := os.Stat(filepath.Join(path, "weights"))
+		assert.Nil(t, err, path)
+	}
+	artifacts, err := metadata.GetArtifactsByID(context.Background(), []int64{deletedArtifact.GetId(), liveArtifact.GetId(), retainedArtifact.GetId()})
+	require.Nil(t, err)
+	assert.Equal(t, pb.Artifact_DELETED, artifacts[0].GetState())
+	assert.NotEqual(t, pb.Artifact_DELETED, artifacts[1].GetState())
+	assert.NotEqual(t, pb.Artifact_DELETED, artifacts[2].GetState())
+
+	// Deleted artifacts are skipped by the next pass.
+	report, err = manager.CollectArtifactGarbage(context.Background(), opts)
+	require.Nil(t, err)
+	assert.Empty(t, report.Deleted)
+}
+
+func TestCollectArtifactGarbage_DryRun(t *testing.T) {
+	store, manager, root := initWithArtifactGC(t)
+	runContext := store.MetadataClientFake.AddRunContext("deleted-run", "file://"+filepath.ToSlash(root))
+	_, artifact, path := addGCArtifact(t, store, root, runContext, "trainer")
+
+	report, err := manager.CollectArtifactGarbage(context.Background(), &ArtifactGCOptions{DryRun: true})
+	require.Nil(t, err)
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Deleted, 1)
+	assert.Equal(t, ArtifactGCEntry{
+		RunId:      "deleted-run",
+		ArtifactId: artifact.GetId(),
+		URI:        artifact.GetUri(),
+		Reason:     "run was deleted",
+	}, report.Deleted[0])
+
+	_, err = os.Stat(filepath.Join(path, "weights"))
+	assert.Nil(t, err)
+	artifacts, err := store.MetadataClientFake.GetArtifactsByID(context.Background(), []int64{artifact.GetId()})
+	require.Nil(t, err)
+	assert.NotEqual(t, pb.Artifact_DELETED, artifacts[0].GetState())
+}
+
+func TestCollectArtifactGarbage_OutsidePipelineRoot(t *testing.T) {
+	store, manager, root := initWithArtifactGC(t)
+	metadata := store.MetadataClientFake
+
+	// The artifact URIs and pipeline roots in ML Metadata are written by the pods of the run.
+	runContext := metadata.AddRunContext("deleted-run", "file://"+filepath.ToSlash(root))
+	execution, artifact, path := addGCArtifact(t, store, root, runContext, "trainer")
+	otherPath := filepath.Join(root, "pipeline", "other-run", "trainer", "model")
+	require.Nil(t, os.MkdirAll(otherPath, 0755))
+	otherArtifact := metadata.AddOutputArtifact(execution.GetId(), "other", "file://"+filepath.ToSlash(otherPath))
+	escapingArtifact := metadata.AddOutputArtifact(execution.GetId(), "escaping", "file://"+filepath.ToSlash(path)+"/../../../other-run")
+
+	otherRoot := t.TempDir()
+	forgedContext := metadata.AddRunContext("forged-run", "file://"+filepath.ToSlash(otherRoot))
+	_, _, forgedPath := addGCArtifact(t, store, otherRoot, forgedContext, "trainer")
+
+	report, err := manager.CollectArtifactGarbage(context.Background(), &ArtifactGCOptions{})
+	require.Nil(t, err)
+	require.Len(t, report.Errors, 1)
+	assert.Contains(t, report.Errors[0].Error(), "forged-run")
+	assertArtifactGCEntries(t, report.Deleted, artifact)
+	assertArtifactGCEntries(t, report.Kept, otherArtifact, escapingArtifact)
+
+	_, err = os.Stat(filepath.Join(path, "weights"))
+	assert.True(t, os.IsNotExist(err))
+	for _, path := range []string{otherPath, forgedPath} {
+		_, err := os.Stat(path)
+		assert.Nil(t, err, path)
+	}
+}
+
+// statCountingObjectStore counts the files stat'ed in the object store.
+type statCountingObjectStore struct {
+	storage.ObjectStoreInterface
+	stats int
+}
+
+func (s *statCountingObjectStore) StatFile(filePath string) (*storage.ObjectInfo, error) {
+	s.stats++
+	return s.ObjectStoreInterface.StatFile(filePath)
+}
+
+func TestCollectArtifactGarbage_ArchivedLogs(t *testing.T) {
+	store, manager, root := initWithArtifactGC(t)
+	objectStore := &statCountingObjectStore{ObjectStoreInterface: store.ObjectStore()}
+	manager.objectStore = objectStore
+	_, err := store.RunStore().CreateRun(&model.Run{
+		UUID:         "expired-run",
+		K8SName:      "expired-run",
+		DisplayName:  "expired-run",
+		StorageState: model.StorageStateAvailable,
+		RunDetails: model.RunDetails{
+			CreatedAtInSec:          1,
+			FinishedAtInSec:         gcNow.Add(-48 * time.Hour).Unix(),
+			State:                   model.RuntimeStateSucceeded,
+			WorkflowRuntimeManifest: `{"metadata":{"name":"workflow-name"},"status":{"nodes":{"node-1":{"id":"node-1"}}}}`,
+		},
+	})
+	require.Nil(t, err)
+	store.MetadataClientFake.AddRunContext("expired-run", "file://"+filepath.ToSlash(root))
+	require.Nil(t, store.ObjectStore().AddFile([]byte("log"), "/logs/workflow-name/node-1/main.log"))
+
+	opts := &ArtifactGCOptions{DefaultRetention: 24 * time.Hour}
+	report, err := manager.CollectArtifactGarbage(context.Background(), opts)
+	require.Nil(t, err)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, []string{"/logs/workflow-name/node-1/main.log"}, report.DeletedLogs)
+	_, err = store.ObjectStore().StatFile("/logs/workflow-name/node-1/main.log")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+	assert.Equal(t, 1, objectStore.stats)
+
+	// The archived logs of the run were collected, they're not stat'ed again.
+	report, err = manager.CollectArtifactGarbage(context.Background(), opts)
+	require.Nil(t, err)
+	assert.Empty(t, report.DeletedLogs)
+	assert.Equal(t, 1, objectStore.stats)
+}
@@ -33,16 +33,23 @@ type FakeClientManager struct {
 	resourceReferenceStore        storage.ResourceReferenceStoreInterface
 	dBStatusStore                 storage.DBStatusStoreInterface
 	defaultExperimentStore        storage.DefaultExperimentStoreInterface
+	auditEventStore               storage.AuditEventStoreInterface
+	runEventStore                 storage.RunEventStoreInterface
+	notificationStore             storage.NotificationStoreInterface
 	objectStore                   storage.ObjectStoreInterface
 	ExecClientFake                *client.FakeExecClient
 	swfClientFake                 *client.FakeSwfClient
 	k8sCoreClientFake             *client.FakeKuberneteCoreClient
+	MetadataClientFake            *client.FakeMetadataClient
 	SubjectAccessReviewClientFake client.SubjectAccessReviewInterface
 	tokenReviewClientFake         client.TokenReviewInterface
 	logArchive                    archive.LogArchiveInterface
 	time                          util.TimeInterface
 	uuid                          util.UUIDGeneratorInterface
 	AuthenticatorsFake            []auth.Authenticator
+	// AuthorizerFake is the authorizer, or nil to authorize requests with
+	// SubjectAccessReviewClientFake.
+	AuthorizerFake auth.Authorizer
 }
 
 func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterface) (
@@ -62,6 +69,7 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		return nil, err
 	}
 
+	objectStore := storage.NewFakeObjectStore()
 	// TODO(neuromage): Pass in metadata.Store instance for tests as well.
 	return &FakeClientManager{
 		db:                            db,
@@ -74,12 +82,16 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		resourceReferenceStore:        storage.NewResourceReferenceStore(db),
 		dBStatusStore:                 storage.NewDBStatusStore(db),
 		defaultExperimentStore:        storage.NewDefaultExperimentStore(db),
-		objectStore:                   storage.NewFakeObjectStore(),
+		auditEventStore:               storage.NewAuditEventStore(db, uuid),
+		runEventStore:                 storage.NewRunEventStore(db),
+		notificationStore:             storage.NewNotificationStore(db, time, uuid),
+		objectStore:                   objectStore,
 		swfClientFake:                 client.NewFakeSwfClient(),
 		k8sCoreClientFake:             client.NewFakeKuberneteCoresClient(),
+		MetadataClientFake:            client.NewFakeMetadataClient(),
 		SubjectAccessReviewClientFake: client.NewFakeSubjectAccessReviewClient(),
 		tokenReviewClientFake:         client.NewFakeTokenReviewClient(),
-		logArchive:                    archive.NewLogArchive("/logs", "main.log"),
+		logArchive:                    archive.NewLogArchive(objectStore, "/logs", "main.log"),
 		time:                          time,
 		uuid:                          uuid,
 		AuthenticatorsFake:            auth.GetAuthenticators(client.NewFakeTokenReviewClient()),
@@ -165,6 +177,18 @@ func (f *FakeClientManager) DefaultExperimentStore() storage.DefaultExperimentSt
 	return f.defaultExperimentStore
 }
 
+func (f *FakeClientManager) AuditEventStore() storage.AuditEventStoreInterface {
+	return f.auditEventStore
+}
+
+func (f *FakeClientManager) RunEventStore() storage.RunEventStoreInterface {
+	return f.runEventStore
+}
+
+func (f *FakeClientManager) NotificationStore() storage.NotificationStoreInterface {
+	return f.notificationStore
+}
+
 func (f *FakeClientManager) SwfClient() client.SwfClientInterface {
 	return f.swfClientFake
 }
@@ -173,6 +197,10 @@ func (f *FakeClientManager) KubernetesCoreClient() client.KubernetesCoreInterfac
 	return f.k8sCoreClientFake
 }
 
+func (f *FakeClientManager) MetadataClient() client.MetadataClientInterface {
+	return f.MetadataClientFake
+}
+
 func (f *FakeClientManager) SubjectAccessReviewClient() client.SubjectAccessReviewInterface {
 	return f.SubjectAccessReviewClientFake
 }
@@ -185,6 +213,13 @@ func (f *FakeClientManager) Authenticators() []auth.Authenticator {
 	return f.AuthenticatorsFake
 }
 
+func (f *FakeClientManager) Authorizer() auth.Authorizer {
+	if f.AuthorizerFake != nil {
+		return f.AuthorizerFake
+	}
+	return auth.NewSubjectAccessReviewAuthorizer(f.SubjectAccessReviewClientFake)
+}
+
 func (f *FakeClientManager) Close() error {
 	return f.db.Close()
 }
@@ -194,4 +229,6 @@ func (f *FakeClientManager) UpdateUUID(uuid util.UUIDGeneratorInterface) {
 	f.uuid = uuid
 	f.experimentStore = storage.NewExperimentStore(f.db, f.time, uuid)
 	f.pipelineStore = storage.NewPipelineStore(f.db, f.time, uuid)
+	f.auditEventStore = storage.NewAuditEventStore(f.db, uuid)
+	f.notificationStore = storage.NewNotificationStore(f.db, f.time, uuid)
 }
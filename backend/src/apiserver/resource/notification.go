@@ -0,0 +1,148 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// Creates a notification subscription. The namespace of an experiment
+// subscription is the namespace of the experiment.
+func (r *ResourceManager) CreateNotificationSubscription(subscription *model.NotificationSubscription) (*model.NotificationSubscription, error) {
+	if subscription.DisplayName == "" {
+		return nil, util.NewInvalidInputError("Failed to create a notification subscription: display name cannot be empty")
+	}
+	if err := r.notifications.ValidateTargetURL(subscription.TargetURL); err != nil {
+		return nil, util.Wrap(err, "Failed to create a notification subscription")
+	}
+	if err := notification.ValidatePayloadTemplate(subscription.PayloadTemplate); err != nil {
+		return nil, util.Wrap(err, "Failed to create a notification subscription")
+	}
+	for _, state := range subscription.GetStates() {
+		if !state.IsValid() || state == model.RuntimeStateUnspecified {
+			return nil, util.NewInvalidInputError("Failed to create a notification subscription: invalid state %v", state)
+		}
+	}
+	if subscription.ExperimentId != "" {
+		namespace, err := r.GetNamespaceFromExperimentId(subscription.ExperimentId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to create a notification subscription for experiment %v", subscription.ExperimentId)
+		}
+		if subscription.Namespace != "" && subscription.Namespace != namespace {
+			return nil, util.NewInvalidInputError("Failed to create a notification subscription: experiment %v is not in namespace %v", subscription.ExperimentId, subscription.Namespace)
+		}
+		subscription.Namespace = namespace
+	}
+	newSubscription, err := r.notificationStore.CreateNotificationSubscription(subscription)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create a notification subscription")
+	}
+	return newSubscription, nil
+}
+
+// Fetches a notification subscription with a given id.
+func (r *ResourceManager) GetNotificationSubscription(subscriptionId string) (*model.NotificationSubscription, error) {
+	subscription, err := r.notificationStore.GetNotificationSubscription(subscriptionId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to fetch notification subscription %v", subscriptionId)
+	}
+	return subscription, nil
+}
+
+// Fetches the notification subscriptions of an experiment, if experimentId is
+// not empty, else of a namespace.
+func (r *ResourceManager) ListNotificationSubscriptions(namespace string, experimentId string, opts *list.Options) ([]*model.NotificationSubscription, int, string, error) {
+	subscriptions, totalSize, nextPageToken, err := r.notificationStore.ListNotificationSubscriptions(namespace, experimentId, opts)
+	if err != nil {
+		return nil, 0, "", util.Wrap(err, "Failed to list notification subscriptions")
+	}
+	return subscriptions, totalSize, nextPageToken, nil
+}
+
+// Deletes a notification subscription and its deliveries.
+func (r *ResourceManager) DeleteNotificationSubscription(subscriptionId string) error {
+	if _, err := r.GetNotificationSubscription(subscriptionId); err != nil {
+		return util.Wrapf(err, "Failed to delete notification subscription %v", subscriptionId)
+	}
+	if err := r.notificationStore.DeleteNotificationSubscription(subscriptionId); err != nil {
+		return util.Wrapf(err, "Failed to delete notification subscription %v", subscriptionId)
+	}
+	return nil
+}
+
+// Fetches the deliveries of the notifications of a subscription.
+func (r *ResourceManager) ListNotificationDeliveries(subscriptionId string, opts *list.Options) ([]*model.NotificationDelivery, int, string, error) {
+	deliveries, totalSize, nextPageToken, err := r.notificationStore.ListNotificationDeliveries(subscriptionId, opts)
+	if err != nil {
+		return nil, 0, "", util.Wrapf(err, "Failed to list the deliveries of notification subscription %v", subscriptionId)
+	}
+	return deliveries, totalSize, nextPageToken, nil
+}
+
+// RunNotificationDispatcher posts the notifications of the state changes of
+// runs until the context is done.
+func (r *ResourceManager) RunNotificationDispatcher(ctx context.Context) {
+	r.notifications.Run(ctx)
+}
+
+// notifyRunStateChange notifies the subscriptions to the new state of a run.
+// Failing to notify does not fail the state change, so errors are only logged.
+func (r *ResourceManager) notifyRunStateChange(run *model.Run, previousState model.RuntimeState) {
+	namespace, err := r.getRunNamespace(run)
+	if err != nil {
+		glog.Warningf("Failed to notify the state change of run %v: %v", run.UUID, err)
+		return
+	}
+	subscriptions, err := r.notificationStore.ListRunNotificationSubscriptions(namespace, run.ExperimentId)
+	if err != nil {
+		glog.Warningf("Failed to notify the state change of run %v: %v", run.UUID, err)
+		return
+	}
+	var event *notification.Event
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(run.State) {
+			continue
+		}
+		if event == nil {
+			event = &notification.Event{
+				RunId:         run.UUID,
+				RunName:       run.DisplayName,
+				ExperimentId:  run.ExperimentId,
+				Namespace:     namespace,
+				State:         run.State.ToString(),
+				PreviousState: previousState.ToString(),
+				Time:          r.time.Now(),
+			}
+		}
+		if err := r.notifications.Notify(subscription, event); err != nil {
+			glog.Warningf("Failed to notify the state change of run %v: %v", run.UUID, err)
+		}
+	}
+}
+
+// getRunNamespace returns the namespace of a run, which is the namespace of its
+// experiment if the run does not record it.
+func (r *ResourceManager) getRunNamespace(run *model.Run) (string, error) {
+	if run.Namespace != "" || run.ExperimentId == "" {
+		return run.Namespace, nil
+	}
+	return r.GetNamespaceFromExperimentId(run.ExperimentId)
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reportWorkflowPhase reports the workflow of a run in the given phase.
+func reportWorkflowPhase(t *testing.T, manager *ResourceManager, runId string, phase v1alpha1.WorkflowPhase) {
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "workflow-name",
+			Namespace: "ns1",
+			UID:       "workflow1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: runId},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: phase},
+	})
+	_, err := manager.ReportWorkflowResource(context.Background(), workflow)
+	require.Nil(t, err)
+}
+
+func listNotificationDeliveries(t *testing.T, manager *ResourceManager, subscriptionId string) []*model.NotificationDelivery {
+	opts, err := list.NewOptions(&model.NotificationDelivery{}, 10, "", nil)
+	require.Nil(t, err)
+	deliveries, _, _, err := manager.ListNotificationDeliveries(subscriptionId, opts)
+	require.Nil(t, err)
+	return deliveries
+}
+
+func TestCreateNotificationSubscription(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	subscription, err := manager.CreateNotificationSubscription(&model.NotificationSubscription{
+		DisplayName:     "failures",
+		ExperimentId:    experiment.UUID,
+		States:          "FAILED,CANCELED",
+		TargetURL:       "https://hooks.example.com/run",
+		PayloadTemplate: `{"text": {{json .RunName}}}`,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, subscription.UUID)
+	// The namespace of the subscription is the namespace of the experiment.
+	assert.Equal(t, "ns1", subscription.Namespace)
+
+	fetched, err := manager.GetNotificationSubscription(subscription.UUID)
+	require.Nil(t, err)
+	assert.Equal(t, subscription, fetched)
+}
+
+func TestCreateNotificationSubscription_Invalid(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	tests := []struct {
+		name         string
+		subscription *model.NotificationSubscription
+		errorMessage string
+		code         codes.Code
+	}{
+		{
+			"no display name",
+			&model.NotificationSubscription{Namespace: "ns1", TargetURL: "https://hooks.example.com/run"},
+			"display name cannot be empty",
+			codes.InvalidArgument,
+		},
+		{
+			"invalid target URL",
+			&model.NotificationSubscription{DisplayName: "failures", Namespace: "ns1", TargetURL: "hooks.example.com"},
+			"Invalid target URL",
+			codes.InvalidArgument,
+		},
+		{
+			"invalid payload template",
+			&model.NotificationSubscription{DisplayName: "failures", Namespace: "ns1", TargetURL: "https://hooks.example.com/run", PayloadTemplate: "{{.RunId"},
+			"Invalid payload template",
+			codes.InvalidArgument,
+		},
+		{
+			"invalid state",
+			&model.NotificationSubscription{DisplayName: "failures", Namespace: "ns1", TargetURL: "https://hooks.example.com/run", States: "FAILED,DONE"},
+			"invalid state DONE",
+			codes.InvalidArgument,
+		},
+		{
+			"experiment in another namespace",
+			&model.NotificationSubscription{DisplayName: "failures", Namespace: "ns2", ExperimentId: experiment.UUID, TargetURL: "https://hooks.example.com/run"},
+			"is not in namespace ns2",
+			codes.InvalidArgument,
+		},
+		{
+			"experiment not found",
+			&model.NotificationSubscription{DisplayName: "failures", ExperimentId: "unknown", TargetURL: "https://hooks.example.com/run"},
+			"unknown",
+			codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := manager.CreateNotificationSubscription(tt.subscription)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errorMessage)
+			assert.Equal(t, tt.code, err.(*util.UserError).ExternalStatusCode())
+		})
+	}
+}
+
+func TestReportWorkflowResource_NotifiesSubscriptions(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	// Subscriptions and deliveries need distinct IDs.
+	store.UpdateUUID(util.NewUUIDGenerator())
+	manager = NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	create := func(subscription *model.NotificationSubscription) *model.NotificationSubscription {
+		subscription.DisplayName = "subscription"
+		subscription.TargetURL = "https://hooks.example.com/run"
+		subscription, err := manager.CreateNotificationSubscription(subscription)
+		require.Nil(t, err)
+		return subscription
+	}
+	failures := create(&model.NotificationSubscription{ExperimentId: run.ExperimentId, States: "FAILED"})
+	all := create(&model.NotificationSubscription{Namespace: "ns1"})
+	successes := create(&model.NotificationSubscription{Namespace: "ns1", States: "SUCCEEDED"})
+	otherNamespace := create(&model.NotificationSubscription{Namespace: "ns2"})
+
+	reportWorkflowPhase(t, manager, run.UUID, v1alpha1.WorkflowFailed)
+	// The state of the run is unchanged, so it is not notified again.
+	reportWorkflowPhase(t, manager, run.UUID, v1alpha1.WorkflowFailed)
+
+	for _, subscription := range []*model.NotificationSubscription{failures, all} {
+		deliveries := listNotificationDeliveries(t, manager, subscription.UUID)
+		require.Len(t, deliveries, 1, subscription.DisplayName)
+		assert.Equal(t, run.UUID, deliveries[0].RunId)
+		assert.Equal(t, model.RuntimeStateFailed, deliveries[0].State)
+		assert.Equal(t, model.NotificationDeliveryPending, deliveries[0].Status)
+		assert.Contains(t, deliveries[0].Payload, `"state":"FAILED"`)
+	}
+	assert.Empty(t, listNotificationDeliveries(t, manager, successes.UUID))
+	assert.Empty(t, listNotificationDeliveries(t, manager, otherNamespace.UUID))
+}
@@ -20,20 +20,27 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"reflect"
+	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	exec "github.com/kubeflow/pipelines/backend/src/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	swfutil "github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -45,6 +52,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
 )
 
 // Metric variables. Please prefix the metric names with resource_manager_.
@@ -85,65 +93,84 @@ type ClientManagerInterface interface {
 	ResourceReferenceStore() storage.ResourceReferenceStoreInterface
 	DBStatusStore() storage.DBStatusStoreInterface
 	DefaultExperimentStore() storage.DefaultExperimentStoreInterface
+	AuditEventStore() storage.AuditEventStoreInterface
+	RunEventStore() storage.RunEventStoreInterface
+	NotificationStore() storage.NotificationStoreInterface
 	ObjectStore() storage.ObjectStoreInterface
 	ExecClient() util.ExecutionClient
 	SwfClient() client.SwfClientInterface
 	KubernetesCoreClient() client.KubernetesCoreInterface
+	MetadataClient() client.MetadataClientInterface
 	SubjectAccessReviewClient() client.SubjectAccessReviewInterface
 	TokenReviewClient() client.TokenReviewInterface
 	LogArchive() archive.LogArchiveInterface
 	Time() util.TimeInterface
 	UUID() util.UUIDGeneratorInterface
 	Authenticators() []kfpauth.Authenticator
+	Authorizer() kfpauth.Authorizer
 }
 
+// The maximum number of scheduled times a backfill of a recurring run can cover.
+const maxBackfillRuns = 1000
+
 type ResourceManagerOptions struct {
 	CollectMetrics bool `json:"collect_metrics,omitempty"`
 }
 
 type ResourceManager struct {
-	experimentStore           storage.ExperimentStoreInterface
-	pipelineStore             storage.PipelineStoreInterface
-	jobStore                  storage.JobStoreInterface
-	runStore                  storage.RunStoreInterface
-	taskStore                 storage.TaskStoreInterface
-	resourceReferenceStore    storage.ResourceReferenceStoreInterface
-	dBStatusStore             storage.DBStatusStoreInterface
-	defaultExperimentStore    storage.DefaultExperimentStoreInterface
-	objectStore               storage.ObjectStoreInterface
-	execClient                util.ExecutionClient
-	swfClient                 client.SwfClientInterface
-	k8sCoreClient             client.KubernetesCoreInterface
-	subjectAccessReviewClient client.SubjectAccessReviewInterface
-	tokenReviewClient         client.TokenReviewInterface
-	logArchive                archive.LogArchiveInterface
-	time                      util.TimeInterface
-	uuid                      util.UUIDGeneratorInterface
-	authenticators            []kfpauth.Authenticator
-	options                   *ResourceManagerOptions
+	experimentStore        storage.ExperimentStoreInterface
+	pipelineStore          storage.PipelineStoreInterface
+	jobStore               storage.JobStoreInterface
+	runStore               storage.RunStoreInterface
+	taskStore              storage.TaskStoreInterface
+	resourceReferenceStore storage.ResourceReferenceStoreInterface
+	dBStatusStore          storage.DBStatusStoreInterface
+	defaultExperimentStore storage.DefaultExperimentStoreInterface
+	auditEventStore        storage.AuditEventStoreInterface
+	notificationStore      storage.NotificationStoreInterface
+	objectStore            storage.ObjectStoreInterface
+	execClient             util.ExecutionClient
+	swfClient              client.SwfClientInterface
+	k8sCoreClient          client.KubernetesCoreInterface
+	metadataClient         client.MetadataClientInterface
+	tokenReviewClient      client.TokenReviewInterface
+	logArchive             archive.LogArchiveInterface
+	runEvents              *runEventHub
+	collectedLogRuns       collectedRuns
+	notifications          *notification.Dispatcher
+	time                   util.TimeInterface
+	uuid                   util.UUIDGeneratorInterface
+	authenticators         []kfpauth.Authenticator
+	authorizer             kfpauth.Authorizer
+	options                *ResourceManagerOptions
 }
 
 func NewResourceManager(clientManager ClientManagerInterface, options *ResourceManagerOptions) *ResourceManager {
 	return &ResourceManager{
-		experimentStore:           clientManager.ExperimentStore(),
-		pipelineStore:             clientManager.PipelineStore(),
-		jobStore:                  clientManager.JobStore(),
-		runStore:                  clientManager.RunStore(),
-		taskStore:                 clientManager.TaskStore(),
-		resourceReferenceStore:    clientManager.ResourceReferenceStore(),
-		dBStatusStore:             clientManager.DBStatusStore(),
-		defaultExperimentStore:    clientManager.DefaultExperimentStore(),
-		objectStore:               clientManager.ObjectStore(),
-		execClient:                clientManager.ExecClient(),
-		swfClient:                 clientManager.SwfClient(),
-		k8sCoreClient:             clientManager.KubernetesCoreClient(),
-		subjectAccessReviewClient: clientManager.SubjectAccessReviewClient(),
-		tokenReviewClient:         clientManager.TokenReviewClient(),
-		logArchive:                clientManager.LogArchive(),
-		time:                      clientManager.Time(),
-		uuid:                      clientManager.UUID(),
-		authenticators:            clientManager.Authenticators(),
-		options:                   options,
+		experimentStore:        clientManager.ExperimentStore(),
+		pipelineStore:          clientManager.PipelineStore(),
+		jobStore:               clientManager.JobStore(),
+		runStore:               clientManager.RunStore(),
+		taskStore:              clientManager.TaskStore(),
+		resourceReferenceStore: clientManager.ResourceReferenceStore(),
+		dBStatusStore:          clientManager.DBStatusStore(),
+		defaultExperimentStore: clientManager.DefaultExperimentStore(),
+		auditEventStore:        clientManager.AuditEventStore(),
+		notificationStore:      clientManager.NotificationStore(),
+		objectStore:            clientManager.ObjectStore(),
+		execClient:             clientManager.ExecClient(),
+		swfClient:              clientManager.SwfClient(),
+		k8sCoreClient:          clientManager.KubernetesCoreClient(),
+		metadataClient:         clientManager.MetadataClient(),
+		tokenReviewClient:      clientManager.TokenReviewClient(),
+		logArchive:             clientManager.LogArchive(),
+		runEvents:              newRunEventHubFromConfig(clientManager.RunEventStore()),
+		notifications:          notification.NewDispatcherFromConfig(clientManager.NotificationStore(), clientManager.Time()),
+		time:                   clientManager.Time(),
+		uuid:                   clientManager.UUID(),
+		authenticators:         clientManager.Authenticators(),
+		authorizer:             clientManager.Authorizer(),
+		options:                options,
 	}
 }
 
@@ -550,6 +577,7 @@ func (r *ResourceManager) CreateRun(ctx context.Context, run *model.Run) (*model
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create a run")
 	}
+	r.publishRunEvents(newRunEvent(model.RunEventTypeRunCreated, newRun))
 	return newRun, nil
 }
 
@@ -635,10 +663,20 @@ func (r *ResourceManager) DeleteRun(ctx context.Context, runId string) error {
 		// once persistent agent sync the state to DB and set TTL for it.
 		glog.Warningf("Failed to delete run %v. Error: %v", run.K8SName, err.Error())
 	}
+	if common.IsArtifactGCEnabled() && !common.IsArtifactGCDryRun() {
+		// The archived logs can only be found from the run, so they are deleted now.
+		// Artifacts are found through ML Metadata and deleted by the next garbage collection.
+		report := &ArtifactGCReport{}
+		r.collectRunArchivedLogs(run, &ArtifactGCOptions{}, report)
+		for _, err := range report.Errors {
+			glog.Warningf("Failed to delete the archived logs of run %v. Error: %v", runId, err)
+		}
+	}
 	err = r.runStore.DeleteRun(runId)
 	if err != nil {
 		return util.Wrapf(err, "Failed to delete a run %v", runId)
 	}
+	r.publishRunEvents(newRunEvent(model.RunEventTypeRunDeleted, run))
 
 	if r.options.CollectMetrics {
 		if run.Conditions == string(exec.ExecutionSucceeded) {
@@ -745,6 +783,8 @@ func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error
 	if err != nil {
 		return util.Wrapf(err, "Failed to terminate run %s", runId)
 	}
+	run.State = model.RuntimeStateCancelling
+	r.publishRunEvents(newRunEvent(model.RunEventTypeRunStateChanged, run))
 
 	if namespace == "" {
 		namespace = common.GetPodNamespace()
@@ -839,7 +879,7 @@ func (r *ResourceManager) ReadLog(ctx context.Context, runId string, nodeId stri
 	}
 	err = r.readRunLogFromPod(ctx, namespace, nodeId, follow, dst)
 	if err != nil && r.logArchive != nil {
-		err = r.readRunLogFromArchive(run.WorkflowRuntimeManifest, nodeId, dst)
+		err = r.readRunLogFromArchive(ctx, run.WorkflowRuntimeManifest, nodeId, dst)
 		if err != nil {
 			return util.NewBadRequestError(err, "Failed to read logs for run %v", runId)
 		}
@@ -876,7 +916,7 @@ func (r *ResourceManager) readRunLogFromPod(ctx context.Context, namespace strin
 }
 
 // Fetches execution logs from a archived pod logs.
-func (r *ResourceManager) readRunLogFromArchive(workflowManifest string, nodeId string, dst io.Writer) error {
+func (r *ResourceManager) readRunLogFromArchive(ctx context.Context, workflowManifest string, nodeId string, dst io.Writer) error {
 	if workflowManifest == "" {
 		return util.NewInternalServerError(util.NewInvalidInputError("Runtime workflow manifest cannot empty"), "Failed to read logs from archive %v due to empty runtime workflow manifest", nodeId)
 	}
@@ -886,12 +926,7 @@ func (r *ResourceManager) readRunLogFromArchive(workflowManifest string, nodeId
 		return util.NewInternalServerError(err, "Failed to read logs from archive %v due error reading execution spec", nodeId)
 	}
 
-	logPath, err := r.logArchive.GetLogObjectKey(execSpec, nodeId)
-	if err != nil {
-		return util.NewInternalServerError(err, "Failed to read logs from archive %v", nodeId)
-	}
-
-	logContent, err := r.objectStore.GetFile(logPath)
+	logContent, err := r.logArchive.ReadLog(ctx, execSpec, nodeId)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to read logs from archive %v due to error fetching the log file", nodeId)
 	}
@@ -1049,6 +1084,71 @@ func (r *ResourceManager) ChangeJobMode(ctx context.Context, jobId string, enabl
 	return nil
 }
 
+// Updates a recurring run and its scheduled workflow. The job is expected to be an existing
+// recurring run with the updated fields set. The runs already created by the recurring run are kept,
+// and its trigger status is reset if the trigger changed.
+func (r *ResourceManager) UpdateJob(ctx context.Context, job *model.Job) (*model.Job, error) {
+	k8sNamespace := job.Namespace
+	if k8sNamespace == "" {
+		k8sNamespace = common.GetPodNamespace()
+	}
+	scheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Get(ctx, job.K8SName, v1.GetOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to update recurring run %v. Check if the scheduled workflow exists", job.UUID)
+	}
+	if scheduledWorkflow == nil || string(scheduledWorkflow.UID) != job.UUID {
+		return nil, util.Wrapf(util.NewResourceNotFoundError("recurring run", job.K8SName), "Failed to update recurring run %v. Check if its k8s resource exists", job.UUID)
+	}
+
+	tmpl, manifest, err := r.fetchTemplateFromPipelineSpec(&job.PipelineSpec)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v with an invalid pipeline spec manifest", job.UUID)
+	}
+	newScheduledWorkflow, err := tmpl.ScheduledWorkflow(job)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v during scheduled workflow creation", job.UUID)
+	}
+
+	// Keep the metadata and the state of the existing scheduled workflow.
+	updatedScheduledWorkflow := scheduledWorkflow.DeepCopy()
+	updatedScheduledWorkflow.Spec = newScheduledWorkflow.Spec
+	updatedScheduledWorkflow.Spec.Enabled = scheduledWorkflow.Spec.Enabled
+	updatedScheduledWorkflow.Spec.MaxHistory = scheduledWorkflow.Spec.MaxHistory
+	if !reflect.DeepEqual(scheduledWorkflow.Spec.Trigger, updatedScheduledWorkflow.Spec.Trigger) {
+		swfutil.NewScheduledWorkflow(updatedScheduledWorkflow).ResetTriggerStatus(r.time.Now().Unix())
+	}
+	updatedScheduledWorkflow, err = r.getScheduledWorkflowClient(k8sNamespace).Update(ctx, updatedScheduledWorkflow)
+	if err != nil {
+		if err, ok := err.(net.Error); ok && err.Timeout() {
+			return nil, util.NewUnavailableServerError(err, "Failed to update recurring run %v - try again later", job.UUID)
+		}
+		return nil, util.NewInternalServerError(err, "Failed to update the scheduled workflow of recurring run %v", job.UUID)
+	}
+	swf := util.NewScheduledWorkflow(updatedScheduledWorkflow)
+	if err := r.jobStore.UpdateJob(swf); err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", job.UUID)
+	}
+
+	// Store the service account and the pipeline spec in the same way as CreateJob.
+	serviceAccount := ""
+	if swf.Spec.Workflow != nil {
+		execSpec, err := util.ScheduleSpecToExecutionSpec(util.ArgoWorkflow, swf.Spec.Workflow)
+		if err == nil {
+			serviceAccount = execSpec.ServiceAccount()
+		}
+	}
+	job.ServiceAccount = serviceAccount
+	if tmpl.GetTemplateType() == template.V1 {
+		job.PipelineSpec.WorkflowSpecManifest = manifest
+	} else {
+		job.PipelineSpec.PipelineSpecManifest = manifest
+	}
+	if err := r.jobStore.UpdateJobPipelineSpec(job); err != nil {
+		return nil, util.Wrapf(err, "Failed to update the pipeline spec of recurring run %v", job.UUID)
+	}
+	return r.GetJob(job.UUID)
+}
+
 // Deletes a recurring run with given id.
 func (r *ResourceManager) DeleteJob(ctx context.Context, jobId string) error {
 	job, err := r.GetJob(jobId)
@@ -1078,6 +1178,317 @@ func (r *ResourceManager) DeleteJob(ctx context.Context, jobId string) error {
 	return nil
 }
 
+// Creates the runs that a recurring run with a cron or periodic schedule would have created
+// between startTime and endTime, inclusive. At most parallelism workflows are created concurrently.
+// Returns the new runs ordered by scheduled time, and the scheduled times skipped because they
+// already had a run.
+func (r *ResourceManager) BackfillJob(ctx context.Context, jobId string, startTime int64, endTime int64, parallelism int) ([]*model.Run, []int64, error) {
+	job, err := r.GetJob(jobId)
+	if err != nil {
+		return nil, nil, util.Wrapf(err, "Failed to backfill recurring run %v. Check if it exists", jobId)
+	}
+	k8sNamespace := job.Namespace
+	if k8sNamespace == "" {
+		k8sNamespace = common.GetPodNamespace()
+	}
+	scheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Get(ctx, job.K8SName, v1.GetOptions{})
+	if err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to backfill recurring run %v. Check if the scheduled workflow exists", jobId)
+	}
+	if scheduledWorkflow == nil || string(scheduledWorkflow.UID) != jobId {
+		return nil, nil, util.Wrapf(util.NewResourceNotFoundError("recurring run", job.K8SName), "Failed to backfill recurring run %v. Check if its k8s resource exists", jobId)
+	}
+	swf := swfutil.NewScheduledWorkflow(scheduledWorkflow)
+	location, err := swfutil.GetLocation()
+	if err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to backfill recurring run %v due to an invalid default time zone", jobId)
+	}
+	scheduledTimes, err := swf.BackfillEpochs(startTime, endTime, location, maxBackfillRuns+1)
+	if err != nil {
+		return nil, nil, util.NewInvalidInputError("Failed to backfill recurring run %v: %v", jobId, err.Error())
+	}
+	if len(scheduledTimes) > maxBackfillRuns {
+		return nil, nil, util.NewInvalidInputError("Failed to backfill recurring run %v: the time window covers more than %v scheduled times", jobId, maxBackfillRuns)
+	}
+
+	// Skip the scheduled times which already have a run.
+	existingTimes, err := r.runStore.ListRunScheduledTimes(jobId, startTime, endTime)
+	if err != nil {
+		return nil, nil, util.Wrapf(err, "Failed to backfill recurring run %v", jobId)
+	}
+	existing := make(map[int64]bool, len(existingTimes))
+	for _, scheduledTime := range existingTimes {
+		existing[scheduledTime] = true
+	}
+	var pendingTimes, skippedTimes []int64
+	for _, scheduledTime := range scheduledTimes {
+		if existing[scheduledTime] {
+			skippedTimes = append(skippedTimes, scheduledTime)
+		} else {
+			pendingTimes = append(pendingTimes, scheduledTime)
+		}
+	}
+	if job.UseLatestPipelineVersion {
+		workflow, _, err := r.MaterializeJobWorkflow(jobId)
+		if err != nil {
+			return nil, nil, util.Wrapf(err, "Failed to backfill recurring run %v", jobId)
+		}
+		swf.Spec.Workflow = workflow
+	}
+	firstIndex, err := r.reserveBackfillIndexes(ctx, k8sNamespace, job, len(pendingTimes))
+	if err != nil {
+		return nil, nil, util.Wrapf(err, "Failed to backfill recurring run %v", jobId)
+	}
+	workflows, err := swf.NewBackfillWorkflows(pendingTimes, firstIndex, r.time.Now().Unix(), location)
+	if err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to backfill recurring run %v", jobId)
+	}
+
+	// Create the workflows concurrently, and store the run of each workflow as soon as it
+	// is created, so that a failed backfill does not leave workflows without runs.
+	type createdWorkflow struct {
+		index    int
+		execSpec util.ExecutionSpec
+		err      error
+	}
+	createdWorkflows := make(chan createdWorkflow)
+	go func() {
+		semaphore := make(chan struct{}, parallelism)
+		var wg sync.WaitGroup
+		for i := range workflows {
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(i int) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				execSpec, err := r.getWorkflowClient(k8sNamespace).Create(ctx, workflows[i], v1.CreateOptions{})
+				createdWorkflows <- createdWorkflow{index: i, execSpec: execSpec, err: err}
+			}(i)
+		}
+		wg.Wait()
+		close(createdWorkflows)
+	}()
+
+	backfilledRuns := make([]*model.Run, len(workflows))
+	var errs []error
+	for created := range createdWorkflows {
+		scheduledTime := pendingTimes[created.index]
+		if created.err != nil {
+			if apierrors.IsAlreadyExists(created.err) {
+				// The scheduled time was backfilled before, but its run has been deleted since.
+				skippedTimes = append(skippedTimes, scheduledTime)
+			} else {
+				errs = append(errs, util.NewInternalServerError(created.err, "Failed to create a workflow scheduled at %v", scheduledTime))
+			}
+			continue
+		}
+		run, err := r.storeBackfillRun(job, created.execSpec, scheduledTime)
+		if err != nil {
+			errs = append(errs, util.Wrapf(err, "Failed to store the run of workflow %v", created.execSpec.ExecutionName()))
+			continue
+		}
+		backfilledRuns[created.index] = run
+	}
+	runs := make([]*model.Run, 0, len(workflows))
+	for _, run := range backfilledRuns {
+		if run != nil {
+			runs = append(runs, run)
+		}
+	}
+	sort.Slice(skippedTimes, func(i, j int) bool { return skippedTimes[i] < skippedTimes[j] })
+	if len(errs) > 0 {
+		return runs, skippedTimes, util.Wrapf(utilerrors.NewAggregate(errs), "Failed to backfill %v of %v scheduled times of recurring run %v", len(errs), len(scheduledTimes), jobId)
+	}
+	return runs, skippedTimes, nil
+}
+
+// Reserves count workflow indexes for a backfill of a recurring run in the status of its
+// scheduled workflow, and returns the first of them. The update fails on a conflict with
+// the controller or another backfill, in which case the indexes are reserved again from
+// the latest status.
+func (r *ResourceManager) reserveBackfillIndexes(ctx context.Context, k8sNamespace string, job *model.Job, count int) (int64, error) {
+	if count == 0 {
+		return 0, nil
+	}
+	var firstIndex int64
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		scheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Get(ctx, job.K8SName, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		updated := scheduledWorkflow.DeepCopy()
+		firstIndex = swfutil.NewScheduledWorkflow(updated).ReserveBackfillIndexes(count)
+		_, err = r.getScheduledWorkflowClient(k8sNamespace).Update(ctx, updated)
+		return err
+	})
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to reserve %v workflow indexes in the scheduled workflow of recurring run %v", count, job.UUID)
+	}
+	return firstIndex, nil
+}
+
+// Stores the run of a workflow created by a backfill of a recurring run. The persistence
+// agent may have stored the run of the workflow already, which is then returned.
+func (r *ResourceManager) storeBackfillRun(job *model.Job, execSpec util.ExecutionSpec, scheduledTime int64) (*model.Run, error) {
+	run, err := r.newBackfillRun(job, execSpec, scheduledTime)
+	if err != nil {
+		return nil, err
+	}
+	newRun, err := r.runStore.CreateRun(run)
+	if err != nil {
+		if existingRun, getErr := r.runStore.GetRun(run.UUID); getErr == nil {
+			return existingRun, nil
+		}
+		return nil, err
+	}
+	r.publishRunEvents(newRunEvent(model.RunEventTypeRunCreated, newRun))
+	return newRun, nil
+}
+
+// Builds the run of a workflow created by a backfill of a recurring run.
+func (r *ResourceManager) newBackfillRun(job *model.Job, execSpec util.ExecutionSpec, scheduledTime int64) (*model.Run, error) {
+	pipelineSpec := job.PipelineSpec
+	pipelineSpec.WorkflowSpecManifest = execSpec.GetExecutionSpec().ToStringForStore()
+	if job.UseLatestPipelineVersion {
+		if err := r.setRunPipelineVersion(&pipelineSpec, execSpec); err != nil {
+			return nil, err
+		}
+	}
+	state := model.RuntimeStatePending
+	return &model.Run{
+		UUID:           execSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowRunId],
+		ExperimentId:   job.ExperimentId,
+		RecurringRunId: job.UUID,
+		DisplayName:    execSpec.ExecutionName(),
+		K8SName:        execSpec.ExecutionName(),
+		StorageState:   model.StorageStateAvailable,
+		Namespace:      job.Namespace,
+		ServiceAccount: execSpec.ServiceAccount(),
+		PipelineSpec:   pipelineSpec,
+		RunDetails: model.RunDetails{
+			WorkflowRuntimeManifest: execSpec.ToStringForStore(),
+			CreatedAtInSec:          r.time.Now().Unix(),
+			ScheduledAtInSec:        scheduledTime,
+			Conditions:              string(state.ToV1()),
+			State:                   state,
+		},
+	}, nil
+}
+
+// Builds the workflow of the next run of a recurring run that uses the latest version of its
+// pipeline. The workflow is labeled with the id of the pipeline version, which is also returned.
+func (r *ResourceManager) MaterializeJobWorkflow(jobId string) (*swfapi.WorkflowResource, string, error) {
+	job, err := r.GetJob(jobId)
+	if err != nil {
+		return nil, "", util.Wrapf(err, "Failed to materialize the workflow of recurring run %v. Check if it exists", jobId)
+	}
+	if !job.UseLatestPipelineVersion {
+		return nil, "", util.NewInvalidInputError("Failed to materialize the workflow of recurring run %v as it does not use the latest version of its pipeline", jobId)
+	}
+	latestJob := *job
+	latestJob.PipelineSpec.PipelineVersionId = ""
+	latestJob.PipelineSpec.PipelineSpecManifest = ""
+	latestJob.PipelineSpec.WorkflowSpecManifest = ""
+	tmpl, _, err := r.fetchTemplateFromPipelineSpec(&latestJob.PipelineSpec)
+	if err != nil {
+		return nil, "", util.Wrapf(err, "Failed to materialize the workflow of recurring run %v from the latest version of pipeline %v", jobId, job.PipelineSpec.PipelineId)
+	}
+	scheduledWorkflow, err := tmpl.ScheduledWorkflow(&latestJob)
+	if err != nil {
+		return nil, "", util.Wrapf(err, "Failed to materialize the workflow of recurring run %v", jobId)
+	}
+	execSpec, err := util.ScheduleSpecToExecutionSpec(util.ArgoWorkflow, scheduledWorkflow.Spec.Workflow)
+	if err != nil {
+		return nil, "", util.Wrapf(err, "Failed to materialize the workflow of recurring run %v", jobId)
+	}
+	execSpec.SetLabels(util.LabelKeyWorkflowPipelineVersionId, latestJob.PipelineSpec.PipelineVersionId)
+	scheduledWorkflow.Spec.Workflow.Spec = execSpec.ToStringForSchedule()
+	return scheduledWorkflow.Spec.Workflow, latestJob.PipelineSpec.PipelineVersionId, nil
+}
+
+// Points the pipeline spec of a run of a recurring run that uses the latest version of its pipeline
+// to the pipeline version the workflow of the run was materialized from. Workflows without the
+// pipeline version label keep the pipeline spec of the recurring run.
+func (r *ResourceManager) setRunPipelineVersion(pipelineSpec *model.PipelineSpec, execSpec util.ExecutionSpec) error {
+	pipelineVersionId := execSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowPipelineVersionId]
+	if pipelineVersionId == "" || pipelineVersionId == pipelineSpec.PipelineVersionId {
+		return nil
+	}
+	versionSpec := model.PipelineSpec{
+		PipelineId:        pipelineSpec.PipelineId,
+		PipelineVersionId: pipelineVersionId,
+	}
+	tmpl, manifest, err := r.fetchTemplateFromPipelineSpec(&versionSpec)
+	if err != nil {
+		return util.Wrapf(err, "Failed to fetch pipeline version %v of workflow %v", pipelineVersionId, execSpec.ExecutionName())
+	}
+	pipelineSpec.PipelineVersionId = versionSpec.PipelineVersionId
+	pipelineSpec.PipelineName = versionSpec.PipelineName
+	if tmpl.GetTemplateType() == template.V2 {
+		pipelineSpec.PipelineSpecManifest = manifest
+	}
+	return nil
+}
+
+// Returns up to count upcoming trigger times of a recurring run with a cron or periodic schedule.
+func (r *ResourceManager) PreviewJobTriggers(ctx context.Context, jobId string, count int) ([]int64, error) {
+	job, err := r.GetJob(jobId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to preview the triggers of recurring run %v. Check if it exists", jobId)
+	}
+	k8sNamespace := job.Namespace
+	if k8sNamespace == "" {
+		k8sNamespace = common.GetPodNamespace()
+	}
+	scheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Get(ctx, job.K8SName, v1.GetOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to preview the triggers of recurring run %v. Check if the scheduled workflow exists", jobId)
+	}
+	if scheduledWorkflow == nil || string(scheduledWorkflow.UID) != jobId {
+		return nil, util.Wrapf(util.NewResourceNotFoundError("recurring run", job.K8SName), "Failed to preview the triggers of recurring run %v. Check if its k8s resource exists", jobId)
+	}
+	triggerTimes, err := r.previewScheduledWorkflow(scheduledWorkflow, count)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to preview the triggers of recurring run %v", jobId)
+	}
+	return triggerTimes, nil
+}
+
+// Returns up to count trigger times of a trigger, as if a recurring run was created with it now.
+func (r *ResourceManager) PreviewTrigger(trigger model.Trigger, noCatchup bool, count int) ([]int64, error) {
+	crdTrigger, err := template.ModelToCRDTrigger(trigger)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to preview a trigger")
+	}
+	scheduledWorkflow := &swfapi.ScheduledWorkflow{
+		ObjectMeta: v1.ObjectMeta{CreationTimestamp: v1.NewTime(r.time.Now())},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:   true,
+			NoCatchup: util.BoolPointer(noCatchup),
+			Trigger:   crdTrigger,
+		},
+	}
+	triggerTimes, err := r.previewScheduledWorkflow(scheduledWorkflow, count)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to preview a trigger")
+	}
+	return triggerTimes, nil
+}
+
+func (r *ResourceManager) previewScheduledWorkflow(scheduledWorkflow *swfapi.ScheduledWorkflow, count int) ([]int64, error) {
+	location, err := swfutil.GetLocation()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to load the default time zone")
+	}
+	triggerTimes, err := swfutil.NewScheduledWorkflow(scheduledWorkflow).PreviewScheduledEpochs(r.time.Now().Unix(), *location, count)
+	if err != nil {
+		return nil, util.NewInvalidInputError("%v", err.Error())
+	}
+	return triggerTimes, nil
+}
+
 // Creates new tasks or updates existing ones.
 // This is not a part of internal API exposed to persistence agent only.
 func (r *ResourceManager) CreateOrUpdateTasks(t []*model.Task) ([]*model.Task, error) {
@@ -1130,6 +1541,8 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 	// If run already exists, simply update it
 	run, updateError := r.GetRun(runId)
 	if updateError == nil {
+		previousState := run.State.ToV2()
+		previousManifest := run.WorkflowRuntimeManifest
 		run.State = state
 		run.Conditions = string(state.ToV1())
 		run.FinishedAtInSec = execStatus.FinishedAt()
@@ -1137,6 +1550,12 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 		if updateError = r.runStore.UpdateRun(run); updateError != nil {
 			return nil, util.Wrapf(updateError, "Failed to report a workflow for existing run %s during updating the run. Check if the run entry is corrupted", runId)
 		}
+		var events []*model.RunEvent
+		if state != previousState {
+			events = append(events, newRunEvent(model.RunEventTypeRunStateChanged, run))
+			r.notifyRunStateChange(run, previousState)
+		}
+		r.publishRunEvents(append(events, newTaskEvents(run, previousManifest, execSpec)...)...)
 	}
 	if jobId == "" {
 		// If a run doesn't have job ID, it's a one-time run created by Pipeline API server.
@@ -1176,6 +1595,11 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 		namespace := existingJob.Namespace
 		pipelineSpec := existingJob.PipelineSpec
 		pipelineSpec.WorkflowSpecManifest = execSpec.GetExecutionSpec().ToStringForStore()
+		if existingJob.UseLatestPipelineVersion {
+			if err := r.setRunPipelineVersion(&pipelineSpec, execSpec); err != nil {
+				return nil, util.Wrapf(err, "Failed to report a workflow for run %s of recurring run %s", runId, jobId)
+			}
+		}
 
 		// Try to fetch experiment id from resource references if it is missing.
 		if experimentId == "" {
@@ -1235,6 +1659,8 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 		} else {
 			runId = run.UUID
 		}
+		r.publishRunEvents(append([]*model.RunEvent{newRunEvent(model.RunEventTypeRunCreated, run)}, newTaskEvents(run, "", execSpec)...)...)
+		r.notifyRunStateChange(run, model.RuntimeStateUnspecified)
 	}
 	if execStatus.IsInFinalState() {
 		err := addWorkflowLabel(ctx, r.getWorkflowClient(execSpec.ExecutionNamespace()), execSpec.ExecutionName(), util.LabelKeyWorkflowPersistedFinalState, "true")
@@ -1670,7 +2096,9 @@ func (r *ResourceManager) IsAuthorized(ctx context.Context, resourceAttributes *
 		return nil
 	}
 
+	// Audit events are only readable by admins, even in shared read mode.
 	if common.IsMultiUserSharedReadMode() &&
+		resourceAttributes.Resource != common.RbacResourceTypeAuditEvents &&
 		(resourceAttributes.Verb == common.RbacResourceVerbGet ||
 			resourceAttributes.Verb == common.RbacResourceVerbList) {
 		glog.Infof("Multi-user shared read mode is enabled. Request allowed: %+v", resourceAttributes)
@@ -1685,8 +2113,19 @@ func (r *ResourceManager) IsAuthorized(ctx context.Context, resourceAttributes *
 	// based on the namespace field in the request.
 	errlist := make([]error, 0)
 	userIdentity := ""
-	for _, auth := range r.authenticators {
-		identity, err := auth.GetUserIdentity(ctx)
+	var userGroups []string
+	for _, authenticator := range r.authenticators {
+		if userInfoAuthenticator, ok := authenticator.(kfpauth.UserInfoAuthenticator); ok {
+			userInfo, err := userInfoAuthenticator.GetUserInfo(ctx)
+			if err == nil {
+				userIdentity = userInfo.Name
+				userGroups = userInfo.Groups
+				break
+			}
+			errlist = append(errlist, err)
+			continue
+		}
+		identity, err := authenticator.GetUserIdentity(ctx)
 		if err == nil {
 			userIdentity = identity
 
@@ -1698,54 +2137,22 @@ func (r *ResourceManager) IsAuthorized(ctx context.Context, resourceAttributes *
 		return util.NewUnauthenticatedError(utilerrors.NewAggregate(errlist), "Failed to check authorization. User identity is empty in the request header")
 	}
 
-	glog.Infof("User: %s, ResourceAttributes: %+v", userIdentity, resourceAttributes)
+	glog.Infof("User: %s, Groups: %v, ResourceAttributes: %+v", userIdentity, userGroups, resourceAttributes)
+	audit.SetIdentity(ctx, userIdentity, resourceAttributes.Namespace)
 	glog.Info("Authorizing request")
-	result, err := r.subjectAccessReviewClient.Create(
-		ctx,
-		&authorizationv1.SubjectAccessReview{
-			Spec: authorizationv1.SubjectAccessReviewSpec{
-				ResourceAttributes: resourceAttributes,
-				User:               userIdentity,
-			},
-		},
-		v1.CreateOptions{},
-	)
-	if err != nil {
-		if err, ok := err.(net.Error); ok && err.Timeout() {
-			reportErr := util.NewUnavailableServerError(
-				err,
-				"Failed to create SubjectAccessReview for user '%s' (request: %+v) - try again later",
-				userIdentity,
-				resourceAttributes,
-			)
-			glog.Info(reportErr.Error())
-			return reportErr
-		} else {
-			reportErr := util.NewInternalServerError(
-				err,
-				"Failed to create SubjectAccessReview for user '%s' (request: %+v)",
-				userIdentity,
-				resourceAttributes,
-			)
-			glog.Info(reportErr.Error())
-			return reportErr
-		}
-	}
-	if !result.Status.Allowed {
-		err := util.NewPermissionDeniedError(
-			errors.New("Unauthorized access"),
-			"User '%s' is not authorized with reason: %s (request: %+v)",
-			userIdentity,
-			result.Status.Reason,
-			resourceAttributes,
-		)
-		glog.Info(err.Error())
+	user := &kfpauth.UserInfo{Name: userIdentity, Groups: userGroups}
+	if err := r.authorizer.Authorize(ctx, user, resourceAttributes); err != nil {
 		return err
 	}
 	glog.Infof("Authorized user '%s': %+v", userIdentity, resourceAttributes)
 	return nil
 }
 
+// Fetches audit events with given list options.
+func (r *ResourceManager) ListAuditEvents(opts *list.Options) ([]*model.AuditEvent, int, string, error) {
+	return r.auditEventStore.ListAuditEvents(opts)
+}
+
 // Fetches namespace that an experiment belongs to.
 func (r *ResourceManager) GetNamespaceFromExperimentId(experimentId string) (string, error) {
 	if experimentId == "" {
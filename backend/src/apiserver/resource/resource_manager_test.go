@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -72,6 +74,18 @@ func (m *FakeBadObjectStore) GetFromYamlFile(o interface{}, filePath string) err
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
 
+func (m *FakeBadObjectStore) StatFile(filePath string) (*storage.ObjectInfo, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
+func (m *FakeBadObjectStore) GetFileRange(filePath string, offset int64, length int64) (io.ReadCloser, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
+func (m *FakeBadObjectStore) GetSignedURL(filePath string, expiry time.Duration) (string, error) {
+	return "", util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
 func createPipelineV1(name string) *model.Pipeline {
 	return &model.Pipeline{
 		Name:   name,
@@ -1625,7 +1639,11 @@ func TestCreateRun_ThroughPipelineID(t *testing.T) {
 
 	expectedRuntimeWorkflow := testWorkflow.DeepCopy()
 	template.AddRuntimeMetadata(expectedRuntimeWorkflow)
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: experiment.UUID,
+		util.LabelKeyWorkflowPipelineId:   p.UUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "param1", Value: v1alpha1.AnyStringPtr("world")}}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = common.DefaultPipelineRunnerServiceAccount
@@ -1714,7 +1732,10 @@ func TestCreateRun_ThroughWorkflowSpec(t *testing.T) {
 	expectedExperimentUUID := runDetail.ExperimentId
 	expectedRuntimeWorkflow := testWorkflow.DeepCopy()
 	template.AddRuntimeMetadata(expectedRuntimeWorkflow)
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: expectedExperimentUUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "param1", Value: v1alpha1.AnyStringPtr("world")}}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = common.DefaultPipelineRunnerServiceAccount
@@ -1764,7 +1785,10 @@ func TestCreateRun_ThroughWorkflowSpecWithPatch(t *testing.T) {
 	expectedExperimentUUID := runDetail.ExperimentId
 	expectedRuntimeWorkflow := testWorkflow.DeepCopy()
 	template.AddRuntimeMetadata(expectedRuntimeWorkflow)
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: expectedExperimentUUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "param1", Value: v1alpha1.AnyStringPtr("test-default-bucket")}}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = common.DefaultPipelineRunnerServiceAccount
@@ -1870,7 +1894,11 @@ func TestCreateRun_ThroughPipelineVersion(t *testing.T) {
 
 	expectedRuntimeWorkflow := testWorkflow.DeepCopy()
 	template.AddRuntimeMetadata(expectedRuntimeWorkflow)
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: experiment.UUID,
+		util.LabelKeyWorkflowPipelineId:   pipeline.UUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "param1", Value: v1alpha1.AnyStringPtr("world")}}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = "sa1"
@@ -1950,7 +1978,11 @@ func TestCreateRun_ThroughPipelineIdAndPipelineVersion(t *testing.T) {
 
 	expectedRuntimeWorkflow := testWorkflow.DeepCopy()
 	template.AddRuntimeMetadata(expectedRuntimeWorkflow)
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: experiment.UUID,
+		util.LabelKeyWorkflowPipelineId:   pipeline.UUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "param1", Value: v1alpha1.AnyStringPtr("world")}}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = "sa1"
@@ -2730,6 +2762,366 @@ func TestDeleteJob(t *testing.T) {
 	assert.Contains(t, err.Error(), fmt.Sprintf("Job %v not found", job.UUID))
 }
 
+// Util function to create an initial state with an hourly job.
+func initWithPeriodicJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job) {
+	store, manager, exp := initWithExperiment(t)
+	job := &model.Job{
+		DisplayName: "j1",
+		Enabled:     true,
+		Trigger: model.Trigger{
+			PeriodicSchedule: model.PeriodicSchedule{
+				IntervalSecond: util.Int64Pointer(3600),
+			},
+		},
+		PipelineSpec: model.PipelineSpec{
+			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+		},
+		ExperimentId: exp.UUID,
+	}
+	j, err := manager.CreateJob(context.Background(), job)
+	assert.Nil(t, err)
+
+	return store, manager, j
+}
+
+func TestBackfillJob(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	runs, skipped, err := manager.BackfillJob(context.Background(), job.UUID, 3600, 4*3600, 2)
+	assert.Nil(t, err)
+	assert.Empty(t, skipped)
+	assert.Equal(t, 4, len(runs))
+	assert.Equal(t, 4, store.ExecClientFake.GetWorkflowCount())
+	for i, run := range runs {
+		assert.Equal(t, int64(i+1)*3600, run.ScheduledAtInSec)
+		assert.Equal(t, job.UUID, run.RecurringRunId)
+		assert.Equal(t, job.ExperimentId, run.ExperimentId)
+		assert.Equal(t, model.RuntimeStatePending, run.State)
+
+		storedRun, err := manager.GetRun(run.UUID)
+		assert.Nil(t, err)
+		assert.Equal(t, run.K8SName, storedRun.K8SName)
+		wf, err := store.ExecClientFake.Execution(job.Namespace).Get(context.Background(), run.K8SName, v1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, job.K8SName, wf.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowScheduledWorkflowName])
+		assert.Equal(t, run.UUID, wf.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowRunId])
+	}
+	// The creation of the runs is published to the watches.
+	events := watchRuns(t, manager, &RunWatchOptions{ResumeToken: "0"}, 4)
+	require.Len(t, events, 4)
+	var runIds, eventRunIds []string
+	for i, event := range events {
+		assert.Equal(t, model.RunEventTypeRunCreated, event.Type)
+		assert.Equal(t, job.Namespace, event.Namespace)
+		runIds = append(runIds, runs[i].UUID)
+		eventRunIds = append(eventRunIds, event.RunId)
+	}
+	assert.ElementsMatch(t, runIds, eventRunIds)
+
+	// The scheduled times which already have a run are skipped.
+	runs, skipped, err = manager.BackfillJob(context.Background(), job.UUID, 3*3600, 5*3600, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3 * 3600, 4 * 3600}, skipped)
+	assert.Equal(t, 1, len(runs))
+	assert.Equal(t, int64(5*3600), runs[0].ScheduledAtInSec)
+	assert.Equal(t, 5, store.ExecClientFake.GetWorkflowCount())
+}
+
+func TestBackfillJob_ReservesIndexes(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+	swfClient := store.SwfClient().ScheduledWorkflow(job.Namespace)
+	stale, err := swfClient.Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	stale = stale.DeepCopy()
+
+	runs, _, err := manager.BackfillJob(context.Background(), job.UUID, 3600, 2*3600, 1)
+	assert.Nil(t, err)
+	runs2, _, err := manager.BackfillJob(context.Background(), job.UUID, 3*3600, 3*3600, 1)
+	assert.Nil(t, err)
+
+	// The indexes follow each other across backfills, and are reserved in the status.
+	for i, run := range append(runs, runs2...) {
+		wf, err := store.ExecClientFake.Execution(job.Namespace).Get(context.Background(), run.K8SName, v1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, util.FormatInt64ForLabel(int64(i+1)), wf.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowIndex])
+	}
+	scheduledWorkflow, err := swfClient.Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), *scheduledWorkflow.Status.Trigger.LastIndex)
+
+	// A status computed before the backfills can't release the reserved indexes.
+	_, err = swfClient.Update(context.Background(), stale)
+	assert.True(t, apierrors.IsConflict(err))
+}
+
+// racingRunStore stores the runs like the persistence agent, before the API server
+// stores them.
+type racingRunStore struct {
+	storage.RunStoreInterface
+}
+
+func (s *racingRunStore) CreateRun(run *model.Run) (*model.Run, error) {
+	reported := *run
+	reported.RunDetails.State = model.RuntimeStateRunning
+	if _, err := s.RunStoreInterface.CreateRun(&reported); err != nil {
+		return nil, err
+	}
+	return s.RunStoreInterface.CreateRun(run)
+}
+
+func TestBackfillJob_RunStoredByPersistenceAgent(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+	manager.runStore = &racingRunStore{manager.runStore}
+
+	runs, skipped, err := manager.BackfillJob(context.Background(), job.UUID, 3600, 2*3600, 2)
+	assert.Nil(t, err)
+	assert.Empty(t, skipped)
+	assert.Equal(t, 2, len(runs))
+	for i, run := range runs {
+		assert.Equal(t, int64(i+1)*3600, run.ScheduledAtInSec)
+		// The run stored by the persistence agent is returned.
+		assert.Equal(t, model.RuntimeStateRunning, run.State)
+	}
+}
+
+func TestBackfillJob_TooManyScheduledTimes(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	_, _, err := manager.BackfillJob(context.Background(), job.UUID, 0, (maxBackfillRuns+1)*3600, 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Equal(t, 0, store.ExecClientFake.GetWorkflowCount())
+}
+
+func TestBackfillJob_NoSchedule(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	_, _, err := manager.BackfillJob(context.Background(), job.UUID, 0, 3600, 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "neither a cron nor a periodic schedule")
+}
+
+func TestBackfillJob_JobNotExist(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	_, _, err := manager.BackfillJob(context.Background(), "1", 0, 3600, 5)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestMaterializeJobWorkflow(t *testing.T) {
+	store, manager, experiment, pipeline, version := initWithExperimentAndPipeline(t)
+	defer store.Close()
+	job, err := manager.CreateJob(context.Background(), &model.Job{
+		DisplayName:  "j1",
+		Enabled:      true,
+		ExperimentId: experiment.UUID,
+		Trigger: model.Trigger{
+			PeriodicSchedule: model.PeriodicSchedule{IntervalSecond: util.Int64Pointer(3600)},
+		},
+		PipelineSpec:             model.PipelineSpec{PipelineId: pipeline.UUID},
+		UseLatestPipelineVersion: true,
+	})
+	assert.Nil(t, err)
+	swf, err := store.swfClientFake.ScheduledWorkflow(job.Namespace).Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, swf.Spec.UseLatestPipelineVersion)
+
+	workflow, versionId, err := manager.MaterializeJobWorkflow(job.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, version.UUID, versionId)
+
+	// Upload a new version of the pipeline.
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	assert.True(t, ok)
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	newVersion, err := manager.CreatePipelineVersion(createPipelineVersion(
+		pipeline.UUID, "p1/v2", "", "", testWorkflow.ToStringForStore(), "", "ns1"))
+	assert.Nil(t, err)
+
+	workflow, versionId, err = manager.MaterializeJobWorkflow(job.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, newVersion.UUID, versionId)
+	execSpec, err := util.ScheduleSpecToExecutionSpec(util.ArgoWorkflow, workflow)
+	assert.Nil(t, err)
+	assert.Equal(t, newVersion.UUID, execSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowPipelineVersionId])
+	assert.Equal(t, pipeline.UUID, execSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowPipelineId])
+
+	// The runs record the version their workflow was materialized from.
+	runs, _, err := manager.BackfillJob(context.Background(), job.UUID, 3600, 3600, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(runs))
+	assert.Equal(t, newVersion.UUID, runs[0].PipelineSpec.PipelineVersionId)
+
+	reported := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "MY_NAME",
+			Namespace: job.Namespace,
+			UID:       "WORKFLOW_1",
+			Labels: map[string]string{
+				util.LabelKeyWorkflowRunId:             "WORKFLOW_1",
+				util.LabelKeyWorkflowPipelineVersionId: version.UUID,
+			},
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "kubeflow.org/v1beta1",
+				Kind:       "ScheduledWorkflow",
+				Name:       job.K8SName,
+				UID:        types.UID(job.UUID),
+			}},
+			CreationTimestamp: v1.NewTime(time.Unix(11, 0).UTC()),
+		},
+	})
+	_, err = manager.ReportWorkflowResource(context.Background(), reported)
+	assert.Nil(t, err)
+	run, err := manager.GetRun("WORKFLOW_1")
+	assert.Nil(t, err)
+	assert.Equal(t, version.UUID, run.PipelineSpec.PipelineVersionId)
+	assert.Equal(t, pipeline.UUID, run.PipelineSpec.PipelineId)
+}
+
+func TestMaterializeJobWorkflow_PinnedVersion(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	_, _, err := manager.MaterializeJobWorkflow(job.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestUpdateJob(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	swf, err := store.SwfClient().ScheduledWorkflow(job.Namespace).Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	lastTriggered := v1.NewTime(time.Unix(102*3600, 0))
+	nextTriggered := v1.NewTime(time.Unix(103*3600, 0))
+	swf.Status.Trigger.LastTriggeredTime = &lastTriggered
+	swf.Status.Trigger.NextTriggeredTime = &nextTriggered
+	swf.Status.Trigger.LastIndex = util.Int64Pointer(5)
+
+	job.MaxConcurrency = 3
+	job.Trigger = model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron: util.StringPointer("0 0 * * * *"),
+		},
+	}
+	updatedJob, err := manager.UpdateJob(context.Background(), job)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), updatedJob.MaxConcurrency)
+	assert.Equal(t, "0 0 * * * *", *updatedJob.Trigger.CronSchedule.Cron)
+	assert.Equal(t, testWorkflow.ToStringForStore(), updatedJob.PipelineSpec.WorkflowSpecManifest)
+
+	swf, err = store.SwfClient().ScheduledWorkflow(job.Namespace).Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, job.UUID, string(swf.UID))
+	assert.True(t, swf.Spec.Enabled)
+	assert.Equal(t, int64(3), *swf.Spec.MaxConcurrency)
+	assert.Equal(t, "0 0 * * * *", swf.Spec.Trigger.CronSchedule.Cron)
+	assert.Nil(t, swf.Spec.Trigger.PeriodicSchedule)
+	// The trigger status is reset, but the new workflows continue after the existing ones.
+	assert.Nil(t, swf.Status.Trigger.NextTriggeredTime)
+	assert.Equal(t, &lastTriggered, swf.Status.Trigger.LastTriggeredTime)
+	assert.Equal(t, int64(5), *swf.Status.Trigger.LastIndex)
+}
+
+func TestUpdateJob_SameTrigger(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	swf, err := store.SwfClient().ScheduledWorkflow(job.Namespace).Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	nextTriggered := v1.NewTime(time.Unix(103*3600, 0))
+	swf.Status.Trigger.NextTriggeredTime = &nextTriggered
+
+	job.NoCatchup = true
+	updatedJob, err := manager.UpdateJob(context.Background(), job)
+	assert.Nil(t, err)
+	assert.True(t, updatedJob.NoCatchup)
+
+	swf, err = store.SwfClient().ScheduledWorkflow(job.Namespace).Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, *swf.Spec.NoCatchup)
+	assert.Equal(t, &nextTriggered, swf.Status.Trigger.NextTriggeredTime)
+}
+
+func TestUpdateJob_CustomResourceNotFound(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	err := store.SwfClient().ScheduledWorkflow(job.Namespace).Delete(context.Background(), job.K8SName, &v1.DeleteOptions{})
+	assert.Nil(t, err)
+
+	_, err = manager.UpdateJob(context.Background(), job)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Check if the scheduled workflow exists")
+}
+
+func TestPreviewJobTriggers(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	swf, err := store.SwfClient().ScheduledWorkflow(job.Namespace).Get(context.Background(), job.K8SName, v1.GetOptions{})
+	assert.Nil(t, err)
+	swf.CreationTimestamp = v1.NewTime(time.Unix(100*3600, 0))
+	lastTriggered := v1.NewTime(time.Unix(102*3600, 0))
+	swf.Status.Trigger.LastTriggeredTime = &lastTriggered
+
+	triggerTimes, err := manager.PreviewJobTriggers(context.Background(), job.UUID, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{103 * 3600, 104 * 3600, 105 * 3600}, triggerTimes)
+}
+
+func TestPreviewJobTriggers_NoSchedule(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	_, err := manager.PreviewJobTriggers(context.Background(), job.UUID, 3)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestPreviewTrigger(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+
+	tests := []struct {
+		name     string
+		trigger  model.Trigger
+		expected []int64
+	}{
+		{
+			name: "periodic",
+			trigger: model.Trigger{PeriodicSchedule: model.PeriodicSchedule{
+				PeriodicScheduleStartTimeInSec: util.Int64Pointer(100 * 3600),
+				PeriodicScheduleEndTimeInSec:   util.Int64Pointer(101 * 3600),
+				IntervalSecond:                 util.Int64Pointer(1800),
+			}},
+			// The first trigger is one interval after the start time, and the last one at the end time.
+			expected: []int64{100*3600 + 1800, 101 * 3600},
+		},
+		{
+			name: "cron",
+			trigger: model.Trigger{CronSchedule: model.CronSchedule{
+				CronScheduleStartTimeInSec: util.Int64Pointer(100 * 3600),
+				Cron:                       util.StringPointer("0 0 * * * *"),
+			}},
+			expected: []int64{101 * 3600, 102 * 3600, 103 * 3600, 104 * 3600},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			triggerTimes, err := manager.PreviewTrigger(tt.trigger, false, 4)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, triggerTimes)
+		})
+	}
+}
+
 func TestDeleteJob_JobNotExist(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -0,0 +1,190 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RunLogOptions select the log entries of a run.
+type RunLogOptions struct {
+	// NodeIds are the nodes whose logs are read, all the pod nodes of the run if empty.
+	NodeIds []string
+	// SinceTime and UntilTime bound the timestamps of the entries, unless they are zero.
+	// Entries without a timestamp are excluded when the timestamps are bound.
+	SinceTime time.Time
+	UntilTime time.Time
+	// Filter is a substring of the entries, unless it is empty.
+	Filter string
+	// Regexp matches the entries, unless it is nil.
+	Regexp *regexp.Regexp
+	// Tail is the number of last matching entries of each node, zero for all entries.
+	Tail int
+}
+
+// RunLogEntry is a log entry of a node of a run.
+type RunLogEntry struct {
+	NodeId    string    `json:"node_id"`
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	Log       string    `json:"log"`
+}
+
+func (o *RunLogOptions) matches(entry archive.RunLogEntry) bool {
+	if !o.SinceTime.IsZero() || !o.UntilTime.IsZero() {
+		if entry.Timestamp.IsZero() {
+			return false
+		}
+		if !o.SinceTime.IsZero() && entry.Timestamp.Before(o.SinceTime) {
+			return false
+		}
+		if !o.UntilTime.IsZero() && entry.Timestamp.After(o.UntilTime) {
+			return false
+		}
+	}
+	if o.Filter != "" && !strings.Contains(entry.Log, o.Filter) {
+		return false
+	}
+	if o.Regexp != nil && !o.Regexp.MatchString(entry.Log) {
+		return false
+	}
+	return true
+}
+
+// ReadRunLogEntries calls fn with the log entries of the nodes of a run matching
+// the options. The logs of the nodes are read in the order in which the nodes
+// started, from their pods if they still exist, else from the log archive.
+func (r *ResourceManager) ReadRunLogEntries(ctx context.Context, runId string, opts *RunLogOptions, fn func(entry *RunLogEntry) error) error {
+	run, err := r.GetRun(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read logs for run %v due to run fetching error", runId)
+	}
+	// TODO(gkcalat): consider using run.Namespace after migration logic will be available.
+	namespace, err := r.getNamespaceFromRunId(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read logs for run %v due to namespace fetching error", runId)
+	}
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+	nodeIds, err := getRunLogNodeIds(run, opts.NodeIds)
+	if err != nil {
+		return err
+	}
+
+	for _, nodeId := range nodeIds {
+		var tail []*RunLogEntry
+		err := r.readNodeLogEntries(ctx, namespace, run.WorkflowRuntimeManifest, nodeId, opts, func(entry archive.RunLogEntry) error {
+			if !opts.matches(entry) {
+				return nil
+			}
+			runLogEntry := &RunLogEntry{NodeId: nodeId, Timestamp: entry.Timestamp, Log: entry.Log}
+			if opts.Tail <= 0 {
+				return fn(runLogEntry)
+			}
+			if len(tail) == opts.Tail {
+				tail = tail[1:]
+			}
+			tail = append(tail, runLogEntry)
+			return nil
+		})
+		if err != nil {
+			return util.Wrapf(err, "Failed to read logs for run %v", runId)
+		}
+		for _, entry := range tail {
+			if err := fn(entry); err != nil {
+				return util.Wrapf(err, "Failed to read logs for run %v", runId)
+			}
+		}
+	}
+	return nil
+}
+
+// getRunLogNodeIds returns the requested nodes of a run, or all its pod nodes in
+// the order in which they started.
+func getRunLogNodeIds(run *model.Run, requestedNodeIds []string) ([]string, error) {
+	if len(requestedNodeIds) > 0 {
+		return requestedNodeIds, nil
+	}
+	if run.WorkflowRuntimeManifest == "" {
+		return nil, nil
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.WorkflowRuntimeManifest))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to read the nodes of run %v", run.UUID)
+	}
+	var nodes []util.NodeStatus
+	for _, node := range execSpec.ExecutionStatus().NodeStatuses() {
+		if node.Type == util.NodeTypePod {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].StartTime != nodes[j].StartTime {
+			return nodes[i].StartTime < nodes[j].StartTime
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	nodeIds := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		nodeIds = append(nodeIds, node.ID)
+	}
+	return nodeIds, nil
+}
+
+// readNodeLogEntries calls fn with the log entries of a node, read from its pod
+// if it still exists, else from the log archive.
+func (r *ResourceManager) readNodeLogEntries(ctx context.Context, namespace string, workflowManifest string, nodeId string, opts *RunLogOptions, fn func(entry archive.RunLogEntry) error) error {
+	logOptions := corev1.PodLogOptions{
+		Container:  "main",
+		Timestamps: true,
+	}
+	if !opts.SinceTime.IsZero() {
+		logOptions.SinceTime = &metav1.Time{Time: opts.SinceTime}
+	}
+	podLogs, err := r.k8sCoreClient.PodClient(namespace).GetLogs(nodeId, &logOptions).Stream(ctx)
+	if err == nil {
+		defer podLogs.Close()
+		return archive.ScanLogEntries(podLogs, fn)
+	}
+	if r.logArchive == nil {
+		return util.NewInternalServerError(err, "Failed to read logs from pod %v due to error opening log stream", nodeId)
+	}
+	glog.V(4).Infof("Reading the archived logs of node %v, its pod logs are unavailable: %v", nodeId, err)
+
+	if workflowManifest == "" {
+		return util.NewInternalServerError(util.NewInvalidInputError("Runtime workflow manifest cannot empty"), "Failed to read logs from archive %v due to empty runtime workflow manifest", nodeId)
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(workflowManifest))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to read logs from archive %v due error reading execution spec", nodeId)
+	}
+	logContent, err := r.logArchive.ReadLog(ctx, execSpec, nodeId)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to read logs from archive %v due to error fetching the log file", nodeId)
+	}
+	return archive.ReadArchivedLogEntries(logContent, fn)
+}
@@ -0,0 +1,447 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// runLogStreamPollInterval is the interval at which a run log stream looks for
+// the new pods of the run, and retries the pods whose logs were unavailable.
+var runLogStreamPollInterval = 5 * time.Second
+
+// runLogOrderDelay is the maximum time for which a run log stream holds an entry
+// while the log of another node may still send an earlier entry.
+var runLogOrderDelay = time.Second
+
+// RunLogStreamOptions select the log entries of a run log stream.
+type RunLogStreamOptions struct {
+	// NodeIds are the nodes whose logs are streamed, all the pod nodes of the run if empty.
+	NodeIds []string
+	// Cursor resumes the stream after the entries already sent, unless it is nil.
+	Cursor *RunLogCursor
+}
+
+// RunLogStreamEntry is a log entry of a node of a run, sent by a run log stream.
+type RunLogStreamEntry struct {
+	NodeId    string
+	TaskName  string
+	Timestamp time.Time
+	Log       string
+	// Cursor is the position of the stream after the entry.
+	Cursor string
+}
+
+// logPosition is the position of an entry in the log of a node: its timestamp,
+// and the number of entries up to it with this timestamp. The entries without a
+// timestamp share the timestamp of the previous entry.
+type logPosition struct {
+	Timestamp int64 `json:"t"`
+	Count     int   `json:"n,omitempty"`
+}
+
+// RunLogCursor is the position of a run log stream. The entries of a stream are
+// sent in the order of their timestamps, then of their node IDs, so the position
+// of the stream is the position of its last entry in the log of its node. It is
+// encoded as an opaque string in the entries of the stream.
+type RunLogCursor struct {
+	nodeId   string
+	position logPosition
+}
+
+// runLogCursorJSON is the encoding of a RunLogCursor.
+type runLogCursorJSON struct {
+	NodeId string `json:"i"`
+	logPosition
+}
+
+// ParseRunLogCursor decodes the cursor of a run log stream entry.
+func ParseRunLogCursor(cursor string) (*RunLogCursor, error) {
+	var decoded runLogCursorJSON
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || json.Unmarshal(bytes, &decoded) != nil {
+		return nil, util.NewInvalidInputError("Invalid cursor %q of the run log stream", cursor)
+	}
+	return &RunLogCursor{nodeId: decoded.NodeId, position: decoded.logPosition}, nil
+}
+
+func (c *RunLogCursor) String() string {
+	bytes, _ := json.Marshal(runLogCursorJSON{NodeId: c.nodeId, logPosition: c.position})
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+// precedes returns whether the entry at a position of the log of a node is sent
+// before the position of the cursor.
+func (c *RunLogCursor) precedes(nodeId string, position logPosition) bool {
+	if order := compareRunLogEntries(nodeId, position.Timestamp, c.nodeId, c.position.Timestamp); order != 0 {
+		return order < 0
+	}
+	return position.Count <= c.position.Count
+}
+
+// compareRunLogEntries returns the order in which the entries of two nodes with
+// the given timestamps are sent by a run log stream.
+func compareRunLogEntries(nodeId1 string, timestamp1 int64, nodeId2 string, timestamp2 int64) int {
+	if timestamp1 != timestamp2 {
+		if timestamp1 < timestamp2 {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(nodeId1, nodeId2)
+}
+
+// nodeLogEntry is an entry read from the log of a node.
+type nodeLogEntry struct {
+	nodeId string
+	entry  archive.RunLogEntry
+}
+
+// nodeLogResult is the result of reading the log of a node until its end.
+type nodeLogResult struct {
+	nodeId string
+	// done is true when the log of the node is complete, false when it must be
+	// read again later.
+	done bool
+}
+
+// bufferedLogEntry is an entry of the log of a node held by a run log stream
+// until it is sent in order.
+type bufferedLogEntry struct {
+	entry    archive.RunLogEntry
+	position logPosition
+	received time.Time
+}
+
+// runLogNode tracks a node of a run log stream.
+type runLogNode struct {
+	taskName string
+	// finished is true when the node finished running.
+	finished bool
+	// reading is true while the log of the node is read.
+	reading bool
+	// done is true when the complete log of the node was read.
+	done bool
+	// position is the position of the last entry read from the log of the node,
+	// and skip is the number of entries of the position still to skip when the
+	// log is read again.
+	position logPosition
+	skip     int
+	// buffer holds the entries read from the log of the node and not yet sent.
+	buffer []bufferedLogEntry
+}
+
+// StreamRunLogEntries calls fn with the log entries of the pods of a run as they
+// are written, until the run finishes and the logs of all its pods are read. The
+// logs of the nodes are read concurrently, from their pods, or from the log archive
+// once their pods are gone, and their entries are sent in the order of their
+// timestamps. Each entry carries a cursor, which resumes the stream after it
+// without duplicates.
+func (r *ResourceManager) StreamRunLogEntries(ctx context.Context, runId string, opts *RunLogStreamOptions, fn func(entry *RunLogStreamEntry) error) error {
+	run, err := r.GetRun(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to stream logs for run %v due to run fetching error", runId)
+	}
+	// TODO(gkcalat): consider using run.Namespace after migration logic will be available.
+	namespace, err := r.getNamespaceFromRunId(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to stream logs for run %v due to namespace fetching error", runId)
+	}
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	var cursor RunLogCursor
+	if opts.Cursor != nil {
+		cursor = *opts.Cursor
+	}
+	nodes := make(map[string]*runLogNode)
+	entries := make(chan nodeLogEntry)
+	results := make(chan nodeLogResult)
+	ticker := time.NewTicker(runLogStreamPollInterval)
+	defer ticker.Stop()
+	orderTicker := time.NewTicker(runLogOrderDelay)
+	defer orderTicker.Stop()
+
+	// Sends the buffered entries which can be sent in order.
+	send := func() error {
+		for {
+			nodeId := r.nextRunLogNode(nodes)
+			if nodeId == "" {
+				return nil
+			}
+			node := nodes[nodeId]
+			buffered := node.buffer[0]
+			node.buffer = node.buffer[1:]
+			if !cursor.precedes(nodeId, buffered.position) {
+				// The position of the stream is unchanged by an entry held longer
+				// than runLogOrderDelay and sent late.
+				cursor = RunLogCursor{nodeId: nodeId, position: buffered.position}
+			}
+			err := fn(&RunLogStreamEntry{
+				NodeId:    nodeId,
+				TaskName:  node.taskName,
+				Timestamp: buffered.entry.Timestamp,
+				Log:       buffered.entry.Log,
+				Cursor:    cursor.String(),
+			})
+			if err != nil {
+				return util.Wrapf(err, "Failed to stream logs for run %v", runId)
+			}
+		}
+	}
+
+	for {
+		runFinished, err := updateRunLogNodes(run, opts.NodeIds, nodes)
+		if err != nil {
+			return err
+		}
+		reading := false
+		for nodeId, node := range nodes {
+			if node.reading {
+				reading = true
+				continue
+			}
+			if node.done {
+				continue
+			}
+			sinceTime := node.position.Timestamp
+			if opts.Cursor != nil && sinceTime < opts.Cursor.position.Timestamp {
+				// The entries before the cursor were sent before the stream resumed.
+				sinceTime = opts.Cursor.position.Timestamp
+			}
+			node.reading = true
+			reading = true
+			wg.Add(1)
+			go func(nodeId string, finished bool, sinceTime int64) {
+				defer wg.Done()
+				done := r.readNodeLog(ctx, namespace, run.WorkflowRuntimeManifest, nodeId, finished || runFinished, sinceTime, entries)
+				select {
+				case results <- nodeLogResult{nodeId: nodeId, done: done}:
+				case <-ctx.Done():
+				}
+			}(nodeId, node.finished, sinceTime)
+		}
+		if runFinished && !reading {
+			return send()
+		}
+
+		// Sends the entries until a node log ends, or the run must be polled.
+		polled := false
+		for !polled {
+			select {
+			case <-ctx.Done():
+				return util.Wrapf(ctx.Err(), "Failed to stream logs for run %v", runId)
+			case e := <-entries:
+				node := nodes[e.nodeId]
+				timestamp := e.entry.Timestamp.UnixNano()
+				if e.entry.Timestamp.IsZero() {
+					timestamp = node.position.Timestamp
+				}
+				if timestamp < node.position.Timestamp {
+					// The entry was read before the log of the node was read again.
+					continue
+				}
+				if timestamp == node.position.Timestamp {
+					if node.skip > 0 {
+						node.skip--
+						continue
+					}
+					node.position.Count++
+				} else {
+					node.position = logPosition{Timestamp: timestamp, Count: 1}
+					node.skip = 0
+				}
+				if opts.Cursor != nil && opts.Cursor.precedes(e.nodeId, node.position) {
+					// The entry was sent before the stream resumed.
+					continue
+				}
+				node.buffer = append(node.buffer, bufferedLogEntry{
+					entry:    e.entry,
+					position: node.position,
+					received: r.time.Now(),
+				})
+				if err := send(); err != nil {
+					return err
+				}
+			case result := <-results:
+				node := nodes[result.nodeId]
+				node.reading = false
+				node.done = result.done
+				// The entries of a node which is read again are skipped up to the
+				// position of its last entry.
+				node.skip = node.position.Count
+				if err := send(); err != nil {
+					return err
+				}
+				polled = runFinished
+			case <-orderTicker.C:
+				if err := send(); err != nil {
+					return err
+				}
+			case <-ticker.C:
+				polled = true
+			}
+		}
+		if !runFinished {
+			if run, err = r.GetRun(runId); err != nil {
+				return util.Wrapf(err, "Failed to stream logs for run %v due to run fetching error", runId)
+			}
+		}
+	}
+}
+
+// nextRunLogNode returns the node of the next buffered entry to send, or an empty
+// string if there is none. The entry is held while the log of another node, which
+// is read, may still send an earlier entry, but for at most runLogOrderDelay.
+func (r *ResourceManager) nextRunLogNode(nodes map[string]*runLogNode) string {
+	next := ""
+	var head bufferedLogEntry
+	for nodeId, node := range nodes {
+		if len(node.buffer) == 0 {
+			continue
+		}
+		if next == "" || compareRunLogEntries(nodeId, node.buffer[0].position.Timestamp, next, head.position.Timestamp) < 0 {
+			next = nodeId
+			head = node.buffer[0]
+		}
+	}
+	if next == "" {
+		return ""
+	}
+	for nodeId, node := range nodes {
+		if node.reading && len(node.buffer) == 0 &&
+			compareRunLogEntries(nodeId, node.position.Timestamp, next, head.position.Timestamp) < 0 {
+			if r.time.Now().Sub(head.received) < runLogOrderDelay {
+				return ""
+			}
+			break
+		}
+	}
+	return next
+}
+
+// updateRunLogNodes adds the new pod nodes of a run to the nodes of a run log
+// stream, and updates their status. It returns whether the run finished.
+func updateRunLogNodes(run *model.Run, requestedNodeIds []string, nodes map[string]*runLogNode) (bool, error) {
+	runFinished := run.FinishedAtInSec > 0
+	if run.WorkflowRuntimeManifest == "" {
+		return runFinished, nil
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.WorkflowRuntimeManifest))
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to read the nodes of run %v", run.UUID)
+	}
+	if execSpec.ExecutionStatus().FinishedAt() > 0 {
+		runFinished = true
+	}
+	requested := make(map[string]bool, len(requestedNodeIds))
+	for _, nodeId := range requestedNodeIds {
+		requested[nodeId] = true
+	}
+	for _, status := range execSpec.ExecutionStatus().NodeStatuses() {
+		if status.Type != util.NodeTypePod || (len(requested) > 0 && !requested[status.ID]) {
+			continue
+		}
+		node, ok := nodes[status.ID]
+		if !ok {
+			node = &runLogNode{}
+			nodes[status.ID] = node
+		}
+		node.taskName = status.DisplayName
+		node.finished = status.FinishTime > 0
+	}
+	if runFinished {
+		// The nodes still running are terminated.
+		for _, node := range nodes {
+			node.finished = true
+		}
+	}
+	return runFinished, nil
+}
+
+// readNodeLog sends the entries of the log of a node from sinceTime, in
+// nanoseconds, following its pod until it terminates. The log is read from the
+// log archive if the node finished and its pod is gone. It returns whether the
+// complete log of the node was read.
+func (r *ResourceManager) readNodeLog(ctx context.Context, namespace string, workflowManifest string, nodeId string, finished bool, sinceTime int64, entries chan<- nodeLogEntry) bool {
+	send := func(entry archive.RunLogEntry) error {
+		select {
+		case entries <- nodeLogEntry{nodeId: nodeId, entry: entry}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	logOptions := corev1.PodLogOptions{
+		Container:  "main",
+		Timestamps: true,
+		Follow:     true,
+	}
+	if sinceTime > 0 {
+		// The pod logs are selected by seconds, the entries before the position
+		// of the stream are skipped.
+		logOptions.SinceTime = &metav1.Time{Time: time.Unix(0, sinceTime)}
+	}
+	podLogs, err := r.k8sCoreClient.PodClient(namespace).GetLogs(nodeId, &logOptions).Stream(ctx)
+	if err == nil {
+		defer podLogs.Close()
+		err = archive.ScanLogEntries(podLogs, send)
+		if err != nil && ctx.Err() == nil {
+			glog.Warningf("Failed to follow the logs of pod %v: %v", nodeId, err)
+		}
+		// The log of a running pod ends when its main container terminates. The
+		// log of a finished node is not read again after an error.
+		return err == nil || finished
+	}
+	if !finished {
+		// The pod is pending, its log is read again later.
+		glog.V(4).Infof("The logs of pod %v are unavailable: %v", nodeId, err)
+		return false
+	}
+	if r.logArchive == nil || workflowManifest == "" {
+		glog.Warningf("The logs of node %v are unavailable: %v", nodeId, err)
+		return true
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(workflowManifest))
+	if err == nil {
+		var logContent []byte
+		if logContent, err = r.logArchive.ReadLog(ctx, execSpec, nodeId); err == nil {
+			err = archive.ReadArchivedLogEntries(logContent, send)
+		}
+	}
+	if err != nil && ctx.Err() == nil {
+		glog.Warningf("Failed to read the archived logs of node %v: %v", nodeId, err)
+	}
+	return true
+}
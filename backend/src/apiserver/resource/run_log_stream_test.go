@@ -0,0 +1,273 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reportRunLogWorkflow reports the workflow of a run with the given pod nodes,
+// which finished unless their finish time is zero.
+func reportRunLogWorkflow(t *testing.T, manager *ResourceManager, runId string, finishedAt time.Time, nodes ...v1alpha1.NodeStatus) {
+	workflow := &v1alpha1.Workflow{
+		TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "workflow-name",
+			Namespace: "ns1",
+			UID:       "workflow1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: runId},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"workflow-name": {ID: "workflow-name", Type: v1alpha1.NodeTypeDAG},
+			},
+			FinishedAt: v1.NewTime(finishedAt),
+		},
+	}
+	for _, node := range nodes {
+		node.Type = v1alpha1.NodeTypePod
+		workflow.Status.Nodes[node.ID] = node
+	}
+	_, err := manager.ReportWorkflowResource(context.Background(), util.NewWorkflow(workflow))
+	require.Nil(t, err)
+}
+
+func finishedPodNode(id string, displayName string) v1alpha1.NodeStatus {
+	return v1alpha1.NodeStatus{
+		ID:          id,
+		DisplayName: displayName,
+		StartedAt:   v1.NewTime(time.Unix(100, 0)),
+		FinishedAt:  v1.NewTime(time.Unix(200, 0)),
+	}
+}
+
+// initWithRunLogStream creates a run with two finished nodes, whose logs interleave.
+func initWithRunLogStream(t *testing.T) (*FakeClientManager, *ResourceManager, string) {
+	initEnvVars()
+	store, manager, run := initWithOneTimeRun(t)
+	reportRunLogWorkflow(t, manager, run.UUID, time.Unix(300, 0),
+		finishedPodNode("node-1", "train"), finishedPodNode("node-2", "evaluate"))
+	store.KubernetesCoreClient().(*client.FakeKuberneteCoreClient).SetPodLog("node-1",
+		"2020-08-31T15:00:00Z Loading data\n"+
+			"2020-08-31T15:00:01Z Training\n"+
+			"2020-08-31T15:00:01Z Still training\n"+
+			"2020-08-31T15:00:02Z Done\n")
+	require.Nil(t, store.ObjectStore().AddFile([]byte(
+		`{"timestamp": "2020-08-31T15:00:01Z", "log": "Evaluating"}`+"\n"+
+			`{"log": "Accuracy: 0.9"}`+"\n"),
+		"/logs/workflow-name/node-2/main.log"))
+	return store, manager, run.UUID
+}
+
+func streamRunLogs(t *testing.T, manager *ResourceManager, runId string, opts *RunLogStreamOptions) []*RunLogStreamEntry {
+	var entries []*RunLogStreamEntry
+	err := manager.StreamRunLogEntries(context.Background(), runId, opts, func(entry *RunLogStreamEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.Nil(t, err)
+	return entries
+}
+
+// logs returns the log lines of the entries, in the order of the stream.
+func logs(entries []*RunLogStreamEntry) []string {
+	var logs []string
+	for _, entry := range entries {
+		logs = append(logs, entry.TaskName+": "+entry.Log)
+	}
+	return logs
+}
+
+// logsByNode returns the log lines of the entries of each node, in the order of the stream.
+func logsByNode(entries []*RunLogStreamEntry) map[string][]string {
+	logs := make(map[string][]string)
+	for _, entry := range entries {
+		logs[entry.NodeId] = append(logs[entry.NodeId], entry.TaskName+": "+entry.Log)
+	}
+	return logs
+}
+
+func TestStreamRunLogEntries(t *testing.T) {
+	store, manager, runId := initWithRunLogStream(t)
+	defer store.Close()
+
+	entries := streamRunLogs(t, manager, runId, &RunLogStreamOptions{})
+	assert.Equal(t, map[string][]string{
+		"node-1": {"train: Loading data", "train: Training", "train: Still training", "train: Done"},
+		"node-2": {"evaluate: Evaluating", "evaluate: Accuracy: 0.9"},
+	}, logsByNode(entries))
+
+	entries = streamRunLogs(t, manager, runId, &RunLogStreamOptions{NodeIds: []string{"node-2"}})
+	assert.Equal(t, map[string][]string{
+		"node-2": {"evaluate: Evaluating", "evaluate: Accuracy: 0.9"},
+	}, logsByNode(entries))
+}
+
+func TestStreamRunLogEntries_Order(t *testing.T) {
+	defer func(delay time.Duration) { runLogOrderDelay = delay }(runLogOrderDelay)
+	runLogOrderDelay = time.Hour
+	store, manager, runId := initWithRunLogStream(t)
+	defer store.Close()
+
+	// The entries are sent in the order of their timestamps, then of their nodes.
+	entries := streamRunLogs(t, manager, runId, &RunLogStreamOptions{})
+	assert.Equal(t, []string{
+		"train: Loading data",
+		"train: Training",
+		"train: Still training",
+		"evaluate: Evaluating",
+		"evaluate: Accuracy: 0.9",
+		"train: Done",
+	}, logs(entries))
+
+	// Each entry carries the position of its node only.
+	for _, entry := range entries {
+		cursor, err := ParseRunLogCursor(entry.Cursor)
+		require.Nil(t, err)
+		assert.Equal(t, entry.NodeId, cursor.nodeId)
+	}
+}
+
+func TestStreamRunLogEntries_Resume(t *testing.T) {
+	defer func(delay time.Duration) { runLogOrderDelay = delay }(runLogOrderDelay)
+	runLogOrderDelay = time.Hour
+	store, manager, runId := initWithRunLogStream(t)
+	defer store.Close()
+
+	entries := streamRunLogs(t, manager, runId, &RunLogStreamOptions{})
+	require.Len(t, entries, 6)
+	// Resuming after each entry streams exactly the following entries.
+	for i, entry := range entries {
+		cursor, err := ParseRunLogCursor(entry.Cursor)
+		require.Nil(t, err)
+		resumed := streamRunLogs(t, manager, runId, &RunLogStreamOptions{Cursor: cursor})
+		assert.Equal(t, logs(entries[i+1:]), logs(resumed), "resuming after entry %d", i)
+		for j, resumedEntry := range resumed {
+			assert.Equal(t, entries[i+1+j].Cursor, resumedEntry.Cursor)
+		}
+	}
+
+	// The selected nodes resume after the entries of the other nodes too.
+	cursor, err := ParseRunLogCursor(entries[3].Cursor)
+	require.Nil(t, err)
+	resumed := streamRunLogs(t, manager, runId, &RunLogStreamOptions{NodeIds: []string{"node-1"}, Cursor: cursor})
+	assert.Equal(t, []string{"train: Done"}, logs(resumed))
+}
+
+func TestNextRunLogNode(t *testing.T) {
+	defer func(delay time.Duration) { runLogOrderDelay = delay }(runLogOrderDelay)
+	runLogOrderDelay = 10 * time.Second
+	// The fake time advances by a second each time it is read.
+	manager := &ResourceManager{time: util.NewFakeTime(time.Unix(100, 0))}
+	received := time.Unix(100, 0)
+	nodes := map[string]*runLogNode{
+		"node-1": {reading: true, position: logPosition{Timestamp: 2, Count: 1},
+			buffer: []bufferedLogEntry{{position: logPosition{Timestamp: 2, Count: 1}, received: received}}},
+		"node-2": {reading: true, position: logPosition{Timestamp: 1, Count: 1}},
+		"node-3": {position: logPosition{Timestamp: 1, Count: 1}},
+	}
+
+	// node-2 may still send an earlier entry, node-3 is not read.
+	assert.Equal(t, "", manager.nextRunLogNode(nodes))
+	nodes["node-2"].position.Timestamp = 3
+	assert.Equal(t, "node-1", manager.nextRunLogNode(nodes))
+
+	// An entry is held for at most runLogOrderDelay.
+	nodes["node-2"].position.Timestamp = 1
+	nodes["node-1"].buffer[0].received = time.Unix(90, 0)
+	assert.Equal(t, "node-1", manager.nextRunLogNode(nodes))
+
+	// The earliest entry is sent first.
+	nodes["node-2"].buffer = []bufferedLogEntry{{position: logPosition{Timestamp: 1, Count: 1}, received: received}}
+	assert.Equal(t, "node-2", manager.nextRunLogNode(nodes))
+	nodes["node-2"].buffer[0].position.Timestamp = 2
+	assert.Equal(t, "node-1", manager.nextRunLogNode(nodes))
+}
+
+func TestStreamRunLogEntries_RunningRun(t *testing.T) {
+	defer func(interval time.Duration) { runLogStreamPollInterval = interval }(runLogStreamPollInterval)
+	runLogStreamPollInterval = 10 * time.Millisecond
+	initEnvVars()
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	podClient := store.KubernetesCoreClient().(*client.FakeKuberneteCoreClient)
+	podClient.SetPodLog("node-1", "2020-08-31T15:00:00Z Loading data\n2020-08-31T15:00:01Z Training\n")
+	podClient.SetPodLog("node-2", "2020-08-31T15:01:00Z Evaluating\n")
+	running := v1alpha1.NodeStatus{ID: "node-1", DisplayName: "train", StartedAt: v1.NewTime(time.Unix(100, 0))}
+	reportRunLogWorkflow(t, manager, run.UUID, time.Time{}, running)
+
+	// The run starts node-2 and finishes after the logs of node-1 are streamed.
+	node1Streamed := make(chan struct{})
+	reported := make(chan struct{})
+	go func() {
+		defer close(reported)
+		<-node1Streamed
+		reportRunLogWorkflow(t, manager, run.UUID, time.Unix(300, 0),
+			finishedPodNode("node-1", "train"), finishedPodNode("node-2", "evaluate"))
+	}()
+	var entries []*RunLogStreamEntry
+	err := manager.StreamRunLogEntries(context.Background(), run.UUID, &RunLogStreamOptions{}, func(entry *RunLogStreamEntry) error {
+		entries = append(entries, entry)
+		if len(entries) == 2 {
+			close(node1Streamed)
+		}
+		return nil
+	})
+	<-reported
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]string{
+		"node-1": {"train: Loading data", "train: Training"},
+		"node-2": {"evaluate: Evaluating"},
+	}, logsByNode(entries))
+}
+
+func TestStreamRunLogEntries_Canceled(t *testing.T) {
+	defer func(interval time.Duration) { runLogStreamPollInterval = interval }(runLogStreamPollInterval)
+	runLogStreamPollInterval = 10 * time.Millisecond
+	initEnvVars()
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	// The pod of the running node is pending, so its log is unavailable.
+	pending := v1alpha1.NodeStatus{ID: "node-1", DisplayName: "train", StartedAt: v1.NewTime(time.Unix(100, 0))}
+	reportRunLogWorkflow(t, manager, run.UUID, time.Time{}, pending)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := manager.StreamRunLogEntries(ctx, run.UUID, &RunLogStreamOptions{}, func(entry *RunLogStreamEntry) error {
+		return nil
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestParseRunLogCursor(t *testing.T) {
+	cursor := &RunLogCursor{nodeId: "node-1", position: logPosition{Timestamp: 1598886001000000000, Count: 2}}
+	parsed, err := ParseRunLogCursor(cursor.String())
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, parsed)
+
+	_, err = ParseRunLogCursor("not a cursor")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid cursor")
+}
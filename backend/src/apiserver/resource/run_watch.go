@@ -0,0 +1,416 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// The maximum number of run events read at once by a watch.
+	runEventPageSize = 100
+	// The minimum interval between two deletions of the expired run events.
+	runEventPruneInterval = time.Hour
+)
+
+// runEventPollInterval is the interval at which a watch looks for the events
+// published by the other API server replicas.
+var runEventPollInterval = 5 * time.Second
+
+// runEventGapTimeout is the time for which a watch waits for missing events. The
+// sequence numbers are assigned when the events are inserted, but concurrent
+// transactions may commit them out of order, so that an event can appear after
+// the following ones. The sequence numbers of the failed inserts are never used.
+var runEventGapTimeout = 10 * time.Second
+
+// RunWatchOptions select the run events of a watch.
+type RunWatchOptions struct {
+	// Namespace is the namespace of the runs, any namespace if empty.
+	Namespace string
+	// ExperimentId is the experiment of the runs, any experiment if empty.
+	ExperimentId string
+	// RunIds are the watched runs, all the runs if empty.
+	RunIds []string
+	// IncludeTasks selects the events of the tasks of the runs.
+	IncludeTasks bool
+	// ResumeToken resumes the watch after an event, if not empty. The watch
+	// starts with the next event otherwise.
+	ResumeToken string
+}
+
+func (o *RunWatchOptions) matches(event *model.RunEvent) bool {
+	if event.Type.IsTaskEvent() && !o.IncludeTasks {
+		return false
+	}
+	if o.Namespace != "" && event.Namespace != o.Namespace {
+		return false
+	}
+	if o.ExperimentId != "" && event.ExperimentId != o.ExperimentId {
+		return false
+	}
+	if len(o.RunIds) == 0 {
+		return true
+	}
+	for _, runId := range o.RunIds {
+		if event.RunId == runId {
+			return true
+		}
+	}
+	return false
+}
+
+// RunEventResumeToken returns the token resuming a watch after an event.
+func RunEventResumeToken(event *model.RunEvent) string {
+	return strconv.FormatInt(event.Sequence, 10)
+}
+
+// WatchRuns calls fn with the run events matching the options, as they are
+// published, until the context is done or fn fails.
+func (r *ResourceManager) WatchRuns(ctx context.Context, opts *RunWatchOptions, fn func(event *model.RunEvent) error) error {
+	notify := r.runEvents.subscribe()
+	defer r.runEvents.unsubscribe(notify)
+	ticker := time.NewTicker(runEventPollInterval)
+	defer ticker.Stop()
+
+	var sequence int64
+	if opts.ResumeToken != "" {
+		var err error
+		sequence, err = strconv.ParseInt(opts.ResumeToken, 10, 64)
+		if err != nil || sequence < 0 {
+			return util.NewInvalidInputError("Failed to watch runs due to invalid resume token %q", opts.ResumeToken)
+		}
+	} else {
+		var err error
+		if sequence, err = r.runEvents.lastSequence(); err != nil {
+			return util.Wrap(err, "Failed to watch runs")
+		}
+	}
+	// gapSince is the time since which the events following the sequence number
+	// are missing, while later events were stored.
+	var gapSince time.Time
+	for {
+		events, err := r.runEvents.eventsAfter(sequence, runEventPageSize)
+		if err != nil {
+			return util.Wrap(err, "Failed to watch runs")
+		}
+		waiting := false
+		for _, event := range events {
+			if event.Sequence > sequence+1 {
+				now := r.runEvents.time.Now()
+				if gapSince.IsZero() {
+					gapSince = now
+				}
+				if now.Sub(gapSince) < runEventGapTimeout {
+					waiting = true
+					break
+				}
+				glog.Warningf("Skipping the missing run events %v to %v", sequence+1, event.Sequence-1)
+			}
+			gapSince = time.Time{}
+			sequence = event.Sequence
+			if !opts.matches(event) {
+				continue
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if len(events) == runEventPageSize && !waiting {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		case <-ticker.C:
+			if _, err := r.runEvents.lastSequence(); err != nil {
+				return util.Wrap(err, "Failed to watch runs")
+			}
+		}
+	}
+}
+
+// publishRunEvents publishes events of a run to the watches. Failing to
+// publish events does not fail the changes of the run, so errors are only logged.
+func (r *ResourceManager) publishRunEvents(events ...*model.RunEvent) {
+	if len(events) == 0 {
+		return
+	}
+	if events[0].Namespace == "" && events[0].ExperimentId != "" {
+		// Runs may not record their namespace, which is the one of their experiment.
+		namespace, err := r.GetNamespaceFromExperimentId(events[0].ExperimentId)
+		if err != nil {
+			glog.Warningf("Failed to get the namespace of the events of run %v: %v", events[0].RunId, err)
+		}
+		for _, event := range events {
+			event.Namespace = namespace
+		}
+	}
+	if err := r.runEvents.publish(events); err != nil {
+		glog.Warningf("Failed to publish the events of run %v: %v", events[0].RunId, err)
+	}
+}
+
+// newRunEvent returns an event of a run.
+func newRunEvent(eventType model.RunEventType, run *model.Run) *model.RunEvent {
+	return &model.RunEvent{
+		Type:         eventType,
+		RunId:        run.UUID,
+		ExperimentId: run.ExperimentId,
+		Namespace:    run.Namespace,
+		State:        run.State.ToV2(),
+	}
+}
+
+// newTaskEvents returns the events of the tasks of a run created or changed since
+// a previous runtime manifest of the run, in the order in which the tasks started.
+func newTaskEvents(run *model.Run, previousManifest string, execSpec util.ExecutionSpec) []*model.RunEvent {
+	previousStates := make(map[string]model.RuntimeState)
+	if previousManifest != "" {
+		previous, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(previousManifest))
+		if err != nil {
+			glog.Warningf("Failed to read the previous tasks of run %v: %v", run.UUID, err)
+		} else {
+			for id, node := range previous.ExecutionStatus().NodeStatuses() {
+				previousStates[id] = model.RuntimeState(node.State).ToV2()
+			}
+		}
+	}
+	var nodes []util.NodeStatus
+	for _, node := range execSpec.ExecutionStatus().NodeStatuses() {
+		if node.Type == util.NodeTypePod {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].StartTime != nodes[j].StartTime {
+			return nodes[i].StartTime < nodes[j].StartTime
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	var events []*model.RunEvent
+	for _, node := range nodes {
+		state := model.RuntimeState(node.State).ToV2()
+		eventType := model.RunEventTypeTaskCreated
+		if previousState, ok := previousStates[node.ID]; ok {
+			if previousState == state {
+				continue
+			}
+			eventType = model.RunEventTypeTaskStateChanged
+		}
+		event := newRunEvent(eventType, run)
+		event.TaskId = node.ID
+		event.TaskName = node.DisplayName
+		event.State = state
+		events = append(events, event)
+	}
+	return events
+}
+
+// runEventHub publishes run events to the watches. The last events are kept in
+// a bounded ring in memory, and all the events are stored in the DB until they
+// expire, so that watches falling behind the ring can resume from the DB.
+//
+// The sequence numbers of the events are assigned by the DB, so that the events
+// published by several API server replicas have distinct sequence numbers. The
+// ring only holds consecutive events: it restarts when the events of another
+// replica come in between, which are then read from the DB. The events are
+// stored without holding the lock, so concurrent publications may also be
+// added to the ring out of order, which restarts it too.
+type runEventHub struct {
+	store      storage.RunEventStoreInterface
+	time       util.TimeInterface
+	bufferSize int
+	retention  time.Duration
+
+	mu sync.Mutex
+	// loaded is true once the last sequence number was read from the DB.
+	loaded bool
+	// sequence is the last sequence number known to the hub.
+	sequence int64
+	// ring holds the last events, with consecutive sequence numbers.
+	ring        []*model.RunEvent
+	prunedAt    time.Time
+	subscribers map[chan struct{}]bool
+}
+
+func newRunEventHub(store storage.RunEventStoreInterface, time util.TimeInterface, bufferSize int, retention time.Duration) *runEventHub {
+	return &runEventHub{
+		store:       store,
+		time:        time,
+		bufferSize:  bufferSize,
+		retention:   retention,
+		subscribers: make(map[chan struct{}]bool),
+	}
+}
+
+// newRunEventHubFromConfig returns a hub configured by the API server config.
+// The events are timed with the real time, since they only expire.
+func newRunEventHubFromConfig(store storage.RunEventStoreInterface) *runEventHub {
+	return newRunEventHub(store, util.NewRealTime(), common.GetRunEventsBufferSize(), common.GetRunEventsRetention())
+}
+
+// subscribe returns a channel notified when events are published.
+func (h *runEventHub) subscribe() chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	notify := make(chan struct{}, 1)
+	h.subscribers[notify] = true
+	return notify
+}
+
+func (h *runEventHub) unsubscribe(notify chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, notify)
+}
+
+// load reads the last sequence number from the DB. It must be called with the
+// lock held.
+func (h *runEventHub) load() error {
+	if h.loaded {
+		return nil
+	}
+	_, last, err := h.store.GetRunEventSequenceRange()
+	if err != nil {
+		return err
+	}
+	h.sequence = last
+	h.loaded = true
+	return nil
+}
+
+// lastSequence returns the sequence number of the last stored event, which
+// may have been published by another replica.
+func (h *runEventHub) lastSequence() (int64, error) {
+	_, last, err := h.store.GetRunEventSequenceRange()
+	if err != nil {
+		return 0, err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if last > h.sequence {
+		h.sequence = last
+	}
+	h.loaded = true
+	return h.sequence, nil
+}
+
+// publish stores events, which assigns their sequence numbers, and notifies
+// the subscribers. Events failing to be stored are dropped.
+func (h *runEventHub) publish(events []*model.RunEvent) error {
+	now := h.time.Now()
+	var stored []*model.RunEvent
+	var storeErr error
+	for _, event := range events {
+		event.CreatedAtInSec = now.Unix()
+		if err := h.store.CreateRunEvent(event); err != nil {
+			if storeErr == nil {
+				storeErr = err
+			}
+			continue
+		}
+		stored = append(stored, event)
+	}
+
+	h.mu.Lock()
+	for _, event := range stored {
+		if len(h.ring) > 0 && event.Sequence != h.ring[len(h.ring)-1].Sequence+1 {
+			if event.Sequence < h.ring[len(h.ring)-1].Sequence {
+				// A concurrent publication added later events to the ring already.
+				continue
+			}
+			// Another replica or publication stored the events in between.
+			h.ring = nil
+		}
+		h.ring = append(h.ring, event)
+		if event.Sequence > h.sequence {
+			h.sequence = event.Sequence
+		}
+	}
+	if len(h.ring) > h.bufferSize {
+		h.ring = append([]*model.RunEvent(nil), h.ring[len(h.ring)-h.bufferSize:]...)
+	}
+	for notify := range h.subscribers {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+	prune := h.retention > 0 && now.Sub(h.prunedAt) >= runEventPruneInterval
+	if prune {
+		h.prunedAt = now
+	}
+	h.mu.Unlock()
+
+	if prune {
+		if err := h.store.DeleteRunEventsBefore(now.Add(-h.retention).Unix()); err != nil {
+			glog.Warningf("Failed to delete the expired run events: %v", err)
+		}
+	}
+	return storeErr
+}
+
+// eventsAfter returns at most limit events following a sequence number, from
+// the ring if it still holds them, else from the DB.
+func (h *runEventHub) eventsAfter(sequence int64, limit int) ([]*model.RunEvent, error) {
+	h.mu.Lock()
+	if err := h.load(); err != nil {
+		h.mu.Unlock()
+		return nil, err
+	}
+	if sequence == h.sequence {
+		h.mu.Unlock()
+		return nil, nil
+	}
+	if len(h.ring) > 0 && h.ring[0].Sequence <= sequence+1 && sequence < h.ring[len(h.ring)-1].Sequence {
+		start := int(sequence + 1 - h.ring[0].Sequence)
+		end := start + limit
+		if end > len(h.ring) {
+			end = len(h.ring)
+		}
+		events := append([]*model.RunEvent(nil), h.ring[start:end]...)
+		h.mu.Unlock()
+		return events, nil
+	}
+	h.mu.Unlock()
+
+	first, last, err := h.store.GetRunEventSequenceRange()
+	if err != nil {
+		return nil, err
+	}
+	if sequence > last {
+		return nil, util.NewInvalidInputError("Resume token %v is ahead of the last run event %v", sequence, last)
+	}
+	if sequence == last {
+		return nil, nil
+	}
+	if first > sequence+1 {
+		return nil, util.NewFailedPreconditionError(errors.New("run events expired"),
+			"Run events after resume token %v expired. Watch the runs again without a resume token", sequence)
+	}
+	return h.store.ListRunEvents(sequence, limit)
+}
@@ -0,0 +1,336 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// watchRuns returns the first count events of a watch of the runs.
+func watchRuns(t *testing.T, manager *ResourceManager, opts *RunWatchOptions, count int) []*model.RunEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var events []*model.RunEvent
+	err := manager.WatchRuns(ctx, opts, func(event *model.RunEvent) error {
+		if len(events) < count {
+			events = append(events, event)
+		}
+		if len(events) == count {
+			cancel()
+		}
+		return nil
+	})
+	require.Equal(t, context.Canceled, err)
+	return events
+}
+
+type runEventSummary struct {
+	Type     model.RunEventType
+	TaskName string
+	State    model.RuntimeState
+}
+
+func summarizeRunEvents(events []*model.RunEvent) []runEventSummary {
+	var summaries []runEventSummary
+	for _, event := range events {
+		summaries = append(summaries, runEventSummary{Type: event.Type, TaskName: event.TaskName, State: event.State})
+	}
+	return summaries
+}
+
+func TestWatchRuns_RunLifecycle(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+
+	node := v1alpha1.NodeStatus{ID: "node-1", DisplayName: "train", Phase: v1alpha1.NodeRunning}
+	reportRunLogWorkflow(t, manager, run.UUID, time.Time{}, node)
+	node.Phase = v1alpha1.NodeSucceeded
+	reportRunLogWorkflow(t, manager, run.UUID, time.Time{}, node)
+	require.Nil(t, manager.TerminateRun(context.Background(), run.UUID))
+	require.Nil(t, manager.DeleteRun(context.Background(), run.UUID))
+
+	events := watchRuns(t, manager, &RunWatchOptions{IncludeTasks: true, ResumeToken: "0"}, 5)
+	assert.Equal(t, []runEventSummary{
+		{Type: model.RunEventTypeRunCreated, State: model.RuntimeStatePending},
+		{Type: model.RunEventTypeRunStateChanged, State: model.RuntimeStateUnspecified},
+		{Type: model.RunEventTypeTaskCreated, TaskName: "train", State: model.RuntimeStateRunning},
+		{Type: model.RunEventTypeTaskStateChanged, TaskName: "train", State: model.RuntimeStateSucceeded},
+		{Type: model.RunEventTypeRunStateChanged, State: model.RuntimeStateCancelling},
+	}, summarizeRunEvents(events))
+	for i, event := range events {
+		assert.Equal(t, int64(i+1), event.Sequence)
+		assert.Equal(t, run.UUID, event.RunId)
+		assert.Equal(t, run.ExperimentId, event.ExperimentId)
+		assert.Equal(t, "ns1", event.Namespace)
+	}
+	assert.Equal(t, "node-1", events[2].TaskId)
+
+	// Resumes after the last event, without the task events.
+	events = watchRuns(t, manager, &RunWatchOptions{ResumeToken: RunEventResumeToken(events[4])}, 1)
+	assert.Equal(t, []runEventSummary{
+		{Type: model.RunEventTypeRunDeleted, State: model.RuntimeStateCancelling},
+	}, summarizeRunEvents(events))
+}
+
+func TestWatchRuns_NewEvents(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+
+	done := make(chan []*model.RunEvent)
+	go func() {
+		done <- watchRuns(t, manager, &RunWatchOptions{RunIds: []string{run.UUID}}, 1)
+	}()
+	// Waits for the watch to start after the creation of the run.
+	require.Eventually(t, func() bool {
+		manager.runEvents.mu.Lock()
+		defer manager.runEvents.mu.Unlock()
+		return len(manager.runEvents.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Nil(t, manager.TerminateRun(context.Background(), run.UUID))
+
+	events := <-done
+	assert.Equal(t, []runEventSummary{
+		{Type: model.RunEventTypeRunStateChanged, State: model.RuntimeStateCancelling},
+	}, summarizeRunEvents(events))
+}
+
+func TestWatchRuns_InvalidResumeToken(t *testing.T) {
+	store, manager, _ := initWithOneTimeRun(t)
+	defer store.Close()
+
+	for _, token := range []string{"abc", "-1", "2"} {
+		err := manager.WatchRuns(context.Background(), &RunWatchOptions{ResumeToken: token}, func(event *model.RunEvent) error {
+			return nil
+		})
+		require.NotNil(t, err, token)
+		assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode(), token)
+	}
+}
+
+func TestRunWatchOptions_Matches(t *testing.T) {
+	runEvent := &model.RunEvent{Type: model.RunEventTypeRunCreated, RunId: "run1", ExperimentId: "exp1", Namespace: "ns1"}
+	taskEvent := &model.RunEvent{Type: model.RunEventTypeTaskCreated, RunId: "run1", ExperimentId: "exp1", Namespace: "ns1", TaskId: "node-1"}
+	tests := []struct {
+		name      string
+		opts      RunWatchOptions
+		runEvent  bool
+		taskEvent bool
+	}{
+		{"all runs", RunWatchOptions{}, true, false},
+		{"tasks", RunWatchOptions{IncludeTasks: true}, true, true},
+		{"namespace", RunWatchOptions{Namespace: "ns1", IncludeTasks: true}, true, true},
+		{"other namespace", RunWatchOptions{Namespace: "ns2", IncludeTasks: true}, false, false},
+		{"experiment", RunWatchOptions{ExperimentId: "exp1"}, true, false},
+		{"other experiment", RunWatchOptions{ExperimentId: "exp2"}, false, false},
+		{"runs", RunWatchOptions{RunIds: []string{"run2", "run1"}}, true, false},
+		{"other runs", RunWatchOptions{RunIds: []string{"run2"}, IncludeTasks: true}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.runEvent, tt.opts.matches(runEvent))
+			assert.Equal(t, tt.taskEvent, tt.opts.matches(taskEvent))
+		})
+	}
+}
+
+func TestRunEventHub_ResumeFromStore(t *testing.T) {
+	db := storage.NewFakeDBOrFatal()
+	defer db.Close()
+	eventStore := storage.NewRunEventStore(db)
+	hub := newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 2, 0)
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, hub.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run1"}}))
+	}
+	assert.Len(t, hub.ring, 2)
+
+	// Reads the events evicted from the ring from the store.
+	events, err := hub.eventsAfter(0, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 5)
+	assert.Equal(t, int64(1), events[0].Sequence)
+	events, err = hub.eventsAfter(1, 2)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(2), events[0].Sequence)
+
+	// Reads the last events from the ring.
+	events, err = hub.eventsAfter(3, 10)
+	require.Nil(t, err)
+	assert.Equal(t, hub.ring, events)
+	events, err = hub.eventsAfter(5, 10)
+	require.Nil(t, err)
+	assert.Empty(t, events)
+
+	// A new hub continues the sequence of the stored events.
+	hub = newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 2, 0)
+	event := &model.RunEvent{Type: model.RunEventTypeRunDeleted, RunId: "run1"}
+	require.Nil(t, hub.publish([]*model.RunEvent{event}))
+	assert.Equal(t, int64(6), event.Sequence)
+}
+
+func TestRunEventHub_ExpiredEvents(t *testing.T) {
+	db := storage.NewFakeDBOrFatal()
+	defer db.Close()
+	eventStore := storage.NewRunEventStore(db)
+	hub := newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 1, time.Minute)
+
+	require.Nil(t, hub.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run1"}}))
+	require.Nil(t, hub.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run2"}}))
+	// Publishing after the retention deletes the expired events.
+	hub.prunedAt = time.Time{}
+	hub.time = util.NewFakeTime(time.Unix(3600, 0))
+	require.Nil(t, hub.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run3"}}))
+
+	_, err := hub.eventsAfter(0, 10)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	events, err := hub.eventsAfter(2, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "run3", events[0].RunId)
+}
+
+func TestRunEventHub_Replicas(t *testing.T) {
+	db := storage.NewFakeDBOrFatal()
+	defer db.Close()
+	eventStore := storage.NewRunEventStore(db)
+	hub1 := newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 10, 0)
+	hub2 := newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 10, 0)
+
+	// The hubs of two API server replicas publish events in turn.
+	require.Nil(t, hub1.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run1"}}))
+	require.Nil(t, hub2.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run2"}}))
+	require.Nil(t, hub1.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: "run3"}}))
+
+	// The first hub reads the events of the other replica from the store.
+	events, err := hub1.eventsAfter(0, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 3)
+	for i, event := range events {
+		assert.Equal(t, int64(i+1), event.Sequence)
+	}
+	assert.Equal(t, "run2", events[1].RunId)
+	events, err = hub1.eventsAfter(2, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "run3", events[0].RunId)
+
+	// The second hub sees the last event of the first one.
+	last, err := hub2.lastSequence()
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), last)
+	events, err = hub2.eventsAfter(2, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "run3", events[0].RunId)
+}
+
+func TestRunEventHub_SequenceAfterPruning(t *testing.T) {
+	db := storage.NewFakeDBOrFatal()
+	defer db.Close()
+	eventStore := storage.NewRunEventStore(db)
+	hub := newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 10, 0)
+	require.Nil(t, hub.publish([]*model.RunEvent{
+		{Type: model.RunEventTypeRunCreated, RunId: "run1"},
+		{Type: model.RunEventTypeRunCreated, RunId: "run2"},
+	}))
+	require.Nil(t, eventStore.DeleteRunEventsBefore(time.Unix(3600, 0).Unix()))
+
+	// A new hub does not reuse the sequence numbers of the pruned events.
+	hub = newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 10, 0)
+	_, err := hub.eventsAfter(0, 10)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	event := &model.RunEvent{Type: model.RunEventTypeRunDeleted, RunId: "run1"}
+	require.Nil(t, hub.publish([]*model.RunEvent{event}))
+	assert.Equal(t, int64(3), event.Sequence)
+}
+
+// lateRunEventStore hides the events whose transactions are not committed yet.
+type lateRunEventStore struct {
+	storage.RunEventStoreInterface
+	mu          sync.Mutex
+	uncommitted map[int64]bool
+}
+
+func (s *lateRunEventStore) commit(sequence int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.uncommitted, sequence)
+}
+
+func (s *lateRunEventStore) ListRunEvents(afterSequence int64, limit int) ([]*model.RunEvent, error) {
+	events, err := s.RunEventStoreInterface.ListRunEvents(afterSequence, limit)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var committed []*model.RunEvent
+	for _, event := range events {
+		if !s.uncommitted[event.Sequence] {
+			committed = append(committed, event)
+		}
+	}
+	return committed, err
+}
+
+func TestWatchRuns_LateEvents(t *testing.T) {
+	defer func(interval, timeout time.Duration) {
+		runEventPollInterval, runEventGapTimeout = interval, timeout
+	}(runEventPollInterval, runEventGapTimeout)
+	runEventPollInterval = 10 * time.Millisecond
+	runEventGapTimeout = time.Hour
+	db := storage.NewFakeDBOrFatal()
+	defer db.Close()
+	eventStore := &lateRunEventStore{RunEventStoreInterface: storage.NewRunEventStore(db), uncommitted: map[int64]bool{2: true}}
+	// The events are read from the store, without a ring.
+	manager := &ResourceManager{runEvents: newRunEventHub(eventStore, util.NewFakeTimeForEpoch(), 0, 0)}
+	for _, runId := range []string{"run1", "run2", "run3"} {
+		require.Nil(t, manager.runEvents.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: runId}}))
+	}
+
+	// The watch waits for the event committed late.
+	done := make(chan []*model.RunEvent)
+	go func() {
+		done <- watchRuns(t, manager, &RunWatchOptions{ResumeToken: "0"}, 3)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	eventStore.commit(2)
+	var runIds []string
+	for _, event := range <-done {
+		runIds = append(runIds, event.RunId)
+	}
+	assert.Equal(t, []string{"run1", "run2", "run3"}, runIds)
+
+	// The missing events are skipped after runEventGapTimeout.
+	runEventGapTimeout = 5 * time.Second
+	eventStore.uncommitted[4] = true
+	for _, runId := range []string{"run4", "run5"} {
+		require.Nil(t, manager.runEvents.publish([]*model.RunEvent{{Type: model.RunEventTypeRunCreated, RunId: runId}}))
+	}
+	events := watchRuns(t, manager, &RunWatchOptions{ResumeToken: "3"}, 1)
+	require.Len(t, events, 1)
+	assert.Equal(t, "run5", events[0].RunId)
+}
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
@@ -26,12 +28,15 @@ import (
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Converts API experiment to its internal representation.
@@ -688,6 +693,9 @@ func toModelTrigger(t interface{}) (*model.Trigger, error) {
 			if cronSchedule.EndTime != nil {
 				modelTrigger.CronScheduleEndTimeInSec = &cronSchedule.EndTime.Seconds
 			}
+			if cronSchedule.TimeZone != "" {
+				modelTrigger.CronScheduleTimeZone = &cronSchedule.TimeZone
+			}
 		}
 		if apiTrigger.GetPeriodicSchedule() != nil {
 			periodicSchedule := apiTrigger.GetPeriodicSchedule()
@@ -701,6 +709,25 @@ func toModelTrigger(t interface{}) (*model.Trigger, error) {
 				modelTrigger.PeriodicScheduleEndTimeInSec = &periodicSchedule.EndTime.Seconds
 			}
 		}
+		if apiTrigger.GetObjectStoreTrigger() != nil {
+			objectStoreTrigger := apiTrigger.GetObjectStoreTrigger()
+			modelTrigger.ObjectStoreTrigger = model.ObjectStoreTrigger{
+				ObjectStoreTriggerURI:                &objectStoreTrigger.Uri,
+				ObjectStoreTriggerPollIntervalSecond: &objectStoreTrigger.PollIntervalSecond,
+			}
+		}
+		if apiTrigger.GetRunCompletionTrigger() != nil {
+			runCompletionTrigger := apiTrigger.GetRunCompletionTrigger()
+			states := make([]string, 0)
+			for _, state := range runCompletionTrigger.GetStates() {
+				states = append(states, state.String())
+			}
+			modelTrigger.RunCompletionTrigger = model.RunCompletionTrigger{
+				RunCompletionTriggerExperimentId: &runCompletionTrigger.ExperimentId,
+				RunCompletionTriggerPipelineId:   &runCompletionTrigger.PipelineId,
+				RunCompletionTriggerStates:       util.StringPointer(strings.Join(states, ",")),
+			}
+		}
 	case *apiv1beta1.Trigger:
 		if apiTrigger.GetCronSchedule() != nil {
 			cronSchedule := apiTrigger.GetCronSchedule()
@@ -793,6 +820,9 @@ func toApiTrigger(trigger *model.Trigger) *apiv2beta1.Trigger {
 				Seconds: *trigger.CronScheduleEndTimeInSec,
 			}
 		}
+		if trigger.CronScheduleTimeZone != nil {
+			cronSchedule.TimeZone = *trigger.CronScheduleTimeZone
+		}
 		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &cronSchedule}}
 	}
 	if trigger.IntervalSecond != nil && *trigger.IntervalSecond != 0 {
@@ -810,12 +840,93 @@ func toApiTrigger(trigger *model.Trigger) *apiv2beta1.Trigger {
 		}
 		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &periodicSchedule}}
 	}
+	if trigger.ObjectStoreTriggerURI != nil && *trigger.ObjectStoreTriggerURI != "" {
+		var objectStoreTrigger apiv2beta1.ObjectStoreTrigger
+		objectStoreTrigger.Uri = *trigger.ObjectStoreTriggerURI
+		if trigger.ObjectStoreTriggerPollIntervalSecond != nil {
+			objectStoreTrigger.PollIntervalSecond = *trigger.ObjectStoreTriggerPollIntervalSecond
+		}
+		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_ObjectStoreTrigger{ObjectStoreTrigger: &objectStoreTrigger}}
+	}
+	if trigger.RunCompletionTriggerExperimentId != nil && *trigger.RunCompletionTriggerExperimentId != "" {
+		var runCompletionTrigger apiv2beta1.RunCompletionTrigger
+		runCompletionTrigger.ExperimentId = *trigger.RunCompletionTriggerExperimentId
+		if trigger.RunCompletionTriggerPipelineId != nil {
+			runCompletionTrigger.PipelineId = *trigger.RunCompletionTriggerPipelineId
+		}
+		if trigger.RunCompletionTriggerStates != nil && *trigger.RunCompletionTriggerStates != "" {
+			for _, state := range strings.Split(*trigger.RunCompletionTriggerStates, ",") {
+				runCompletionTrigger.States = append(runCompletionTrigger.States, apiv2beta1.RuntimeState(apiv2beta1.RuntimeState_value[state]))
+			}
+		}
+		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_RunCompletionTrigger{RunCompletionTrigger: &runCompletionTrigger}}
+	}
 	if trigger.IntervalSecond == nil && trigger.Cron == nil {
 		return &apiv2beta1.Trigger{}
 	}
 	return nil
 }
 
+// Validates the schedule of a recurring run.
+func validateTrigger(trigger *model.Trigger) error {
+	if trigger.CronSchedule.Cron != nil {
+		if _, err := cron.Parse(*trigger.CronSchedule.Cron); err != nil {
+			return util.NewInvalidInputError(
+				"Schedule cron is not a supported format(https://godoc.org/github.com/robfig/cron). Error: %v", err)
+		}
+	}
+	if trigger.CronSchedule.CronScheduleTimeZone != nil {
+		if _, err := time.LoadLocation(*trigger.CronSchedule.CronScheduleTimeZone); err != nil {
+			return util.NewInvalidInputError(
+				"Schedule time zone is not a valid IANA time zone. Error: %v", err)
+		}
+	}
+	if trigger.PeriodicSchedule.IntervalSecond != nil {
+		if *trigger.PeriodicSchedule.IntervalSecond < 1 {
+			return util.NewInvalidInputError(
+				"Found invalid period schedule interval %v. Set at interval to least 1 second", *trigger.PeriodicSchedule.IntervalSecond)
+		}
+	}
+	return validateEventTrigger(trigger)
+}
+
+// Validates the object store and run completion triggers of a recurring run.
+func validateEventTrigger(trigger *model.Trigger) error {
+	if trigger.ObjectStoreTriggerURI != nil {
+		uri := *trigger.ObjectStoreTriggerURI
+		if _, err := objectstore.ParseBucketConfigForArtifactURI(uri); err != nil {
+			return util.NewInvalidInputError("Object store trigger has an invalid URI %q: %v", uri, err)
+		}
+		if interval := trigger.ObjectStoreTriggerPollIntervalSecond; interval != nil && *interval < 0 {
+			return util.NewInvalidInputError("Found invalid object store trigger poll interval %v. Set the interval to at least 1 second, or 0 for the default", *interval)
+		}
+	}
+	if trigger.RunCompletionTriggerExperimentId != nil {
+		if *trigger.RunCompletionTriggerExperimentId == "" {
+			return util.NewInvalidInputError("Run completion trigger must specify an experiment id")
+		}
+		// The controller selects the runs by their labels.
+		if errs := validation.IsValidLabelValue(*trigger.RunCompletionTriggerExperimentId); len(errs) > 0 {
+			return util.NewInvalidInputError("Run completion trigger has an invalid experiment id %q: %v",
+				*trigger.RunCompletionTriggerExperimentId, strings.Join(errs, "; "))
+		}
+		if pipelineId := trigger.RunCompletionTriggerPipelineId; pipelineId != nil && *pipelineId != "" {
+			if errs := validation.IsValidLabelValue(*pipelineId); len(errs) > 0 {
+				return util.NewInvalidInputError("Run completion trigger has an invalid pipeline id %q: %v",
+					*pipelineId, strings.Join(errs, "; "))
+			}
+		}
+		if trigger.RunCompletionTriggerStates != nil && *trigger.RunCompletionTriggerStates != "" {
+			for _, state := range strings.Split(*trigger.RunCompletionTriggerStates, ",") {
+				if state != apiv2beta1.RuntimeState_SUCCEEDED.String() && state != apiv2beta1.RuntimeState_FAILED.String() {
+					return util.NewInvalidInputError("Run completion trigger only supports SUCCEEDED and FAILED states. Received %v", state)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Converts an array of API resource references to an array of their internal representations.
 // Supports v1beta1 API.
 // Note: avoid using reference resource name. Use resource's UUID instead.
@@ -1803,8 +1914,9 @@ func toModelJob(j interface{}) (*model.Job, error) {
 	}
 	var jobId, jobName, k8sName, namespace, serviceAcc, desc, experimentId, pipelineName string
 	var pipelineId, pipelineVersionId, pipelineSpec, workflowSpec, specParams, cfgParams, pipelineRoot string
-	var maxConcur, createTime, updateTime int64
-	var noCatchup, isEnabled bool
+	var concurrencyPolicy, failureWebhookURL string
+	var maxConcur, maxConsecutiveFailures, createTime, updateTime int64
+	var noCatchup, isEnabled, useLatestPipelineVersion bool
 	var trigger *model.Trigger
 	resRefs := make([]*model.ResourceReference, 0)
 	switch apiJob := j.(type) {
@@ -1868,6 +1980,10 @@ func toModelJob(j interface{}) (*model.Job, error) {
 	case *apiv2beta1.RecurringRun:
 		pipelineId = apiJob.GetPipelineVersionReference().GetPipelineId()
 		pipelineVersionId = apiJob.GetPipelineVersionReference().GetPipelineVersionId()
+		if apiJob.GetPipelineId() != "" {
+			pipelineId = apiJob.GetPipelineId()
+			useLatestPipelineVersion = true
+		}
 		if spec, err := pipelineSpecStructToYamlString(apiJob.GetPipelineSpec()); err == nil {
 			pipelineSpec = spec
 		} else {
@@ -1895,6 +2011,14 @@ func toModelJob(j interface{}) (*model.Job, error) {
 		if err != nil {
 			return nil, util.Wrap(err, "Failed to convert a API recurring run to its internal representation due to parsing error occurred in its mode field")
 		}
+		concurrencyPolicy, err = toModelConcurrencyPolicy(apiJob.GetConcurrencyPolicy())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to convert a API recurring run to its internal representation due to parsing error occurred in its concurrency policy field")
+		}
+		maxConsecutiveFailures, failureWebhookURL, err = toModelFailurePolicy(apiJob.GetFailurePolicy())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to convert a API recurring run to its internal representation due to parsing error occurred in its failure policy field")
+		}
 
 		jobId = apiJob.GetRecurringRunId()
 		desc = apiJob.GetDescription()
@@ -1915,16 +2039,9 @@ func toModelJob(j interface{}) (*model.Job, error) {
 	if maxConcur > 10 || maxConcur < 1 {
 		return nil, util.NewInvalidInputError("Max concurrency of a recurring run must be at leas 1 and at most 10. Received %v", maxConcur)
 	}
-	if trigger != nil && trigger.CronSchedule.Cron != nil {
-		if _, err := cron.Parse(*trigger.CronSchedule.Cron); err != nil {
-			return nil, util.NewInvalidInputError(
-				"Schedule cron is not a supported format(https://godoc.org/github.com/robfig/cron). Error: %v", err)
-		}
-	}
-	if trigger != nil && trigger.PeriodicSchedule.IntervalSecond != nil {
-		if *trigger.PeriodicSchedule.IntervalSecond < 1 {
-			return nil, util.NewInvalidInputError(
-				"Found invalid period schedule interval %v. Set at interval to least 1 second", *trigger.PeriodicSchedule.IntervalSecond)
+	if trigger != nil {
+		if err := validateTrigger(trigger); err != nil {
+			return nil, err
 		}
 	}
 	if namespace != "" && pipelineVersionId != "" {
@@ -1939,21 +2056,25 @@ func toModelJob(j interface{}) (*model.Job, error) {
 		status = model.StatusStateDisabled
 	}
 	return &model.Job{
-		UUID:               jobId,
-		DisplayName:        jobName,
-		K8SName:            k8sName,
-		Namespace:          namespace,
-		ServiceAccount:     serviceAcc,
-		Description:        desc,
-		MaxConcurrency:     maxConcur,
-		NoCatchup:          noCatchup,
-		CreatedAtInSec:     createTime,
-		UpdatedAtInSec:     updateTime,
-		Enabled:            isEnabled,
-		Conditions:         status.ToString(),
-		ExperimentId:       experimentId,
-		ResourceReferences: resRefs,
-		Trigger:            *trigger,
+		UUID:                     jobId,
+		DisplayName:              jobName,
+		K8SName:                  k8sName,
+		Namespace:                namespace,
+		ServiceAccount:           serviceAcc,
+		Description:              desc,
+		MaxConcurrency:           maxConcur,
+		NoCatchup:                noCatchup,
+		ConcurrencyPolicy:        concurrencyPolicy,
+		MaxConsecutiveFailures:   maxConsecutiveFailures,
+		FailureWebhookURL:        failureWebhookURL,
+		UseLatestPipelineVersion: useLatestPipelineVersion,
+		CreatedAtInSec:           createTime,
+		UpdatedAtInSec:           updateTime,
+		Enabled:                  isEnabled,
+		Conditions:               status.ToString(),
+		ExperimentId:             experimentId,
+		ResourceReferences:       resRefs,
+		Trigger:                  *trigger,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:           pipelineId,
 			PipelineName:         pipelineName,
@@ -1999,6 +2120,84 @@ func toModelJobEnabled(m interface{}) (bool, error) {
 	}
 }
 
+// Converts API recurring run's concurrency policy to its internal representation.
+// Supports v2beta1 API.
+// Note: returns an empty string, i.e. Allow, if the policy is unspecified.
+func toModelConcurrencyPolicy(p apiv2beta1.RecurringRun_ConcurrencyPolicy) (string, error) {
+	switch p {
+	case apiv2beta1.RecurringRun_CONCURRENCY_POLICY_UNSPECIFIED:
+		return "", nil
+	case apiv2beta1.RecurringRun_ALLOW:
+		return string(swapi.AllowConcurrent), nil
+	case apiv2beta1.RecurringRun_FORBID:
+		return string(swapi.ForbidConcurrent), nil
+	case apiv2beta1.RecurringRun_REPLACE:
+		return string(swapi.ReplaceConcurrent), nil
+	case apiv2beta1.RecurringRun_QUEUE:
+		return string(swapi.QueueConcurrent), nil
+	default:
+		return "", util.NewInvalidInputError("Recurring run's concurrency policy is invalid: %v", p)
+	}
+}
+
+// Converts internal recurring run's concurrency policy to API counterpart.
+// Supports v2beta1 API.
+// Note: returns CONCURRENCY_POLICY_UNSPECIFIED by default.
+func toApiConcurrencyPolicy(p string) apiv2beta1.RecurringRun_ConcurrencyPolicy {
+	switch swapi.ConcurrencyPolicy(p) {
+	case swapi.AllowConcurrent:
+		return apiv2beta1.RecurringRun_ALLOW
+	case swapi.ForbidConcurrent:
+		return apiv2beta1.RecurringRun_FORBID
+	case swapi.ReplaceConcurrent:
+		return apiv2beta1.RecurringRun_REPLACE
+	case swapi.QueueConcurrent:
+		return apiv2beta1.RecurringRun_QUEUE
+	default:
+		return apiv2beta1.RecurringRun_CONCURRENCY_POLICY_UNSPECIFIED
+	}
+}
+
+// The largest number of consecutive failed runs after which a recurring run can be disabled.
+const maxMaxConsecutiveFailures = 100
+
+// Converts API recurring run's failure policy to its internal representation.
+// Supports v2beta1 API.
+// Note: returns 0 and an empty string, i.e. no failure policy, if the policy is unspecified.
+func toModelFailurePolicy(p *apiv2beta1.FailurePolicy) (int64, string, error) {
+	if p == nil {
+		return 0, "", nil
+	}
+	if p.GetMaxConsecutiveFailures() < 0 || p.GetMaxConsecutiveFailures() > maxMaxConsecutiveFailures {
+		return 0, "", util.NewInvalidInputError("Max consecutive failures of a recurring run must be at least 0 and at most %v. Received %v", maxMaxConsecutiveFailures, p.GetMaxConsecutiveFailures())
+	}
+	if webhookURL := p.GetWebhookUrl(); webhookURL != "" {
+		// The controller posts to the webhook from the cluster, so it's restricted
+		// like the targets of notification subscriptions.
+		policy, err := util.NewTargetPolicy(common.GetNotificationAllowedNetworks())
+		if err != nil {
+			return 0, "", util.NewInternalServerError(err, "Invalid %v config", common.NotificationAllowedNetworks)
+		}
+		if err := policy.ValidateTargetURL(webhookURL); err != nil {
+			return 0, "", util.Wrap(err, "Invalid webhook URL of a recurring run")
+		}
+	}
+	return int64(p.GetMaxConsecutiveFailures()), p.GetWebhookUrl(), nil
+}
+
+// Converts internal recurring run's failure policy to API counterpart.
+// Supports v2beta1 API.
+// Note: returns nil if the recurring run has no failure policy.
+func toApiFailurePolicy(j *model.Job) *apiv2beta1.FailurePolicy {
+	if j.MaxConsecutiveFailures == 0 && j.FailureWebhookURL == "" {
+		return nil
+	}
+	return &apiv2beta1.FailurePolicy{
+		MaxConsecutiveFailures: int32(j.MaxConsecutiveFailures),
+		WebhookUrl:             j.FailureWebhookURL,
+	}
+}
+
 // Converts internal recurring run's status to API counterpart.
 // Supports v2beta1 API.
 // Note: returns STATUS_UNSPECIFIED by default.
@@ -2174,22 +2373,29 @@ func toApiRecurringRun(j *model.Job) *apiv2beta1.RecurringRun {
 	}
 
 	apiRecurringRunV2 := &apiv2beta1.RecurringRun{
-		RecurringRunId: j.UUID,
-		DisplayName:    j.DisplayName,
-		ServiceAccount: j.ServiceAccount,
-		Description:    j.Description,
-		Status:         toApiRecurringRunStatus(j.Conditions),
-		CreatedAt:      &timestamp.Timestamp{Seconds: j.CreatedAtInSec},
-		UpdatedAt:      &timestamp.Timestamp{Seconds: j.UpdatedAtInSec},
-		MaxConcurrency: j.MaxConcurrency,
-		NoCatchup:      j.NoCatchup,
-		Trigger:        toApiTrigger(&j.Trigger),
-		RuntimeConfig:  runtimeConfig,
-		Namespace:      j.Namespace,
-		ExperimentId:   j.ExperimentId,
-	}
-
-	if j.PipelineSpec.PipelineVersionId == "" {
+		RecurringRunId:      j.UUID,
+		DisplayName:         j.DisplayName,
+		ServiceAccount:      j.ServiceAccount,
+		Description:         j.Description,
+		Status:              toApiRecurringRunStatus(j.Conditions),
+		CreatedAt:           &timestamp.Timestamp{Seconds: j.CreatedAtInSec},
+		UpdatedAt:           &timestamp.Timestamp{Seconds: j.UpdatedAtInSec},
+		MaxConcurrency:      j.MaxConcurrency,
+		NoCatchup:           j.NoCatchup,
+		ConcurrencyPolicy:   toApiConcurrencyPolicy(j.ConcurrencyPolicy),
+		FailurePolicy:       toApiFailurePolicy(j),
+		ConsecutiveFailures: j.ConsecutiveFailures,
+		Trigger:             toApiTrigger(&j.Trigger),
+		RuntimeConfig:       runtimeConfig,
+		Namespace:           j.Namespace,
+		ExperimentId:        j.ExperimentId,
+	}
+
+	if j.UseLatestPipelineVersion {
+		apiRecurringRunV2.PipelineSource = &apiv2beta1.RecurringRun_PipelineId{
+			PipelineId: j.PipelineSpec.PipelineId,
+		}
+	} else if j.PipelineSpec.PipelineVersionId == "" {
 		spec, err := yamlStringToPipelineSpecStruct(j.PipelineSpec.PipelineSpecManifest)
 		if err != nil {
 			return &apiv2beta1.RecurringRun{
@@ -2440,3 +2646,144 @@ func toApiRuntimeStatuses(s []*model.RuntimeStatus) []*apiv2beta1.RuntimeStatus
 	}
 	return statuses
 }
+
+// Converts an internal audit event representation to its API counterpart.
+// Supports v2beta1 API.
+func toApiAuditEvent(event *model.AuditEvent) *apiv2beta1.AuditEvent {
+	var resourceIds []string
+	if event.ResourceIds != "" {
+		resourceIds = strings.Split(event.ResourceIds, ",")
+	}
+	return &apiv2beta1.AuditEvent{
+		AuditEventId: event.UUID,
+		CreatedAt:    &timestamp.Timestamp{Seconds: event.CreatedAtInSec},
+		User:         event.User,
+		Method:       event.Method,
+		ResourceIds:  resourceIds,
+		Namespace:    event.Namespace,
+		Code:         event.Code,
+		ErrorMessage: event.ErrorMessage,
+	}
+}
+
+// Converts an array of internal audit event representations to an array of API audit events.
+// Supports v2beta1 API.
+func toApiAuditEvents(events []*model.AuditEvent) []*apiv2beta1.AuditEvent {
+	apiEvents := make([]*apiv2beta1.AuditEvent, 0)
+	for _, event := range events {
+		apiEvents = append(apiEvents, toApiAuditEvent(event))
+	}
+	return apiEvents
+}
+
+// Converts an entry of a run log stream to its API counterpart.
+// Supports v2beta1 API.
+func toApiRunLogEntry(entry *resource.RunLogStreamEntry) *apiv2beta1.RunLogEntry {
+	apiEntry := &apiv2beta1.RunLogEntry{
+		NodeId:   entry.NodeId,
+		TaskName: entry.TaskName,
+		Log:      entry.Log,
+		Cursor:   entry.Cursor,
+	}
+	if !entry.Timestamp.IsZero() {
+		apiEntry.Timestamp = timestamppb.New(entry.Timestamp)
+	}
+	return apiEntry
+}
+
+// Converts a run event to its API counterpart.
+// Supports v2beta1 API.
+func toApiRunEvent(event *model.RunEvent) *apiv2beta1.RunEvent {
+	return &apiv2beta1.RunEvent{
+		Type:         apiv2beta1.RunEvent_EventType(apiv2beta1.RunEvent_EventType_value[string(event.Type)]),
+		RunId:        event.RunId,
+		ExperimentId: event.ExperimentId,
+		Namespace:    event.Namespace,
+		TaskId:       event.TaskId,
+		TaskName:     event.TaskName,
+		State:        toApiRuntimeState(&event.State),
+		CreateTime:   timestamppb.New(time.Unix(event.CreatedAtInSec, 0)),
+		ResumeToken:  resource.RunEventResumeToken(event),
+	}
+}
+
+// Converts an API notification subscription to its internal representation.
+// Supports v2beta1 API.
+func toModelNotificationSubscription(s *apiv2beta1.NotificationSubscription) *model.NotificationSubscription {
+	subscription := &model.NotificationSubscription{
+		DisplayName:     s.GetDisplayName(),
+		Namespace:       s.GetNamespace(),
+		ExperimentId:    s.GetExperimentId(),
+		TargetURL:       s.GetTargetUrl(),
+		PayloadTemplate: s.GetPayloadTemplate(),
+		Secret:          s.GetSecret(),
+	}
+	states := make([]model.RuntimeState, 0, len(s.GetStates()))
+	for _, state := range s.GetStates() {
+		states = append(states, model.RuntimeState(state.String()))
+	}
+	subscription.SetStates(states)
+	return subscription
+}
+
+// Converts an internal notification subscription to its API counterpart,
+// without its secret.
+// Supports v2beta1 API.
+func toApiNotificationSubscription(s *model.NotificationSubscription) *apiv2beta1.NotificationSubscription {
+	var states []apiv2beta1.RuntimeState
+	for _, state := range s.GetStates() {
+		states = append(states, toApiRuntimeState(&state))
+	}
+	return &apiv2beta1.NotificationSubscription{
+		SubscriptionId:  s.UUID,
+		DisplayName:     s.DisplayName,
+		Namespace:       s.Namespace,
+		ExperimentId:    s.ExperimentId,
+		States:          states,
+		TargetUrl:       s.TargetURL,
+		PayloadTemplate: s.PayloadTemplate,
+		CreatedAt:       &timestamp.Timestamp{Seconds: s.CreatedAtInSec},
+	}
+}
+
+// Converts an array of internal notification subscriptions to an array of API
+// notification subscriptions.
+// Supports v2beta1 API.
+func toApiNotificationSubscriptions(subscriptions []*model.NotificationSubscription) []*apiv2beta1.NotificationSubscription {
+	apiSubscriptions := make([]*apiv2beta1.NotificationSubscription, 0)
+	for _, subscription := range subscriptions {
+		apiSubscriptions = append(apiSubscriptions, toApiNotificationSubscription(subscription))
+	}
+	return apiSubscriptions
+}
+
+// Converts an internal notification delivery to its API counterpart.
+// Supports v2beta1 API.
+func toApiNotificationDelivery(d *model.NotificationDelivery) *apiv2beta1.NotificationDelivery {
+	delivery := &apiv2beta1.NotificationDelivery{
+		DeliveryId:     d.UUID,
+		SubscriptionId: d.SubscriptionId,
+		RunId:          d.RunId,
+		State:          toApiRuntimeState(&d.State),
+		Status:         apiv2beta1.NotificationDelivery_Status(apiv2beta1.NotificationDelivery_Status_value[string(d.Status)]),
+		Attempts:       d.Attempts,
+		ResponseCode:   d.ResponseCode,
+		ErrorMessage:   d.ErrorMessage,
+		CreatedAt:      &timestamp.Timestamp{Seconds: d.CreatedAtInSec},
+	}
+	if d.FinishedAtInSec != 0 {
+		delivery.FinishedAt = &timestamp.Timestamp{Seconds: d.FinishedAtInSec}
+	}
+	return delivery
+}
+
+// Converts an array of internal notification deliveries to an array of API
+// notification deliveries.
+// Supports v2beta1 API.
+func toApiNotificationDeliveries(deliveries []*model.NotificationDelivery) []*apiv2beta1.NotificationDelivery {
+	apiDeliveries := make([]*apiv2beta1.NotificationDelivery, 0)
+	for _, delivery := range deliveries {
+		apiDeliveries = append(apiDeliveries, toApiNotificationDelivery(delivery))
+	}
+	return apiDeliveries
+}
@@ -571,6 +571,265 @@ func TestToModelJob(t *testing.T) {
 	}
 }
 
+func TestToModelTrigger_CronTimeZone(t *testing.T) {
+	apiTrigger := &apiv2beta1.Trigger{
+		Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+			StartTime: &timestamp.Timestamp{Seconds: 1},
+			Cron:      "0 0 2 * * *",
+			TimeZone:  "America/New_York",
+		}},
+	}
+	expectedModelTrigger := &model.Trigger{
+		CronSchedule: model.CronSchedule{
+			CronScheduleStartTimeInSec: util.Int64Pointer(1),
+			Cron:                       util.StringPointer("0 0 2 * * *"),
+			CronScheduleTimeZone:       util.StringPointer("America/New_York"),
+		},
+	}
+	modelTrigger, err := toModelTrigger(apiTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedModelTrigger, modelTrigger)
+	assert.Equal(t, apiTrigger, toApiTrigger(modelTrigger))
+}
+
+func TestToModelJob_InvalidTimeZone(t *testing.T) {
+	_, err := toModelJob(&apiv2beta1.RecurringRun{
+		DisplayName:    "name1",
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				Cron:     "0 0 2 * * *",
+				TimeZone: "Mars/Olympus_Mons",
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionId{PipelineVersionId: "pv1"},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Schedule time zone is not a valid IANA time zone")
+}
+
+func TestToModelJob_ConcurrencyPolicy(t *testing.T) {
+	tests := []struct {
+		apiPolicy   apiv2beta1.RecurringRun_ConcurrencyPolicy
+		modelPolicy string
+	}{
+		{apiv2beta1.RecurringRun_CONCURRENCY_POLICY_UNSPECIFIED, ""},
+		{apiv2beta1.RecurringRun_ALLOW, "Allow"},
+		{apiv2beta1.RecurringRun_FORBID, "Forbid"},
+		{apiv2beta1.RecurringRun_REPLACE, "Replace"},
+		{apiv2beta1.RecurringRun_QUEUE, "Queue"},
+	}
+	for _, tt := range tests {
+		modelJob, err := toModelJob(&apiv2beta1.RecurringRun{
+			DisplayName:       "name1",
+			MaxConcurrency:    1,
+			ConcurrencyPolicy: tt.apiPolicy,
+			Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{Cron: "0 0 2 * * *"}},
+			},
+			PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionId{PipelineVersionId: "pv1"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, tt.modelPolicy, modelJob.ConcurrencyPolicy)
+		assert.Equal(t, tt.apiPolicy, toApiConcurrencyPolicy(tt.modelPolicy))
+	}
+
+	_, err := toModelConcurrencyPolicy(apiv2beta1.RecurringRun_ConcurrencyPolicy(42))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "concurrency policy is invalid")
+}
+
+func TestToModelJob_FailurePolicy(t *testing.T) {
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		DisplayName:    "name1",
+		MaxConcurrency: 1,
+		FailurePolicy: &apiv2beta1.FailurePolicy{
+			MaxConsecutiveFailures: 3,
+			WebhookUrl:             "https://example.com/hook",
+		},
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{Cron: "0 0 2 * * *"}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionReference{
+			PipelineVersionReference: &apiv2beta1.PipelineVersionReference{PipelineId: "p1", PipelineVersionId: "pv1"},
+		},
+	}
+	modelJob, err := toModelJob(apiRecurringRun)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), modelJob.MaxConsecutiveFailures)
+	assert.Equal(t, "https://example.com/hook", modelJob.FailureWebhookURL)
+
+	modelJob.ConsecutiveFailures = 2
+	apiRecurringRun = toApiRecurringRun(modelJob)
+	assert.Equal(t, &apiv2beta1.FailurePolicy{MaxConsecutiveFailures: 3, WebhookUrl: "https://example.com/hook"},
+		apiRecurringRun.FailurePolicy)
+	assert.Equal(t, int64(2), apiRecurringRun.ConsecutiveFailures)
+
+	modelJob, err = toModelJob(&apiv2beta1.RecurringRun{
+		DisplayName:    "name1",
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{Cron: "0 0 2 * * *"}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionReference{
+			PipelineVersionReference: &apiv2beta1.PipelineVersionReference{PipelineId: "p1", PipelineVersionId: "pv1"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, toApiRecurringRun(modelJob).FailurePolicy)
+}
+
+func TestToModelJob_UseLatestPipelineVersion(t *testing.T) {
+	modelJob, err := toModelJob(&apiv2beta1.RecurringRun{
+		DisplayName:    "name1",
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{Cron: "0 0 2 * * *"}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineId{PipelineId: "p1"},
+	})
+	assert.Nil(t, err)
+	assert.True(t, modelJob.UseLatestPipelineVersion)
+	assert.Equal(t, "p1", modelJob.PipelineSpec.PipelineId)
+	assert.Equal(t, "", modelJob.PipelineSpec.PipelineVersionId)
+
+	// The recurring run keeps referencing the pipeline after a version was resolved.
+	modelJob.PipelineSpec.PipelineVersionId = "pv1"
+	apiRecurringRun := toApiRecurringRun(modelJob)
+	assert.Nil(t, apiRecurringRun.Error)
+	assert.Equal(t, &apiv2beta1.RecurringRun_PipelineId{PipelineId: "p1"}, apiRecurringRun.PipelineSource)
+}
+
+func TestToModelFailurePolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		policy *apiv2beta1.FailurePolicy
+		errMsg string
+	}{
+		{&apiv2beta1.FailurePolicy{MaxConsecutiveFailures: -1}, "must be at least 0 and at most 100"},
+		{&apiv2beta1.FailurePolicy{MaxConsecutiveFailures: 101}, "must be at least 0 and at most 100"},
+		{&apiv2beta1.FailurePolicy{MaxConsecutiveFailures: 1, WebhookUrl: "example.com/hook"}, "absolute http or https URL"},
+		{&apiv2beta1.FailurePolicy{MaxConsecutiveFailures: 1, WebhookUrl: "ftp://example.com/hook"}, "absolute http or https URL"},
+		{&apiv2beta1.FailurePolicy{MaxConsecutiveFailures: 1, WebhookUrl: "http://169.254.169.254/computeMetadata/v1/"}, "can't be posted to address"},
+		{&apiv2beta1.FailurePolicy{MaxConsecutiveFailures: 1, WebhookUrl: "http://localhost:8888/"}, "can't be posted to localhost"},
+	}
+	for _, tt := range tests {
+		_, _, err := toModelFailurePolicy(tt.policy)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), tt.errMsg)
+	}
+}
+
+func TestToModelTrigger_EventDriven(t *testing.T) {
+	tests := []struct {
+		name          string
+		apiTrigger    *apiv2beta1.Trigger
+		expectedModel *model.Trigger
+	}{
+		{
+			name: "object store",
+			apiTrigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_ObjectStoreTrigger{ObjectStoreTrigger: &apiv2beta1.ObjectStoreTrigger{
+					Uri:                "s3://bucket/data/",
+					PollIntervalSecond: 30,
+				}},
+			},
+			expectedModel: &model.Trigger{
+				ObjectStoreTrigger: model.ObjectStoreTrigger{
+					ObjectStoreTriggerURI:                util.StringPointer("s3://bucket/data/"),
+					ObjectStoreTriggerPollIntervalSecond: util.Int64Pointer(30),
+				},
+			},
+		},
+		{
+			name: "run completion",
+			apiTrigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_RunCompletionTrigger{RunCompletionTrigger: &apiv2beta1.RunCompletionTrigger{
+					ExperimentId: "exp1",
+					PipelineId:   "p1",
+					States:       []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_SUCCEEDED, apiv2beta1.RuntimeState_FAILED},
+				}},
+			},
+			expectedModel: &model.Trigger{
+				RunCompletionTrigger: model.RunCompletionTrigger{
+					RunCompletionTriggerExperimentId: util.StringPointer("exp1"),
+					RunCompletionTriggerPipelineId:   util.StringPointer("p1"),
+					RunCompletionTriggerStates:       util.StringPointer("SUCCEEDED,FAILED"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelTrigger, err := toModelTrigger(tt.apiTrigger)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedModel, modelTrigger)
+			assert.Nil(t, validateEventTrigger(modelTrigger))
+			assert.Equal(t, tt.apiTrigger, toApiTrigger(modelTrigger))
+		})
+	}
+}
+
+func TestValidateEventTrigger_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *model.Trigger
+		errMsg  string
+	}{
+		{
+			name: "invalid uri",
+			trigger: &model.Trigger{ObjectStoreTrigger: model.ObjectStoreTrigger{
+				ObjectStoreTriggerURI: util.StringPointer("bucket/data"),
+			}},
+			errMsg: "Object store trigger has an invalid URI",
+		},
+		{
+			name: "negative poll interval",
+			trigger: &model.Trigger{ObjectStoreTrigger: model.ObjectStoreTrigger{
+				ObjectStoreTriggerURI:                util.StringPointer("gs://bucket/data"),
+				ObjectStoreTriggerPollIntervalSecond: util.Int64Pointer(-1),
+			}},
+			errMsg: "Found invalid object store trigger poll interval",
+		},
+		{
+			name: "missing experiment",
+			trigger: &model.Trigger{RunCompletionTrigger: model.RunCompletionTrigger{
+				RunCompletionTriggerExperimentId: util.StringPointer(""),
+			}},
+			errMsg: "Run completion trigger must specify an experiment id",
+		},
+		{
+			name: "experiment id is not a label value",
+			trigger: &model.Trigger{RunCompletionTrigger: model.RunCompletionTrigger{
+				RunCompletionTriggerExperimentId: util.StringPointer("exp 1"),
+			}},
+			errMsg: "Run completion trigger has an invalid experiment id",
+		},
+		{
+			name: "pipeline id is not a label value",
+			trigger: &model.Trigger{RunCompletionTrigger: model.RunCompletionTrigger{
+				RunCompletionTriggerExperimentId: util.StringPointer("exp1"),
+				RunCompletionTriggerPipelineId:   util.StringPointer("p/1"),
+			}},
+			errMsg: "Run completion trigger has an invalid pipeline id",
+		},
+		{
+			name: "unsupported state",
+			trigger: &model.Trigger{RunCompletionTrigger: model.RunCompletionTrigger{
+				RunCompletionTriggerExperimentId: util.StringPointer("exp1"),
+				RunCompletionTriggerStates:       util.StringPointer("SUCCEEDED,RUNNING"),
+			}},
+			errMsg: "Run completion trigger only supports SUCCEEDED and FAILED states",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEventTrigger(tt.trigger)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
 func TestToModelResourceReferencesV1(t *testing.T) {
 	refs, err := toModelResourceReferencesV1(
 		[]*apiv1beta1.ResourceReference{
@@ -0,0 +1,247 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const (
+	ArtifactNameKey = "artifact_name"
+	ArtifactIdKey   = "artifact_id"
+	TaskNameKey     = "task_name"
+	OutputNameKey   = "output_name"
+	// RedirectKey is the query parameter requesting a redirect to a signed URL
+	// instead of streaming the artifact through the API server.
+	RedirectKey = "redirect"
+)
+
+type ArtifactServerOptions struct {
+	// SignedURLRedirect allows clients to be redirected to a signed object store URL.
+	SignedURLRedirect bool
+	// SignedURLExpiry is the validity of signed URLs.
+	SignedURLExpiry time.Duration
+}
+
+// ArtifactServer streams run artifacts over HTTP. Range requests are supported,
+// so large artifacts can be downloaded in parts or resumed.
+// These endpoints are not exposed through grpc endpoints, since grpc-gateway cannot handle native HTTP content streaming.
+type ArtifactServer struct {
+	resourceManager *resource.ResourceManager
+	// runServer authorizes access to the run owning an artifact.
+	runServer *RunServer
+	options   *ArtifactServerOptions
+}
+
+// Streams an output artifact of an Argo workflow node.
+// Supports v1beta1 API.
+func (s *ArtifactServer) DownloadRunArtifactV1(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	runId, nodeId, artifactName := vars[RunKey], vars[NodeKey], vars[ArtifactNameKey]
+	ctx := requestContext(r)
+	if err := s.canReadArtifact(ctx, runId); err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	artifact, err := s.resourceManager.OpenRunArtifact(runId, nodeId, artifactName)
+	if err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	s.serveArtifact(ctx, w, r, artifact)
+}
+
+// Streams the output artifact of a task in a v2 run.
+func (s *ArtifactServer) DownloadTaskOutputArtifact(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	runId, taskName, outputName := vars[RunKey], vars[TaskNameKey], vars[OutputNameKey]
+	ctx := requestContext(r)
+	if err := s.canReadArtifact(ctx, runId); err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	artifact, err := s.resourceManager.OpenTaskOutputArtifact(ctx, runId, taskName, outputName)
+	if err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	s.serveArtifact(ctx, w, r, artifact)
+}
+
+// Streams a v2 artifact by its ML Metadata ID.
+func (s *ArtifactServer) DownloadArtifact(w http.ResponseWriter, r *http.Request) {
+	artifactId, err := strconv.ParseInt(mux.Vars(r)[ArtifactIdKey], 10, 64)
+	if err != nil {
+		s.writeErrorToResponse(w, util.NewInvalidInputError("Invalid artifact ID %q", mux.Vars(r)[ArtifactIdKey]))
+		return
+	}
+	ctx := requestContext(r)
+	runId, err := s.resourceManager.GetArtifactRunId(ctx, artifactId)
+	if err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	if err := s.canReadArtifact(ctx, runId); err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	artifact, err := s.resourceManager.OpenArtifact(ctx, runId, artifactId)
+	if err != nil {
+		s.writeErrorToResponse(w, err)
+		return
+	}
+	s.serveArtifact(ctx, w, r, artifact)
+}
+
+func (s *ArtifactServer) canReadArtifact(ctx context.Context, runId string) error {
+	err := s.runServer.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbReadArtifact})
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request")
+	}
+	return nil
+}
+
+func (s *ArtifactServer) serveArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, artifact resource.Artifact) {
+	defer artifact.Close()
+	if redirect, _ := strconv.ParseBool(r.URL.Query().Get(RedirectKey)); redirect {
+		if !s.options.SignedURLRedirect {
+			s.writeErrorToResponse(w, util.NewInvalidInputError("Redirecting to signed artifact URLs is disabled"))
+			return
+		}
+		signedURL, err := artifact.SignedURL(ctx, s.options.SignedURLExpiry)
+		if err != nil {
+			s.writeErrorToResponse(w, err)
+			return
+		}
+		http.Redirect(w, r, signedURL, http.StatusFound)
+		return
+	}
+
+	contentType := artifact.ContentType()
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", artifact.Name()))
+	w.Header().Set("Cache-Control", "no-cache, private")
+	content := &artifactReadSeeker{ctx: ctx, artifact: artifact}
+	defer content.Close()
+	// ServeContent handles Range and If-Modified-Since requests.
+	http.ServeContent(w, r, artifact.Name(), artifact.ModTime(), content)
+}
+
+func (s *ArtifactServer) writeErrorToResponse(w http.ResponseWriter, err error) {
+	glog.Errorf("Failed to download artifact. Error: %+v", err)
+	code := httpStatusFromError(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	errorResponse := &api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Error downloading artifact"))
+	}
+	w.Write(errBytes)
+}
+
+// requestContext forwards the request headers as gRPC metadata, so that the
+// request can be authenticated the same way as gRPC calls.
+func requestContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md.Set(key, values...)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+// artifactReadSeeker adapts an artifact to io.ReadSeeker. The object store is
+// only read from the current offset once Read is called, so seeking to serve a
+// range request doesn't download the skipped content.
+type artifactReadSeeker struct {
+	ctx      context.Context
+	artifact resource.Artifact
+	offset   int64
+	reader   io.ReadCloser
+}
+
+func (a *artifactReadSeeker) Read(p []byte) (int, error) {
+	if a.offset >= a.artifact.Size() {
+		return 0, io.EOF
+	}
+	if a.reader == nil {
+		reader, err := a.artifact.NewRangeReader(a.ctx, a.offset, -1)
+		if err != nil {
+			return 0, err
+		}
+		a.reader = reader
+	}
+	n, err := a.reader.Read(p)
+	a.offset += int64(n)
+	return n, err
+}
+
+func (a *artifactReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = a.offset + offset
+	case io.SeekEnd:
+		newOffset = a.artifact.Size() + offset
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+	if newOffset < 0 {
+		return 0, errors.Errorf("negative offset %d", newOffset)
+	}
+	if newOffset != a.offset {
+		a.Close()
+		a.offset = newOffset
+	}
+	return newOffset, nil
+}
+
+func (a *artifactReadSeeker) Close() error {
+	if a.reader == nil {
+		return nil
+	}
+	err := a.reader.Close()
+	a.reader = nil
+	return err
+}
+
+func NewArtifactServer(resourceManager *resource.ResourceManager, options *ArtifactServerOptions) *ArtifactServer {
+	return &ArtifactServer{
+		resourceManager: resourceManager,
+		runServer:       &RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}},
+		options:         options,
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/gorilla/mux"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newArtifactServerRouter(manager *resource.ResourceManager, options *ArtifactServerOptions) *mux.Router {
+	artifactServer := NewArtifactServer(manager, options)
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:download", artifactServer.DownloadRunArtifactV1)
+	router.HandleFunc("/apis/v2beta1/runs/{run_id}/tasks/{task_name}/outputs/{output_name}:download", artifactServer.DownloadTaskOutputArtifact)
+	router.HandleFunc("/apis/v2beta1/artifacts/{artifact_id}:download", artifactServer.DownloadArtifact)
+	return router
+}
+
+// initWithArgoArtifact creates a run whose workflow node-1 produced artifact-1.
+func initWithArgoArtifact(t *testing.T, content string) (*resource.FakeClientManager, *resource.ResourceManager, *model.Run) {
+	clientManager, manager, run := initWithOneTimeRun(t)
+	filePath := "artifacts/workflow-name/node-1/artifact-1.tgz"
+	require.Nil(t, clientManager.ObjectStore().AddFile([]byte(content), filePath))
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "workflow-name",
+			Namespace: "ns1",
+			UID:       "workflow1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"node-1": {
+					Outputs: &v1alpha1.Outputs{
+						Artifacts: []v1alpha1.Artifact{{
+							Name:             "artifact-1",
+							ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{Key: filePath}},
+						}},
+					},
+				},
+			},
+		},
+	})
+	_, err := manager.ReportWorkflowResource(context.Background(), workflow)
+	require.Nil(t, err)
+	return clientManager, manager, run
+}
+
+func TestDownloadRunArtifactV1(t *testing.T) {
+	_, manager, run := initWithArgoArtifact(t, "0123456789")
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{})
+	url := fmt.Sprintf("/apis/v1beta1/runs/%s/nodes/node-1/artifacts/artifact-1:download", run.UUID)
+
+	tests := []struct {
+		name        string
+		rangeHeader string
+		wantCode    int
+		wantBody    string
+	}{
+		{name: "whole artifact", wantCode: http.StatusOK, wantBody: "0123456789"},
+		{name: "range", rangeHeader: "bytes=2-5", wantCode: http.StatusPartialContent, wantBody: "2345"},
+		{name: "suffix range", rangeHeader: "bytes=-3", wantCode: http.StatusPartialContent, wantBody: "789"},
+		{name: "open ended range", rangeHeader: "bytes=7-", wantCode: http.StatusPartialContent, wantBody: "789"},
+		{name: "unsatisfiable range", rangeHeader: "bytes=20-", wantCode: http.StatusRequestedRangeNotSatisfiable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			assert.Equal(t, tt.wantCode, rr.Code, rr.Body.String())
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, rr.Body.String())
+				assert.Equal(t, `attachment; filename="artifact-1.tgz"`, rr.Header().Get("Content-Disposition"))
+				assert.Equal(t, "bytes", rr.Header().Get("Accept-Ranges"))
+			}
+		})
+	}
+}
+
+func TestDownloadRunArtifactV1_NotFound(t *testing.T) {
+	_, manager, run := initWithArgoArtifact(t, "content")
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{})
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/apis/v1beta1/runs/%s/nodes/node-1/artifacts/missing:download", run.UUID), nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Contains(t, rr.Body.String(), "missing")
+}
+
+func TestDownloadRunArtifactV1_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, _, run := initWithArgoArtifact(t, "content")
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	manager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{})
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/apis/v1beta1/runs/%s/nodes/node-1/artifacts/artifact-1:download", run.UUID), nil)
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Contains(t, rr.Body.String(), "is not authorized")
+}
+
+func TestDownloadRunArtifactV1_Redirect(t *testing.T) {
+	_, manager, run := initWithArgoArtifact(t, "content")
+	url := fmt.Sprintf("/apis/v1beta1/runs/%s/nodes/node-1/artifacts/artifact-1:download?redirect=true", run.UUID)
+
+	rr := httptest.NewRecorder()
+	newArtifactServerRouter(manager, &ArtifactServerOptions{}).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = httptest.NewRecorder()
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{SignedURLRedirect: true, SignedURLExpiry: 10 * time.Minute})
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+	assert.Equal(t, http.StatusFound, rr.Code)
+	assert.Contains(t, rr.Header().Get("Location"), "artifacts/workflow-name/node-1/artifact-1.tgz")
+	assert.Contains(t, rr.Header().Get("Location"), "X-Amz-Expires=600")
+}
+
+// initWithMetadataArtifact records a v2 task output artifact stored in a local pipeline root.
+func initWithMetadataArtifact(t *testing.T, clientManager *resource.FakeClientManager, runID string, content string) (int64, string) {
+	root := t.TempDir()
+	artifactPath := filepath.Join(root, "pipeline", runID, "trainer", "model.bin")
+	require.Nil(t, os.MkdirAll(filepath.Dir(artifactPath), 0755))
+	require.Nil(t, ioutil.WriteFile(artifactPath, []byte(content), 0644))
+	require.Nil(t, os.MkdirAll(filepath.Join(root, "pipeline", runID, "trainer", "dataset"), 0755))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(root, "pipeline", runID, "trainer", "dataset", "part-0"), []byte(content), 0644))
+
+	// The local pipeline root is the default pipeline root of the run namespace.
+	run, err := clientManager.RunStore().GetRun(runID)
+	require.Nil(t, err)
+	namespace := run.Namespace
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+	_, err = clientManager.KubernetesCoreClient().GetClientSet().CoreV1().ConfigMaps(namespace).Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "kfp-launcher"},
+		Data:       map[string]string{"defaultPipelineRoot": "file://" + filepath.ToSlash(root)},
+	}, v1.CreateOptions{})
+	require.Nil(t, err)
+
+	runContext := clientManager.MetadataClientFake.AddRunContext(runID, "file://"+filepath.ToSlash(root))
+	execution := clientManager.MetadataClientFake.AddExecution("trainer", runContext.GetId())
+	artifact := clientManager.MetadataClientFake.AddOutputArtifact(execution.GetId(), "model", "file://"+filepath.ToSlash(artifactPath))
+	clientManager.MetadataClientFake.AddOutputArtifact(execution.GetId(), "dataset", "file://"+filepath.ToSlash(filepath.Join(root, "pipeline", runID, "trainer", "dataset")))
+	return artifact.GetId(), artifactPath
+}
+
+func TestDownloadTaskOutputArtifact(t *testing.T) {
+	clientManager, manager, run := initWithOneTimeRun(t)
+	initWithMetadataArtifact(t, clientManager, run.UUID, "model weights")
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{})
+
+	tests := []struct {
+		name     string
+		url      string
+		wantCode int
+		wantBody string
+	}{
+		{name: "output artifact", url: fmt.Sprintf("/apis/v2beta1/runs/%s/tasks/trainer/outputs/model:download", run.UUID), wantCode: http.StatusOK, wantBody: "model weights"},
+		{name: "unknown output", url: fmt.Sprintf("/apis/v2beta1/runs/%s/tasks/trainer/outputs/metrics:download", run.UUID), wantCode: http.StatusNotFound},
+		{name: "unknown task", url: fmt.Sprintf("/apis/v2beta1/runs/%s/tasks/evaluator/outputs/model:download", run.UUID), wantCode: http.StatusNotFound},
+		{name: "directory artifact", url: fmt.Sprintf("/apis/v2beta1/runs/%s/tasks/trainer/outputs/dataset:download", run.UUID), wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			assert.Equal(t, tt.wantCode, rr.Code, rr.Body.String())
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestDownloadArtifact(t *testing.T) {
+	clientManager, manager, run := initWithOneTimeRun(t)
+	artifactID, _ := initWithMetadataArtifact(t, clientManager, run.UUID, "model weights")
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{SignedURLRedirect: true, SignedURLExpiry: time.Minute})
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/apis/v2beta1/artifacts/%d:download", artifactID), nil)
+	req.Header.Set("Range", "bytes=6-")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusPartialContent, rr.Code, rr.Body.String())
+	assert.Equal(t, "weights", rr.Body.String())
+	assert.Equal(t, `attachment; filename="model.bin"`, rr.Header().Get("Content-Disposition"))
+
+	// The local file system can't sign URLs.
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/apis/v2beta1/artifacts/%d:download?redirect=true", artifactID), nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/apis/v2beta1/artifacts/12345:download", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/apis/v2beta1/artifacts/abc:download", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestDownloadArtifact_OutsidePipelineRoot(t *testing.T) {
+	clientManager, manager, run := initWithOneTimeRun(t)
+	_, artifactPath := initWithMetadataArtifact(t, clientManager, run.UUID, "model weights")
+	router := newArtifactServerRouter(manager, &ArtifactServerOptions{})
+
+	// The artifact URIs in ML Metadata are written by the pods of the run.
+	otherPath := filepath.Join(t.TempDir(), "credentials")
+	require.Nil(t, ioutil.WriteFile(otherPath, []byte("secret key"), 0644))
+	runContext, err := clientManager.MetadataClientFake.GetRunContext(context.Background(), run.UUID)
+	require.Nil(t, err)
+	execution := clientManager.MetadataClientFake.AddExecution("evaluator", runContext.GetId())
+	outside := clientManager.MetadataClientFake.AddOutputArtifact(execution.GetId(), "outside", "file://"+filepath.ToSlash(otherPath))
+	escaping := clientManager.MetadataClientFake.AddOutputArtifact(execution.GetId(), "escaping", "file://"+filepath.ToSlash(artifactPath)+"/../../../../credentials")
+
+	for _, url := range []string{
+		fmt.Sprintf("/apis/v2beta1/artifacts/%d:download", outside.GetId()),
+		fmt.Sprintf("/apis/v2beta1/artifacts/%d:download", escaping.GetId()),
+		fmt.Sprintf("/apis/v2beta1/runs/%s/tasks/evaluator/outputs/outside:download", run.UUID),
+	} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Equal(t, http.StatusForbidden, rr.Code, url)
+		assert.NotContains(t, rr.Body.String(), "secret key", url)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type AuditServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// ListAuditEvents lists the audit events of mutating API calls. Audit events
+// are cluster-scoped, so only admins allowed to list auditevents in all
+// namespaces can list them.
+func (s *AuditServer) ListAuditEvents(ctx context.Context, request *apiv2beta1.ListAuditEventsRequest) (
+	*apiv2beta1.ListAuditEventsResponse, error,
+) {
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbList,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: common.RbacResourceTypeAuditEvents,
+	}
+	if err := s.resourceManager.IsAuthorized(ctx, resourceAttributes); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	opts, err := validatedListOptions(&model.AuditEvent{}, request.GetPageToken(), int(request.GetPageSize()), request.GetSortBy(), request.GetFilter(), "v2beta1")
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	events, totalSize, nextPageToken, err := s.resourceManager.ListAuditEvents(opts)
+	if err != nil {
+		return nil, util.Wrap(err, "List audit events failed")
+	}
+	return &apiv2beta1.ListAuditEventsResponse{
+		AuditEvents:   toApiAuditEvents(events),
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func NewAuditServer(resourceManager *resource.ResourceManager) *AuditServer {
+	return &AuditServer{resourceManager: resourceManager}
+}
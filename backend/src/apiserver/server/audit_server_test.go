@@ -0,0 +1,109 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	clients := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clients.Close()
+	_, err := clients.AuditEventStore().CreateAuditEvent(&model.AuditEvent{
+		CreatedAtInSec: 1,
+		User:           "user@google.com",
+		Method:         "/kubeflow.pipelines.backend.api.v2beta1.RunService/TerminateRun",
+		ResourceIds:    "run1,experiment1",
+		Namespace:      "ns1",
+		Code:           "OK",
+	})
+	assert.Nil(t, err)
+	server := NewAuditServer(resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false}))
+
+	response, err := server.ListAuditEvents(context.Background(), &apiv2beta1.ListAuditEventsRequest{PageSize: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.GetTotalSize())
+	assert.Equal(t, 1, len(response.GetAuditEvents()))
+	event := response.GetAuditEvents()[0]
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426655440000", event.GetAuditEventId())
+	assert.Equal(t, int64(1), event.GetCreatedAt().GetSeconds())
+	assert.Equal(t, "user@google.com", event.GetUser())
+	assert.Equal(t, []string{"run1", "experiment1"}, event.GetResourceIds())
+	assert.Equal(t, "ns1", event.GetNamespace())
+	assert.Equal(t, "OK", event.GetCode())
+}
+
+func TestListAuditEvents_AdminOnly(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	// Audit events are not readable by everyone in shared read mode.
+	viper.Set(common.MultiUserModeSharedReadAccess, "true")
+	defer viper.Set(common.MultiUserModeSharedReadAccess, "false")
+
+	clients := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clients.Close()
+	authorizer, err := auth.NewRBACAuthorizer(&auth.RBACPolicy{
+		Roles: []auth.RBACRole{
+			{
+				Name:  "admin",
+				Rules: []auth.RBACRule{{Resources: []string{"*"}, Verbs: []string{"*"}}},
+			},
+			{
+				Name:  "editor",
+				Rules: []auth.RBACRule{{Resources: []string{common.RbacResourceTypeRuns}, Verbs: []string{"*"}}},
+			},
+		},
+		Bindings: []auth.RBACBinding{
+			{Role: "admin", Namespaces: []string{"*"}, Users: []string{"admin@google.com"}},
+			{Role: "editor", Namespaces: []string{"*"}, Users: []string{"user@google.com"}},
+		},
+	})
+	assert.Nil(t, err)
+	clients.AuthorizerFake = authorizer
+	server := NewAuditServer(resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false}))
+
+	tests := []struct {
+		user    string
+		wantErr bool
+	}{
+		{"admin@google.com", false},
+		{"user@google.com", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.user, func(t *testing.T) {
+			md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + tt.user})
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			_, err := server.ListAuditEvents(ctx, &apiv2beta1.ListAuditEventsRequest{})
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).ExternalStatusCode())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
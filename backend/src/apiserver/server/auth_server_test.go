@@ -19,8 +19,10 @@ import (
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
@@ -169,3 +171,37 @@ func TestAuthorizeRequest_Unauthenticated(t *testing.T) {
 		"there is no user identity header",
 	)
 }
+
+func TestAuthorizeRequest_RBACAuthorizer(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, _, _ := initWithExperiment(t)
+	defer clients.Close()
+	authorizer, err := auth.NewRBACAuthorizer(&auth.RBACPolicy{
+		Roles: []auth.RBACRole{{
+			Name:  "viewer",
+			Rules: []auth.RBACRule{{Resources: []string{common.RbacResourceTypeViewers}, Verbs: []string{common.RbacResourceVerbGet}}},
+		}},
+		Bindings: []auth.RBACBinding{{Role: "viewer", Namespaces: []string{"ns1"}, Users: []string{"user@google.com"}}},
+	})
+	assert.Nil(t, err)
+	clients.AuthorizerFake = authorizer
+	authServer := AuthServer{resourceManager: resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false})}
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	request := &api.AuthorizeRequest{
+		Namespace: "ns1",
+		Resources: api.AuthorizeRequest_VIEWERS,
+		Verb:      api.AuthorizeRequest_GET,
+	}
+	_, err = authServer.AuthorizeV1(ctx, request)
+	assert.Nil(t, err)
+
+	request.Namespace = "ns2"
+	_, err = authServer.AuthorizeV1(ctx, request)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no binding of the RBAC policy allows it")
+}
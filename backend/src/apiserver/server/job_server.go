@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	apiv1beta1 "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
@@ -27,6 +28,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
@@ -63,6 +65,26 @@ var (
 		Help: "The total number of EnableJob requests",
 	})
 
+	backfillJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_backfill_requests",
+		Help: "The total number of BackfillRecurringRun requests",
+	})
+
+	previewJobTriggersRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_preview_triggers_requests",
+		Help: "The total number of PreviewRecurringRunTriggers requests",
+	})
+
+	updateJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_update_requests",
+		Help: "The total number of UpdateRecurringRun requests",
+	})
+
+	validateTriggerRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_validate_trigger_requests",
+		Help: "The total number of ValidateTrigger requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	jobCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -71,6 +93,13 @@ var (
 	})
 )
 
+const (
+	defaultBackfillParallelism = 5
+	maxBackfillParallelism     = 20
+	defaultTriggerPreviewCount = 10
+	maxTriggerPreviewCount     = 100
+)
+
 type JobServerOptions struct {
 	CollectMetrics bool
 }
@@ -103,9 +132,43 @@ func (s *JobServer) createJob(ctx context.Context, job *model.Job) (*model.Job,
 	if err := s.canAccessJob(ctx, "", resourceAttributes); err != nil {
 		return nil, util.Wrapf(err, "Failed to create a recurring run due to authorization error. Check if you have write permission to namespace %s", job.Namespace)
 	}
+	if err := s.validateRunCompletionTrigger(job); err != nil {
+		return nil, util.Wrapf(err, "Failed to create a recurring run due to an invalid run completion trigger")
+	}
 	return s.resourceManager.CreateJob(ctx, job)
 }
 
+// Checks that the experiment and pipeline of a run completion trigger exist. The
+// controller only sees the runs in the namespace of the recurring run, so the
+// experiment must belong to it. It must only be called once the caller is
+// authorized in the namespace of the recurring run, and it doesn't tell apart
+// missing experiments and pipelines from those of other namespaces, so that it
+// doesn't reveal the resources of other namespaces.
+func (s *JobServer) validateRunCompletionTrigger(job *model.Job) error {
+	experimentId := job.Trigger.RunCompletionTriggerExperimentId
+	if experimentId == nil || *experimentId == "" {
+		return nil
+	}
+	experiment, err := s.resourceManager.GetExperiment(*experimentId)
+	if err != nil && !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return util.Wrapf(err, "Failed to fetch experiment %v of the run completion trigger", *experimentId)
+	}
+	if err != nil || experiment.Namespace != job.Namespace {
+		return util.NewInvalidInputError("Run completion trigger experiment %v not found in namespace %q", *experimentId, job.Namespace)
+	}
+	if pipelineId := job.Trigger.RunCompletionTriggerPipelineId; pipelineId != nil && *pipelineId != "" {
+		pipeline, err := s.resourceManager.GetPipeline(*pipelineId)
+		if err != nil && !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			return util.Wrapf(err, "Failed to fetch pipeline %v of the run completion trigger", *pipelineId)
+		}
+		// Pipelines without a namespace are shared by all namespaces.
+		if err != nil || (pipeline.Namespace != "" && pipeline.Namespace != job.Namespace) {
+			return util.NewInvalidInputError("Run completion trigger pipeline %v not found in namespace %q", *pipelineId, job.Namespace)
+		}
+	}
+	return nil
+}
+
 func (s *JobServer) CreateJob(ctx context.Context, request *apiv1beta1.CreateJobRequest) (*apiv1beta1.Job, error) {
 	if s.options.CollectMetrics {
 		createJobRequests.Inc()
@@ -332,6 +395,105 @@ func (s *JobServer) GetRecurringRun(ctx context.Context, request *apiv2beta1.Get
 	return apiRecurringRun, nil
 }
 
+func (s *JobServer) UpdateRecurringRun(ctx context.Context, request *apiv2beta1.UpdateRecurringRunRequest) (*apiv2beta1.RecurringRun, error) {
+	if s.options.CollectMetrics {
+		updateJobRequests.Inc()
+	}
+	recurringRunId := request.GetRecurringRun().GetRecurringRunId()
+	if recurringRunId == "" {
+		return nil, util.NewInvalidInputError("Failed to update a recurring run due to missing recurring run id")
+	}
+	if len(request.GetUpdateMask().GetPaths()) == 0 {
+		return nil, util.NewInvalidInputError("Failed to update recurring run %v due to an empty update mask", recurringRunId)
+	}
+	if err := s.canAccessJob(ctx, recurringRunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate}); err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v due to authorization error", recurringRunId)
+	}
+	job, err := s.resourceManager.GetJob(recurringRunId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", recurringRunId)
+	}
+	if err := applyRecurringRunUpdate(job, request.GetRecurringRun(), request.GetUpdateMask().GetPaths()); err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", recurringRunId)
+	}
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		if path != "trigger" {
+			continue
+		}
+		// The run completion trigger can only watch the namespace of the recurring run.
+		if err := s.validateRunCompletionTrigger(job); err != nil {
+			return nil, util.Wrapf(err, "Failed to update recurring run %v", recurringRunId)
+		}
+	}
+	if job.UseLatestPipelineVersion {
+		// Resolve the latest version of the pipeline again.
+		job.PipelineSpec.PipelineVersionId = ""
+		job.PipelineSpec.PipelineSpecManifest = ""
+		job.PipelineSpec.WorkflowSpecManifest = ""
+	}
+	updatedJob, err := s.resourceManager.UpdateJob(ctx, job)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", recurringRunId)
+	}
+	apiRecurringRun := toApiRecurringRun(updatedJob)
+	if apiRecurringRun == nil {
+		return nil, util.NewInternalServerError(util.NewInvalidInputError("Failed to convert internal recurring run representation to its API counterpart"), "Failed to update recurring run %v", recurringRunId)
+	}
+	return apiRecurringRun, nil
+}
+
+// Applies the fields of a recurring run listed in the update mask to a job.
+func applyRecurringRunUpdate(job *model.Job, recurringRun *apiv2beta1.RecurringRun, paths []string) error {
+	for _, path := range paths {
+		switch path {
+		case "trigger":
+			trigger, err := toModelTrigger(recurringRun.GetTrigger())
+			if err != nil {
+				return util.Wrap(err, "Failed to parse the trigger")
+			}
+			if err := validateTrigger(trigger); err != nil {
+				return err
+			}
+			job.Trigger = *trigger
+		case "max_concurrency":
+			maxConcurrency := recurringRun.GetMaxConcurrency()
+			if maxConcurrency > 10 || maxConcurrency < 1 {
+				return util.NewInvalidInputError("Max concurrency of a recurring run must be at least 1 and at most 10. Received %v", maxConcurrency)
+			}
+			job.MaxConcurrency = maxConcurrency
+		case "no_catchup":
+			job.NoCatchup = recurringRun.GetNoCatchup()
+		case "runtime_config":
+			runtimeConfig, err := toModelRuntimeConfig(recurringRun.GetRuntimeConfig())
+			if err != nil {
+				return util.Wrap(err, "Failed to parse the runtime config")
+			}
+			job.PipelineSpec.RuntimeConfig = *runtimeConfig
+		case "service_account":
+			job.ServiceAccount = recurringRun.GetServiceAccount()
+		case "pipeline_version_reference":
+			reference := recurringRun.GetPipelineVersionReference()
+			if reference.GetPipelineVersionId() == "" {
+				return util.NewInvalidInputError("Pipeline version reference must have a pipeline version id")
+			}
+			job.PipelineSpec.PipelineId = reference.GetPipelineId()
+			job.PipelineSpec.PipelineVersionId = reference.GetPipelineVersionId()
+			job.PipelineSpec.PipelineSpecManifest = ""
+			job.PipelineSpec.WorkflowSpecManifest = ""
+			job.UseLatestPipelineVersion = false
+		case "pipeline_id":
+			if recurringRun.GetPipelineId() == "" {
+				return util.NewInvalidInputError("Pipeline id cannot be empty")
+			}
+			job.PipelineSpec.PipelineId = recurringRun.GetPipelineId()
+			job.UseLatestPipelineVersion = true
+		default:
+			return util.NewInvalidInputError("Field %v of a recurring run cannot be updated", path)
+		}
+	}
+	return nil
+}
+
 func (s *JobServer) ListRecurringRuns(ctx context.Context, r *apiv2beta1.ListRecurringRunsRequest) (*apiv2beta1.ListRecurringRunsResponse, error) {
 	if s.options.CollectMetrics {
 		listJobRequests.Inc()
@@ -394,6 +556,110 @@ func (s *JobServer) DeleteRecurringRun(ctx context.Context, request *apiv2beta1.
 	return &empty.Empty{}, nil
 }
 
+func (s *JobServer) BackfillRecurringRun(ctx context.Context, request *apiv2beta1.BackfillRecurringRunRequest) (*apiv2beta1.BackfillRecurringRunResponse, error) {
+	if s.options.CollectMetrics {
+		backfillJobRequests.Inc()
+	}
+	jobId := request.GetRecurringRunId()
+	if request.GetStartTime() == nil || request.GetEndTime() == nil {
+		return nil, util.NewInvalidInputError("Failed to backfill recurring run %v: both start time and end time must be set", jobId)
+	}
+	startTime := request.GetStartTime().GetSeconds()
+	endTime := request.GetEndTime().GetSeconds()
+	if startTime > endTime {
+		return nil, util.NewInvalidInputError("Failed to backfill recurring run %v: start time %v is after end time %v", jobId, startTime, endTime)
+	}
+	parallelism := int(request.GetParallelism())
+	if parallelism == 0 {
+		parallelism = defaultBackfillParallelism
+	}
+	if parallelism < 1 || parallelism > maxBackfillParallelism {
+		return nil, util.NewInvalidInputError("Failed to backfill recurring run %v: parallelism must be between 1 and %v, got %v", jobId, maxBackfillParallelism, parallelism)
+	}
+
+	err := s.canAccessJob(ctx, jobId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbBackfill})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	runs, skippedTimes, err := s.resourceManager.BackfillJob(ctx, jobId, startTime, endTime, parallelism)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to backfill a recurring run")
+	}
+	response := &apiv2beta1.BackfillRecurringRunResponse{}
+	for _, run := range runs {
+		response.Runs = append(response.Runs, toApiRun(run))
+	}
+	for _, skippedTime := range skippedTimes {
+		response.SkippedTimes = append(response.SkippedTimes, &timestamp.Timestamp{Seconds: skippedTime})
+	}
+	return response, nil
+}
+
+func (s *JobServer) PreviewRecurringRunTriggers(ctx context.Context, request *apiv2beta1.PreviewRecurringRunTriggersRequest) (*apiv2beta1.PreviewTriggersResponse, error) {
+	if s.options.CollectMetrics {
+		previewJobTriggersRequests.Inc()
+	}
+	jobId := request.GetRecurringRunId()
+	count, err := triggerPreviewCount(request.GetCount())
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to preview the triggers of recurring run %v", jobId)
+	}
+
+	err = s.canAccessJob(ctx, jobId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	triggerTimes, err := s.resourceManager.PreviewJobTriggers(ctx, jobId, count)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to preview the triggers of a recurring run")
+	}
+	return toApiPreviewTriggersResponse(triggerTimes), nil
+}
+
+func (s *JobServer) ValidateTrigger(ctx context.Context, request *apiv2beta1.ValidateTriggerRequest) (*apiv2beta1.PreviewTriggersResponse, error) {
+	if s.options.CollectMetrics {
+		validateTriggerRequests.Inc()
+	}
+	count, err := triggerPreviewCount(request.GetCount())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to validate a trigger")
+	}
+	if request.GetTrigger() == nil {
+		return nil, util.NewInvalidInputError("Failed to validate a trigger: trigger must be set")
+	}
+	trigger, err := toModelTrigger(request.GetTrigger())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to validate a trigger due to conversion error")
+	}
+	if err := validateTrigger(trigger); err != nil {
+		return nil, util.Wrap(err, "Failed to validate a trigger")
+	}
+	triggerTimes, err := s.resourceManager.PreviewTrigger(*trigger, request.GetNoCatchup(), count)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to validate a trigger")
+	}
+	return toApiPreviewTriggersResponse(triggerTimes), nil
+}
+
+// Returns the number of trigger times to preview, or an error if it is out of range.
+func triggerPreviewCount(count int32) (int, error) {
+	if count == 0 {
+		return defaultTriggerPreviewCount, nil
+	}
+	if count < 1 || count > maxTriggerPreviewCount {
+		return 0, util.NewInvalidInputError("Count must be between 1 and %v, got %v", maxTriggerPreviewCount, count)
+	}
+	return int(count), nil
+}
+
+func toApiPreviewTriggersResponse(triggerTimes []int64) *apiv2beta1.PreviewTriggersResponse {
+	response := &apiv2beta1.PreviewTriggersResponse{}
+	for _, triggerTime := range triggerTimes {
+		response.TriggerTimes = append(response.TriggerTimes, &timestamp.Timestamp{Seconds: triggerTime})
+	}
+	return response
+}
+
 func (s *JobServer) canAccessJob(ctx context.Context, jobID string, resourceAttributes *authorizationv1.ResourceAttributes) error {
 	if !common.IsMultiUserMode() {
 		// Skip authorization if not multi-user mode.
@@ -27,12 +27,16 @@ import (
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"sigs.k8s.io/yaml"
 )
@@ -938,6 +942,94 @@ func TestListJobs_Unauthenticated(t *testing.T) {
 	)
 }
 
+// newRunCompletionRecurringRun returns a recurring run in an experiment, triggered by
+// the completion of the runs of another experiment and pipeline.
+func newRunCompletionRecurringRun(experimentId string, triggerExperimentId string, triggerPipelineId string) *apiv2beta1.RecurringRun {
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	return &apiv2beta1.RecurringRun{
+		DisplayName:    "job1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_RunCompletionTrigger{RunCompletionTrigger: &apiv2beta1.RunCompletionTrigger{
+				ExperimentId: triggerExperimentId,
+				PipelineId:   triggerPipelineId,
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{"param1": structpb.NewStringValue("world")},
+		},
+		ExperimentId: experimentId,
+	}
+}
+
+func TestCreateRecurringRun_RunCompletionTrigger(t *testing.T) {
+	tests := []struct {
+		name         string
+		experimentId string
+		pipelineId   string
+		errMsg       string
+	}{
+		{name: "valid", experimentId: DefaultFakeUUID},
+		{name: "missing experiment", experimentId: "no-such-experiment",
+			errMsg: "Run completion trigger experiment no-such-experiment not found"},
+		{name: "missing pipeline", experimentId: DefaultFakeUUID, pipelineId: "no-such-pipeline",
+			errMsg: "Run completion trigger pipeline no-such-pipeline not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients, manager, _ := initWithExperiment(t)
+			defer clients.Close()
+			server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+			apiRecurringRun := newRunCompletionRecurringRun(DefaultFakeUUID, tt.experimentId, tt.pipelineId)
+			_, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: apiRecurringRun})
+			if tt.errMsg == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestCreateRecurringRun_RunCompletionTrigger_OtherNamespace(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	clients.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false})
+	_, err := manager.CreateExperiment(&model.Experiment{Name: "exp2", Namespace: "ns2"})
+	require.Nil(t, err)
+	clients.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(DefaultFakeUUID, nil))
+
+	// The experiment of another namespace looks like a missing experiment.
+	server := NewJobServer(resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false}), &JobServerOptions{CollectMetrics: false})
+	_, err = server.CreateRecurringRun(ctx, &apiv2beta1.CreateRecurringRunRequest{
+		RecurringRun: newRunCompletionRecurringRun(DefaultFakeUUID, FakeUUIDOne, ""),
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Run completion trigger experiment "+FakeUUIDOne+" not found in namespace \"ns1\"")
+	assert.NotContains(t, err.Error(), "ns2")
+
+	// Unauthorized users don't learn anything about the experiment.
+	clients.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server = NewJobServer(resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false}), &JobServerOptions{CollectMetrics: false})
+	_, err = server.CreateRecurringRun(ctx, &apiv2beta1.CreateRecurringRunRequest{
+		RecurringRun: newRunCompletionRecurringRun(DefaultFakeUUID, FakeUUIDOne, ""),
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+	assert.NotContains(t, err.Error(), "Run completion trigger")
+}
+
 func TestCreateRecurringRun(t *testing.T) {
 	clients, manager, _ := initWithExperiment(t)
 	defer clients.Close()
@@ -1212,3 +1304,414 @@ func TestDisableRecurringRun(t *testing.T) {
 	_, err = server.DisableRecurringRun(nil, &apiv2beta1.DisableRecurringRunRequest{RecurringRunId: createdRecurringRun.RecurringRunId})
 	assert.Nil(t, err)
 }
+
+func TestBackfillRecurringRun(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &apiv2beta1.PeriodicSchedule{
+				IntervalSecond: 3600,
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			PipelineRoot: "model-pipeline-root",
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}
+
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: apiRecurringRun})
+	assert.Nil(t, err)
+
+	response, err := server.BackfillRecurringRun(nil, &apiv2beta1.BackfillRecurringRunRequest{
+		RecurringRunId: createdRecurringRun.RecurringRunId,
+		StartTime:      &timestamp.Timestamp{Seconds: 3600},
+		EndTime:        &timestamp.Timestamp{Seconds: 3 * 3600},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(response.Runs))
+	for i, run := range response.Runs {
+		assert.Equal(t, int64(i+1)*3600, run.GetScheduledAt().GetSeconds())
+		assert.Equal(t, createdRecurringRun.RecurringRunId, run.GetRecurringRunId())
+	}
+	assert.Empty(t, response.SkippedTimes)
+
+	response, err = server.BackfillRecurringRun(nil, &apiv2beta1.BackfillRecurringRunRequest{
+		RecurringRunId: createdRecurringRun.RecurringRunId,
+		StartTime:      &timestamp.Timestamp{Seconds: 3 * 3600},
+		EndTime:        &timestamp.Timestamp{Seconds: 4 * 3600},
+		Parallelism:    1,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(response.Runs))
+	assert.Equal(t, []*timestamp.Timestamp{{Seconds: 3 * 3600}}, response.SkippedTimes)
+}
+
+func TestBackfillRecurringRun_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	tests := []struct {
+		name    string
+		request *apiv2beta1.BackfillRecurringRunRequest
+		errMsg  string
+	}{
+		{
+			name:    "missing end time",
+			request: &apiv2beta1.BackfillRecurringRunRequest{StartTime: &timestamp.Timestamp{Seconds: 1}},
+			errMsg:  "both start time and end time must be set",
+		},
+		{
+			name: "start after end",
+			request: &apiv2beta1.BackfillRecurringRunRequest{
+				StartTime: &timestamp.Timestamp{Seconds: 2},
+				EndTime:   &timestamp.Timestamp{Seconds: 1},
+			},
+			errMsg: "start time 2 is after end time 1",
+		},
+		{
+			name: "parallelism out of range",
+			request: &apiv2beta1.BackfillRecurringRunRequest{
+				StartTime:   &timestamp.Timestamp{Seconds: 1},
+				EndTime:     &timestamp.Timestamp{Seconds: 2},
+				Parallelism: 21,
+			},
+			errMsg: "parallelism must be between 1 and 20",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.BackfillRecurringRun(nil, tt.request)
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestUpdateRecurringRun(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &apiv2beta1.PeriodicSchedule{
+				IntervalSecond: 3600,
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			PipelineRoot: "model-pipeline-root",
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}})
+	assert.Nil(t, err)
+
+	updatedRecurringRun, err := server.UpdateRecurringRun(nil, &apiv2beta1.UpdateRecurringRunRequest{
+		RecurringRun: &apiv2beta1.RecurringRun{
+			RecurringRunId: createdRecurringRun.GetRecurringRunId(),
+			// Fields missing from the update mask are ignored.
+			DisplayName:    "recurring_run_2",
+			MaxConcurrency: 5,
+			Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+					Cron: "0 0 * * * *",
+				}},
+			},
+			RuntimeConfig: &apiv2beta1.RuntimeConfig{
+				PipelineRoot: "model-pipeline-root",
+				Parameters: map[string]*structpb.Value{
+					"param1": structpb.NewStringValue("kubeflow"),
+				},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"trigger", "max_concurrency", "runtime_config"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, createdRecurringRun.GetRecurringRunId(), updatedRecurringRun.GetRecurringRunId())
+	assert.Equal(t, "recurring_run_1", updatedRecurringRun.GetDisplayName())
+	assert.Equal(t, int64(5), updatedRecurringRun.GetMaxConcurrency())
+	assert.Equal(t, "0 0 * * * *", updatedRecurringRun.GetTrigger().GetCronSchedule().GetCron())
+	assert.Equal(t, "kubeflow", updatedRecurringRun.GetRuntimeConfig().GetParameters()["param1"].GetStringValue())
+	assert.Equal(t, apiv2beta1.RecurringRun_ENABLE, updatedRecurringRun.GetMode())
+
+	fetchedRecurringRun, err := server.GetRecurringRun(nil, &apiv2beta1.GetRecurringRunRequest{RecurringRunId: createdRecurringRun.GetRecurringRunId()})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), fetchedRecurringRun.GetMaxConcurrency())
+	assert.Equal(t, "0 0 * * * *", fetchedRecurringRun.GetTrigger().GetCronSchedule().GetCron())
+}
+
+func TestUpdateRecurringRun_RunCompletionTrigger_OtherNamespace(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	clients.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false})
+	_, err := manager.CreateExperiment(&model.Experiment{Name: "exp2", Namespace: "ns2"})
+	require.Nil(t, err)
+	clients.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(DefaultFakeUUID, nil))
+
+	server := NewJobServer(resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false}), &JobServerOptions{CollectMetrics: false})
+	createdRecurringRun, err := server.CreateRecurringRun(ctx, &apiv2beta1.CreateRecurringRunRequest{
+		RecurringRun: newRunCompletionRecurringRun(DefaultFakeUUID, DefaultFakeUUID, ""),
+	})
+	require.Nil(t, err)
+	update := &apiv2beta1.UpdateRecurringRunRequest{
+		RecurringRun: newRunCompletionRecurringRun(DefaultFakeUUID, FakeUUIDOne, ""),
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"trigger"}},
+	}
+	update.RecurringRun.RecurringRunId = createdRecurringRun.GetRecurringRunId()
+
+	// The trigger can't be moved to the experiment of another namespace.
+	_, err = server.UpdateRecurringRun(ctx, update)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Run completion trigger experiment "+FakeUUIDOne+" not found in namespace \"ns1\"")
+	assert.NotContains(t, err.Error(), "ns2")
+	fetchedRecurringRun, err := server.GetRecurringRun(ctx, &apiv2beta1.GetRecurringRunRequest{RecurringRunId: createdRecurringRun.GetRecurringRunId()})
+	require.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, fetchedRecurringRun.GetTrigger().GetRunCompletionTrigger().GetExperimentId())
+
+	// Unauthorized users don't learn anything about the experiment.
+	clients.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server = NewJobServer(resource.NewResourceManager(clients, &resource.ResourceManagerOptions{CollectMetrics: false}), &JobServerOptions{CollectMetrics: false})
+	_, err = server.UpdateRecurringRun(ctx, update)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+	assert.NotContains(t, err.Error(), "Run completion trigger")
+}
+
+func TestUpdateRecurringRun_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		MaxConcurrency: 1,
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}})
+	assert.Nil(t, err)
+	recurringRunId := createdRecurringRun.GetRecurringRunId()
+
+	tests := []struct {
+		name    string
+		request *apiv2beta1.UpdateRecurringRunRequest
+		errMsg  string
+	}{
+		{
+			name: "missing id",
+			request: &apiv2beta1.UpdateRecurringRunRequest{
+				RecurringRun: &apiv2beta1.RecurringRun{},
+				UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"no_catchup"}},
+			},
+			errMsg: "missing recurring run id",
+		},
+		{
+			name: "empty update mask",
+			request: &apiv2beta1.UpdateRecurringRunRequest{
+				RecurringRun: &apiv2beta1.RecurringRun{RecurringRunId: recurringRunId},
+			},
+			errMsg: "empty update mask",
+		},
+		{
+			name: "unsupported field",
+			request: &apiv2beta1.UpdateRecurringRunRequest{
+				RecurringRun: &apiv2beta1.RecurringRun{RecurringRunId: recurringRunId, DisplayName: "new name"},
+				UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			},
+			errMsg: "Field display_name of a recurring run cannot be updated",
+		},
+		{
+			name: "max concurrency out of range",
+			request: &apiv2beta1.UpdateRecurringRunRequest{
+				RecurringRun: &apiv2beta1.RecurringRun{RecurringRunId: recurringRunId, MaxConcurrency: 11},
+				UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"max_concurrency"}},
+			},
+			errMsg: "Max concurrency of a recurring run must be at least 1 and at most 10",
+		},
+		{
+			name: "missing pipeline version",
+			request: &apiv2beta1.UpdateRecurringRunRequest{
+				RecurringRun: &apiv2beta1.RecurringRun{
+					RecurringRunId: recurringRunId,
+					PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionReference{
+						PipelineVersionReference: &apiv2beta1.PipelineVersionReference{PipelineId: "123"},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pipeline_version_reference"}},
+			},
+			errMsg: "Pipeline version reference must have a pipeline version id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.UpdateRecurringRun(nil, tt.request)
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateTrigger(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	response, err := server.ValidateTrigger(nil, &apiv2beta1.ValidateTriggerRequest{
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				// 11:00 UTC.
+				Cron:      "0 0 12 * * *",
+				TimeZone:  "Europe/Paris",
+				StartTime: &timestamp.Timestamp{Seconds: 24 * 3600},
+				EndTime:   &timestamp.Timestamp{Seconds: 72 * 3600},
+			}},
+		},
+		Count: 5,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*timestamp.Timestamp{{Seconds: 35 * 3600}, {Seconds: 59 * 3600}}, response.TriggerTimes)
+}
+
+func TestValidateTrigger_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	tests := []struct {
+		name    string
+		request *apiv2beta1.ValidateTriggerRequest
+		errMsg  string
+	}{
+		{
+			name:    "missing trigger",
+			request: &apiv2beta1.ValidateTriggerRequest{},
+			errMsg:  "trigger must be set",
+		},
+		{
+			name: "invalid cron",
+			request: &apiv2beta1.ValidateTriggerRequest{Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{Cron: "every hour"}},
+			}},
+			errMsg: "Schedule cron is not a supported format",
+		},
+		{
+			name: "invalid time zone",
+			request: &apiv2beta1.ValidateTriggerRequest{Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+					Cron: "0 0 * * * *", TimeZone: "Mars/Olympus_Mons",
+				}},
+			}},
+			errMsg: "not a valid IANA time zone",
+		},
+		{
+			name: "event-driven trigger",
+			request: &apiv2beta1.ValidateTriggerRequest{Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_ObjectStoreTrigger{ObjectStoreTrigger: &apiv2beta1.ObjectStoreTrigger{
+					Uri: "s3://bucket/data/",
+				}},
+			}},
+			errMsg: "neither a cron nor a periodic schedule",
+		},
+		{
+			name: "count out of range",
+			request: &apiv2beta1.ValidateTriggerRequest{
+				Trigger: &apiv2beta1.Trigger{
+					Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &apiv2beta1.PeriodicSchedule{IntervalSecond: 60}},
+				},
+				Count: 101,
+			},
+			errMsg: "Count must be between 1 and 100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.ValidateTrigger(nil, tt.request)
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestPreviewRecurringRunTriggers(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				StartTime: &timestamp.Timestamp{Seconds: 3600},
+				Cron:      "0 0 * * * *",
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			PipelineRoot: "model-pipeline-root",
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}
+
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: apiRecurringRun})
+	assert.Nil(t, err)
+
+	response, err := server.PreviewRecurringRunTriggers(nil, &apiv2beta1.PreviewRecurringRunTriggersRequest{
+		RecurringRunId: createdRecurringRun.RecurringRunId,
+		Count:          2,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*timestamp.Timestamp{{Seconds: 2 * 3600}, {Seconds: 3 * 3600}}, response.TriggerTimes)
+
+	_, err = server.PreviewRecurringRunTriggers(nil, &apiv2beta1.PreviewRecurringRunTriggersRequest{
+		RecurringRunId: createdRecurringRun.RecurringRunId,
+		Count:          -1,
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Count must be between 1 and 100")
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type NotificationServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// Creates a notification subscription to the runs of a namespace or of an
+// experiment.
+func (s *NotificationServer) CreateNotificationSubscription(ctx context.Context, request *apiv2beta1.CreateNotificationSubscriptionRequest) (*apiv2beta1.NotificationSubscription, error) {
+	subscription := toModelNotificationSubscription(request.GetSubscription())
+	namespace, err := s.getNamespace(subscription.Namespace, subscription.ExperimentId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create a notification subscription")
+	}
+	subscription.Namespace = namespace
+	err = s.canAccessNotificationSubscriptions(ctx, namespace, common.RbacResourceVerbCreate)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create a notification subscription due to authorization error")
+	}
+	newSubscription, err := s.resourceManager.CreateNotificationSubscription(subscription)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create a notification subscription")
+	}
+	return toApiNotificationSubscription(newSubscription), nil
+}
+
+// Fetches a notification subscription.
+func (s *NotificationServer) GetNotificationSubscription(ctx context.Context, request *apiv2beta1.GetNotificationSubscriptionRequest) (*apiv2beta1.NotificationSubscription, error) {
+	subscription, err := s.getAuthorizedSubscription(ctx, request.GetSubscriptionId(), common.RbacResourceVerbGet)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get a notification subscription")
+	}
+	return toApiNotificationSubscription(subscription), nil
+}
+
+// Fetches the notification subscriptions of a namespace or of an experiment.
+func (s *NotificationServer) ListNotificationSubscriptions(ctx context.Context, request *apiv2beta1.ListNotificationSubscriptionsRequest) (*apiv2beta1.ListNotificationSubscriptionsResponse, error) {
+	namespace, err := s.getNamespace(request.GetNamespace(), request.GetExperimentId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list notification subscriptions")
+	}
+	err = s.canAccessNotificationSubscriptions(ctx, namespace, common.RbacResourceVerbList)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list notification subscriptions due to authorization error")
+	}
+	opts, err := validatedListOptions(&model.NotificationSubscription{}, request.GetPageToken(), int(request.GetPageSize()), request.GetSortBy(), request.GetFilter(), "v2beta1")
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	subscriptions, totalSize, nextPageToken, err := s.resourceManager.ListNotificationSubscriptions(namespace, request.GetExperimentId(), opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list notification subscriptions")
+	}
+	return &apiv2beta1.ListNotificationSubscriptionsResponse{
+		Subscriptions: toApiNotificationSubscriptions(subscriptions),
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Deletes a notification subscription and its delivery history.
+func (s *NotificationServer) DeleteNotificationSubscription(ctx context.Context, request *apiv2beta1.DeleteNotificationSubscriptionRequest) (*empty.Empty, error) {
+	if _, err := s.getAuthorizedSubscription(ctx, request.GetSubscriptionId(), common.RbacResourceVerbDelete); err != nil {
+		return nil, util.Wrap(err, "Failed to delete a notification subscription")
+	}
+	if err := s.resourceManager.DeleteNotificationSubscription(request.GetSubscriptionId()); err != nil {
+		return nil, util.Wrap(err, "Failed to delete a notification subscription")
+	}
+	return &empty.Empty{}, nil
+}
+
+// Fetches the deliveries of the notifications of a subscription.
+func (s *NotificationServer) ListNotificationDeliveries(ctx context.Context, request *apiv2beta1.ListNotificationDeliveriesRequest) (*apiv2beta1.ListNotificationDeliveriesResponse, error) {
+	if _, err := s.getAuthorizedSubscription(ctx, request.GetSubscriptionId(), common.RbacResourceVerbGet); err != nil {
+		return nil, util.Wrap(err, "Failed to list notification deliveries")
+	}
+	opts, err := validatedListOptions(&model.NotificationDelivery{}, request.GetPageToken(), int(request.GetPageSize()), request.GetSortBy(), request.GetFilter(), "v2beta1")
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	deliveries, totalSize, nextPageToken, err := s.resourceManager.ListNotificationDeliveries(request.GetSubscriptionId(), opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list notification deliveries")
+	}
+	return &apiv2beta1.ListNotificationDeliveriesResponse{
+		Deliveries:    toApiNotificationDeliveries(deliveries),
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// getNamespace returns the namespace of the experiment, if experimentId is not
+// empty, else the requested namespace.
+func (s *NotificationServer) getNamespace(namespace string, experimentId string) (string, error) {
+	if experimentId != "" {
+		return s.resourceManager.GetNamespaceFromExperimentId(experimentId)
+	}
+	return s.resourceManager.ReplaceNamespace(namespace), nil
+}
+
+// getAuthorizedSubscription fetches a subscription, if the user can access the
+// subscriptions of its namespace.
+func (s *NotificationServer) getAuthorizedSubscription(ctx context.Context, subscriptionId string, verb string) (*model.NotificationSubscription, error) {
+	if subscriptionId == "" {
+		return nil, util.NewInvalidInputError("Subscription ID cannot be empty")
+	}
+	subscription, err := s.resourceManager.GetNotificationSubscription(subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canAccessNotificationSubscriptions(ctx, subscription.Namespace, verb); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return subscription, nil
+}
+
+// Checks if a user can access the notification subscriptions of a namespace.
+func (s *NotificationServer) canAccessNotificationSubscriptions(ctx context.Context, namespace string, verb string) error {
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return nil
+	}
+	if s.resourceManager.IsEmptyNamespace(namespace) {
+		return util.NewInvalidInputError("A notification subscription cannot have an empty namespace in multi-user mode")
+	}
+	return s.resourceManager.IsAuthorized(ctx, &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeNotificationSubscriptions,
+	})
+}
+
+func NewNotificationServer(resourceManager *resource.ResourceManager) *NotificationServer {
+	return &NotificationServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNotificationSubscriptions(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	server := NewNotificationServer(resourceManager)
+
+	subscription, err := server.CreateNotificationSubscription(context.Background(), &apiv2beta1.CreateNotificationSubscriptionRequest{
+		Subscription: &apiv2beta1.NotificationSubscription{
+			DisplayName:     "failures",
+			ExperimentId:    experiment.UUID,
+			States:          []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_FAILED, apiv2beta1.RuntimeState_CANCELED},
+			TargetUrl:       "https://hooks.example.com/run",
+			PayloadTemplate: `{"text": {{json .RunName}}}`,
+			Secret:          "secret",
+		},
+	})
+	require.Nil(t, err)
+	expected := &apiv2beta1.NotificationSubscription{
+		SubscriptionId:  DefaultFakeUUID,
+		DisplayName:     "failures",
+		Namespace:       experiment.Namespace,
+		ExperimentId:    experiment.UUID,
+		States:          []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_FAILED, apiv2beta1.RuntimeState_CANCELED},
+		TargetUrl:       "https://hooks.example.com/run",
+		PayloadTemplate: `{"text": {{json .RunName}}}`,
+		CreatedAt:       subscription.GetCreatedAt(),
+	}
+	// The secret is never returned.
+	assert.Equal(t, expected, subscription)
+
+	fetched, err := server.GetNotificationSubscription(context.Background(), &apiv2beta1.GetNotificationSubscriptionRequest{SubscriptionId: subscription.SubscriptionId})
+	require.Nil(t, err)
+	assert.Equal(t, expected, fetched)
+
+	subscriptions, err := server.ListNotificationSubscriptions(context.Background(), &apiv2beta1.ListNotificationSubscriptionsRequest{ExperimentId: experiment.UUID})
+	require.Nil(t, err)
+	assert.Equal(t, &apiv2beta1.ListNotificationSubscriptionsResponse{
+		Subscriptions: []*apiv2beta1.NotificationSubscription{expected},
+		TotalSize:     1,
+	}, subscriptions)
+
+	_, err = clientManager.NotificationStore().CreateNotificationDelivery(&model.NotificationDelivery{
+		SubscriptionId: subscription.SubscriptionId,
+		RunId:          "run1",
+		State:          model.RuntimeStateFailed,
+		Status:         model.NotificationDeliveryFailed,
+		Attempts:       5,
+		ResponseCode:   500,
+		ErrorMessage:   "Notification rejected",
+	})
+	require.Nil(t, err)
+	deliveries, err := server.ListNotificationDeliveries(context.Background(), &apiv2beta1.ListNotificationDeliveriesRequest{SubscriptionId: subscription.SubscriptionId})
+	require.Nil(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	assert.Equal(t, int32(1), deliveries.TotalSize)
+	delivery := deliveries.Deliveries[0]
+	assert.Equal(t, "run1", delivery.RunId)
+	assert.Equal(t, apiv2beta1.RuntimeState_FAILED, delivery.State)
+	assert.Equal(t, apiv2beta1.NotificationDelivery_FAILED, delivery.Status)
+	assert.Equal(t, int32(5), delivery.Attempts)
+	assert.Equal(t, int32(500), delivery.ResponseCode)
+
+	_, err = server.DeleteNotificationSubscription(context.Background(), &apiv2beta1.DeleteNotificationSubscriptionRequest{SubscriptionId: subscription.SubscriptionId})
+	require.Nil(t, err)
+	_, err = server.GetNotificationSubscription(context.Background(), &apiv2beta1.GetNotificationSubscriptionRequest{SubscriptionId: subscription.SubscriptionId})
+	require.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestCreateNotificationSubscription_InvalidInput(t *testing.T) {
+	clientManager, resourceManager, _ := initWithExperiment(t)
+	defer clientManager.Close()
+	server := NewNotificationServer(resourceManager)
+
+	_, err := server.CreateNotificationSubscription(context.Background(), &apiv2beta1.CreateNotificationSubscriptionRequest{
+		Subscription: &apiv2beta1.NotificationSubscription{DisplayName: "failures", TargetUrl: "ftp://hooks.example.com"},
+	})
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Invalid target URL")
+
+	_, err = server.GetNotificationSubscription(context.Background(), &apiv2beta1.GetNotificationSubscriptionRequest{})
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestNotificationSubscriptions_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, resourceManager, _ := initWithExperiment(t)
+	defer clientManager.Close()
+	subscription, err := resourceManager.CreateNotificationSubscription(&model.NotificationSubscription{
+		DisplayName: "failures",
+		Namespace:   "ns1",
+		TargetURL:   "https://hooks.example.com/run",
+	})
+	require.Nil(t, err)
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	resourceManager = resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	server := NewNotificationServer(resourceManager)
+
+	_, err = server.CreateNotificationSubscription(ctx, &apiv2beta1.CreateNotificationSubscriptionRequest{
+		Subscription: &apiv2beta1.NotificationSubscription{DisplayName: "failures", Namespace: "ns1", TargetUrl: "https://hooks.example.com/run"},
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+
+	_, err = server.ListNotificationSubscriptions(ctx, &apiv2beta1.ListNotificationSubscriptionsRequest{Namespace: "ns1"})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+
+	_, err = server.ListNotificationDeliveries(ctx, &apiv2beta1.ListNotificationDeliveriesRequest{SubscriptionId: subscription.UUID})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+
+	_, err = server.DeleteNotificationSubscription(ctx, &apiv2beta1.DeleteNotificationSubscriptionRequest{SubscriptionId: subscription.UUID})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+}
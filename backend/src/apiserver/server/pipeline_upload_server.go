@@ -15,7 +15,6 @@
 package server
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,6 +22,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/jsonpb"
 	apiv1beta1 "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
@@ -135,6 +135,7 @@ func (s *PipelineUploadServer) uploadPipeline(api_version string, w http.Respons
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Failed to create a pipeline and a pipeline version"))
 		return
 	}
+	audit.AddResourceIds(r.Context(), newPipeline.UUID, newPipelineVersion.UUID)
 
 	if s.options.CollectMetrics {
 		pipelineVersionCount.Inc()
@@ -226,6 +227,7 @@ func (s *PipelineUploadServer) uploadPipelineVersion(api_version string, w http.
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Failed to create a pipeline version"))
 		return
 	}
+	audit.AddResourceIds(r.Context(), newPipelineVersion.UUID)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -270,7 +272,7 @@ func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, pipel
 	resourceAttributes.Version = common.RbacPipelinesVersion
 	resourceAttributes.Resource = common.RbacResourceTypePipelines
 
-	ctx := context.Background()
+	ctx := r.Context()
 	md := metadata.MD{}
 	for key, values := range r.Header {
 		md.Set(key, values...)
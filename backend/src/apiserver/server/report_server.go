@@ -17,6 +17,8 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"strings"
+
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	authorizationv1 "k8s.io/api/authorization/v1"
 
@@ -27,6 +29,8 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
 )
 
 type ReportServer struct {
@@ -45,6 +49,9 @@ func (s ReportServer) reportTasksFromExecution(execSpec util.ExecutionSpec, runI
 	return s.resourceManager.CreateOrUpdateTasks(tasks)
 }
 
+// The maximum number of workflows reported in a batch.
+const maxReportWorkflowsBatchSize = 100
+
 // Reports a workflow.
 func (s *ReportServer) reportWorkflow(ctx context.Context, workflow string) (*empty.Empty, error) {
 	execSpec, err := validateReportWorkflowRequest(workflow)
@@ -62,17 +69,69 @@ func (s *ReportServer) reportWorkflow(ctx context.Context, workflow string) (*em
 		return nil, err
 	}
 
-	newExecSpec, err := s.resourceManager.ReportWorkflowResource(ctx, *execSpec)
+	if err := s.reportExecution(ctx, *execSpec); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// Reports a batch of workflows. The batch is authorized once, and the workflows
+// are reported independently, so the result of each workflow is returned.
+func (s *ReportServer) reportWorkflows(ctx context.Context, workflows []string) ([]*status.Status, error) {
+	if len(workflows) == 0 {
+		return nil, util.NewInvalidInputError("Report workflows failed: the batch of workflows cannot be empty")
+	}
+	if len(workflows) > maxReportWorkflowsBatchSize {
+		return nil, util.NewInvalidInputError("Report workflows failed: the batch has %v workflows, more than the maximum of %v", len(workflows), maxReportWorkflowsBatchSize)
+	}
+	execSpecs := make([]util.ExecutionSpec, len(workflows))
+	errs := make([]error, len(workflows))
+	var executionNames []string
+	for i, workflow := range workflows {
+		execSpec, err := validateReportWorkflowRequest(workflow)
+		if err != nil {
+			errs[i] = util.Wrap(err, "Report workflow failed")
+			continue
+		}
+		execSpecs[i] = *execSpec
+		executionNames = append(executionNames, (*execSpec).ExecutionName())
+	}
+
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbReport,
+		Resource: common.RbacResourceTypeWorkflows,
+	}
+	if err := s.canAccessWorkflow(ctx, strings.Join(executionNames, ","), resourceAttributes); err != nil {
+		return nil, err
+	}
+
+	results := make([]*status.Status, len(workflows))
+	for i, execSpec := range execSpecs {
+		if errs[i] == nil {
+			errs[i] = s.reportExecution(ctx, execSpec)
+		}
+		if errs[i] != nil {
+			results[i] = util.ToGRPCStatus(errs[i]).Proto()
+		} else {
+			results[i] = &status.Status{Code: int32(codes.OK)}
+		}
+	}
+	return results, nil
+}
+
+// Reports a validated workflow and its tasks.
+func (s *ReportServer) reportExecution(ctx context.Context, execSpec util.ExecutionSpec) error {
+	newExecSpec, err := s.resourceManager.ReportWorkflowResource(ctx, execSpec)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to report workflow")
+		return util.Wrap(err, "Failed to report workflow")
 	}
 
 	runId := newExecSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowRunId]
 	_, err = s.reportTasksFromExecution(newExecSpec, runId)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to report task details")
+		return util.Wrap(err, "Failed to report task details")
 	}
-	return &empty.Empty{}, nil
+	return nil
 }
 
 func (s *ReportServer) ReportWorkflowV1(ctx context.Context,
@@ -87,6 +146,26 @@ func (s *ReportServer) ReportWorkflow(ctx context.Context,
 	return s.reportWorkflow(ctx, request.GetWorkflow())
 }
 
+func (s *ReportServer) ReportWorkflowsV1(ctx context.Context,
+	request *apiv1beta1.ReportWorkflowsRequest,
+) (*apiv1beta1.ReportWorkflowsResponse, error) {
+	results, err := s.reportWorkflows(ctx, request.GetWorkflows())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1beta1.ReportWorkflowsResponse{Results: results}, nil
+}
+
+func (s *ReportServer) ReportWorkflows(ctx context.Context,
+	request *apiv2beta1.ReportWorkflowsRequest,
+) (*apiv2beta1.ReportWorkflowsResponse, error) {
+	results, err := s.reportWorkflows(ctx, request.GetWorkflows())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2beta1.ReportWorkflowsResponse{Results: results}, nil
+}
+
 // Reports a scheduled workflow.
 func (s *ReportServer) reportScheduledWorkflow(ctx context.Context, swf string) (*empty.Empty, error) {
 	scheduledWorkflow, err := validateReportScheduledWorkflowRequest(swf)
@@ -121,6 +200,34 @@ func (s *ReportServer) ReportScheduledWorkflow(ctx context.Context,
 	return s.reportScheduledWorkflow(ctx, request.GetScheduledWorkflow())
 }
 
+// Builds the workflow of the next run of a recurring run that uses the latest version of its pipeline.
+func (s *ReportServer) MaterializeScheduledWorkflow(ctx context.Context,
+	request *apiv2beta1.MaterializeScheduledWorkflowRequest,
+) (*apiv2beta1.MaterializeScheduledWorkflowResponse, error) {
+	if request.GetRecurringRunId() == "" {
+		return nil, util.NewInvalidInputError("Failed to materialize a scheduled workflow due to missing recurring run id")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbReport,
+		Resource: common.RbacResourceTypeScheduledWorkflows,
+	}
+	if err := s.canAccessWorkflow(ctx, request.GetRecurringRunId(), resourceAttributes); err != nil {
+		return nil, err
+	}
+	workflow, pipelineVersionId, err := s.resourceManager.MaterializeJobWorkflow(request.GetRecurringRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to materialize a scheduled workflow")
+	}
+	workflowJSON, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to marshal the workflow of recurring run %v", request.GetRecurringRunId())
+	}
+	return &apiv2beta1.MaterializeScheduledWorkflowResponse{
+		PipelineVersionId: pipelineVersionId,
+		Workflow:          string(workflowJSON),
+	}, nil
+}
+
 func validateReportWorkflowRequest(wfManifest string) (*util.ExecutionSpec, error) {
 	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(wfManifest))
 	if err != nil {
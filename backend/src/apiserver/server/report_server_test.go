@@ -15,12 +15,14 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
 	apiv2 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/stretchr/testify/assert"
@@ -160,6 +162,100 @@ func TestReportWorkflow_ValidationFailed(t *testing.T) {
 	assert.Contains(t, err.Error(), "must have a name")
 }
 
+func TestReportWorkflowsV1(t *testing.T) {
+	clientManager, resourceManager, run := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	reportServer := NewReportServer(resourceManager)
+
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Workflow",
+			APIVersion: "argoproj.io/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "run1",
+			Namespace: "default",
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning},
+	})
+	unnamedWorkflow := util.NewWorkflow(&v1alpha1.Workflow{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Workflow",
+			APIVersion: "argoproj.io/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			UID:       types.UID(run.UUID),
+		},
+	})
+	response, err := reportServer.ReportWorkflowsV1(nil, &api.ReportWorkflowsRequest{
+		Workflows: []string{unnamedWorkflow.ToStringForStore(), workflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	// The invalid workflow does not fail the batch.
+	assert.Len(t, response.GetResults(), 2)
+	assert.Equal(t, int32(codes.InvalidArgument), response.GetResults()[0].GetCode())
+	assert.Contains(t, response.GetResults()[0].GetMessage(), "must have a name")
+	assert.Equal(t, int32(codes.OK), response.GetResults()[1].GetCode())
+	run, err = resourceManager.GetRun(run.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStateRunning, run.State)
+}
+
+func TestReportWorkflows_InvalidBatch(t *testing.T) {
+	clientManager, resourceManager, _ := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	reportServer := NewReportServer(resourceManager)
+
+	_, err := reportServer.ReportWorkflows(nil, &apiv2.ReportWorkflowsRequest{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cannot be empty")
+
+	_, err = reportServer.ReportWorkflows(nil, &apiv2.ReportWorkflowsRequest{
+		Workflows: make([]string, maxReportWorkflowsBatchSize+1),
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "more than the maximum")
+}
+
+func TestMaterializeScheduledWorkflow(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	pipeline, err := resourceManager.CreatePipeline(&model.Pipeline{Name: "p1"})
+	assert.Nil(t, err)
+	version, err := resourceManager.CreatePipelineVersion(&model.PipelineVersion{
+		Name:         "v1",
+		PipelineId:   pipeline.UUID,
+		PipelineSpec: testWorkflow.ToStringForStore(),
+	})
+	assert.Nil(t, err)
+	job, err := resourceManager.CreateJob(context.Background(), &model.Job{
+		DisplayName:              "j1",
+		Enabled:                  true,
+		ExperimentId:             experiment.UUID,
+		PipelineSpec:             model.PipelineSpec{PipelineId: pipeline.UUID},
+		UseLatestPipelineVersion: true,
+	})
+	assert.Nil(t, err)
+	reportServer := NewReportServer(resourceManager)
+
+	response, err := reportServer.MaterializeScheduledWorkflow(context.Background(),
+		&apiv2.MaterializeScheduledWorkflowRequest{RecurringRunId: job.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, version.UUID, response.GetPipelineVersionId())
+	var workflow swfapi.WorkflowResource
+	assert.Nil(t, json.Unmarshal([]byte(response.GetWorkflow()), &workflow))
+	execSpec, err := util.ScheduleSpecToExecutionSpec(util.ArgoWorkflow, &workflow)
+	assert.Nil(t, err)
+	assert.Equal(t, version.UUID, execSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowPipelineVersionId])
+
+	_, err = reportServer.MaterializeScheduledWorkflow(context.Background(), &apiv2.MaterializeScheduledWorkflowRequest{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "missing recurring run id")
+}
+
 func TestValidateReportWorkflowRequest(t *testing.T) {
 	// Name
 	workflow := &v1alpha1.Workflow{
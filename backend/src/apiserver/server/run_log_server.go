@@ -15,26 +15,63 @@
 package server
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
 const (
 	RunKey  = "run_id"
 	NodeKey = "node_id"
 	Follow  = "follow"
+
+	// The query parameters of the run logs endpoint.
+	SinceTimeKey = "since_time"
+	UntilTimeKey = "until_time"
+	FilterKey    = "filter"
+	RegexKey     = "regex"
+	TailKey      = "tail"
+	FormatKey    = "format"
+
+	// The query parameter resuming a run log stream.
+	CursorKey = "cursor"
+)
+
+const (
+	// The header of the ID of the last server-sent event received by a client.
+	lastEventIdHeader = "Last-Event-ID"
+	// The interval of the comments keeping the run log event streams alive.
+	runLogKeepaliveInterval = 15 * time.Second
+)
+
+// The formats of the run logs endpoint.
+const (
+	runLogFormatJSON = "json-lines"
+	runLogFormatText = "text"
 )
 
 type RunLogServer struct {
 	resourceManager *resource.ResourceManager
-	httpClient      *http.Client
+	// runServer authorizes access to the run owning the logs.
+	runServer  *RunServer
+	httpClient *http.Client
 }
 
 // Log streaming endpoint
@@ -62,12 +99,246 @@ func (s *RunLogServer) ReadRunLogV1(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/plain")
 	w.Header().Set("Cache-Control", "no-cache, private")
 
-	err := s.resourceManager.ReadLog(context.Background(), runId, nodeId, follow, w)
+	err := s.resourceManager.ReadLog(r.Context(), runId, nodeId, follow, w)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusInternalServerError, err)
 	}
 }
 
+// Reads the logs of the nodes of a run, from their pods or from the log archive,
+// as JSON lines with the node ID and timestamp of each entry, or as text. The
+// node_id query parameter, which can be repeated, selects nodes, since_time and
+// until_time (RFC 3339) select a time range, filter and regex select entries
+// containing a substring or matching a regular expression, and tail selects the
+// last entries of each node.
+// This endpoint is not exposed through grpc endpoint, since grpc-gateway cannot handle native HTTP content streaming.
+func (s *RunLogServer) ReadRunLogs(w http.ResponseWriter, r *http.Request) {
+	runId := mux.Vars(r)[RunKey]
+	opts, format, err := parseRunLogOptions(r.URL.Query())
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	ctx := requestContext(r)
+	err = s.runServer.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+
+	written := false
+	err = s.resourceManager.ReadRunLogEntries(ctx, runId, opts, func(entry *resource.RunLogEntry) error {
+		if !written {
+			if format == runLogFormatText {
+				w.Header().Set("Content-Type", "text/plain")
+			} else {
+				w.Header().Set("Content-Type", "application/x-ndjson")
+			}
+			w.Header().Set("Cache-Control", "no-cache, private")
+			w.WriteHeader(http.StatusOK)
+			written = true
+		}
+		if format == runLogFormatText {
+			if entry.Timestamp.IsZero() {
+				_, err := fmt.Fprintf(w, "%s %s\n", entry.NodeId, entry.Log)
+				return err
+			}
+			_, err := fmt.Fprintf(w, "%s %s %s\n", entry.NodeId, entry.Timestamp.Format(time.RFC3339Nano), entry.Log)
+			return err
+		}
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(line, '\n'))
+		return err
+	})
+	if err != nil {
+		if written {
+			// The response has started, the error can only be logged.
+			glog.Errorf("Failed to read the logs of run %v. Error: %+v", runId, err)
+			return
+		}
+		s.writeErrorToResponse(w, httpStatusFromError(err), err)
+		return
+	}
+	if !written {
+		w.Header().Set("Cache-Control", "no-cache, private")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// StreamRunLogs streams the logs of the pods of a run until the run finishes.
+// Supports v2beta1 API.
+func (s *RunLogServer) StreamRunLogs(request *apiv2beta1.StreamRunLogsRequest, stream apiv2beta1.RunLogService_StreamRunLogsServer) error {
+	if request.GetRunId() == "" {
+		return util.NewInvalidInputError("Failed to stream run logs: run ID cannot be empty")
+	}
+	opts, err := toRunLogStreamOptions(request.GetNodeIds(), request.GetCursor())
+	if err != nil {
+		return util.Wrap(err, "Failed to stream run logs due to invalid request")
+	}
+	ctx := stream.Context()
+	err = s.runServer.canAccessRun(ctx, request.GetRunId(), &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request")
+	}
+	err = s.resourceManager.StreamRunLogEntries(ctx, request.GetRunId(), opts, func(entry *resource.RunLogStreamEntry) error {
+		return stream.Send(toApiRunLogEntry(entry))
+	})
+	if err != nil {
+		return util.Wrap(err, "Failed to stream run logs")
+	}
+	return nil
+}
+
+// Streams the logs of the pods of a run as server-sent events, until the run
+// finishes. The ID of each event is the cursor of its entry, so that EventSource
+// clients resume the stream after a disconnection with the Last-Event-ID header.
+// The cursor query parameter resumes it explicitly, and the node_id query
+// parameter, which can be repeated, selects nodes. An "end" event completes the
+// stream, an "error" event reports the errors after the stream started, and
+// comments keep the connection alive.
+// This endpoint is the HTTP counterpart of the StreamRunLogs RPC, since grpc-gateway
+// cannot serve server-sent events.
+func (s *RunLogServer) StreamRunLogEvents(w http.ResponseWriter, r *http.Request) {
+	runId := mux.Vars(r)[RunKey]
+	cursor := r.URL.Query().Get(CursorKey)
+	if lastEventId := r.Header.Get(lastEventIdHeader); lastEventId != "" {
+		cursor = lastEventId
+	}
+	opts, err := toRunLogStreamOptions(r.URL.Query()[NodeKey], cursor)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.writeErrorToResponse(w, http.StatusInternalServerError, fmt.Errorf("the response cannot be streamed"))
+		return
+	}
+	ctx := requestContext(r)
+	err = s.runServer.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache, private")
+	// Disables the buffering of the responses by proxies such as nginx.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// The events and the keepalive comments are written concurrently.
+	var mu sync.Mutex
+	write := func(format string, a ...interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, err := fmt.Fprintf(w, format, a...); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(runLogKeepaliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				write(": keepalive\n\n")
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	err = s.resourceManager.StreamRunLogEntries(ctx, runId, opts, func(entry *resource.RunLogStreamEntry) error {
+		data, err := marshaler.MarshalToString(toApiRunLogEntry(entry))
+		if err != nil {
+			return err
+		}
+		return write("id: %s\ndata: %s\n\n", entry.Cursor, data)
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			// The client disconnected.
+			return
+		}
+		glog.Errorf("Failed to stream the logs of run %v. Error: %+v", runId, err)
+		data, _ := json.Marshal(&api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)})
+		write("event: error\ndata: %s\n\n", data)
+		return
+	}
+	write("event: end\ndata: {}\n\n")
+}
+
+// toRunLogStreamOptions validates the node IDs and the cursor of a run log stream.
+func toRunLogStreamOptions(nodeIds []string, cursor string) (*resource.RunLogStreamOptions, error) {
+	opts := &resource.RunLogStreamOptions{NodeIds: nodeIds}
+	if cursor != "" {
+		var err error
+		if opts.Cursor, err = resource.ParseRunLogCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// parseRunLogOptions parses the query parameters of the run logs endpoint.
+func parseRunLogOptions(query url.Values) (*resource.RunLogOptions, string, error) {
+	opts := &resource.RunLogOptions{
+		NodeIds: query[NodeKey],
+		Filter:  query.Get(FilterKey),
+	}
+	var err error
+	if value := query.Get(SinceTimeKey); value != "" {
+		if opts.SinceTime, err = time.Parse(time.RFC3339, value); err != nil {
+			return nil, "", util.NewInvalidInputError("Invalid %v %q, expected an RFC 3339 time", SinceTimeKey, value)
+		}
+	}
+	if value := query.Get(UntilTimeKey); value != "" {
+		if opts.UntilTime, err = time.Parse(time.RFC3339, value); err != nil {
+			return nil, "", util.NewInvalidInputError("Invalid %v %q, expected an RFC 3339 time", UntilTimeKey, value)
+		}
+	}
+	if !opts.SinceTime.IsZero() && !opts.UntilTime.IsZero() && opts.UntilTime.Before(opts.SinceTime) {
+		return nil, "", util.NewInvalidInputError("Invalid time range, %v is before %v", UntilTimeKey, SinceTimeKey)
+	}
+	if value := query.Get(RegexKey); value != "" {
+		if opts.Regexp, err = regexp.Compile(value); err != nil {
+			return nil, "", util.NewInvalidInputError("Invalid %v %q: %v", RegexKey, value, err)
+		}
+	}
+	if value := query.Get(TailKey); value != "" {
+		if opts.Tail, err = strconv.Atoi(value); err != nil || opts.Tail < 0 {
+			return nil, "", util.NewInvalidInputError("Invalid %v %q, expected a non-negative number of lines", TailKey, value)
+		}
+	}
+	format := query.Get(FormatKey)
+	switch format {
+	case "":
+		format = runLogFormatJSON
+	case runLogFormatJSON, runLogFormatText:
+	default:
+		return nil, "", util.NewInvalidInputError("Invalid %v %q, expected %q or %q", FormatKey, format, runLogFormatJSON, runLogFormatText)
+	}
+	return opts, format, nil
+}
+
+// httpStatusFromError returns the HTTP status of the gRPC code of an error.
+func httpStatusFromError(err error) int {
+	if _, ok := err.(*util.UserError); ok {
+		return runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err)))
+	}
+	return http.StatusInternalServerError
+}
+
 func (s *RunLogServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
 	glog.Errorf("Failed to read run log. Error: %+v", err)
 	w.WriteHeader(code)
@@ -80,5 +351,9 @@ func (s *RunLogServer) writeErrorToResponse(w http.ResponseWriter, code int, err
 }
 
 func NewRunLogServer(resourceManager *resource.ResourceManager) *RunLogServer {
-	return &RunLogServer{resourceManager: resourceManager, httpClient: http.DefaultClient}
+	return &RunLogServer{
+		resourceManager: resourceManager,
+		runServer:       &RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}},
+		httpClient:      http.DefaultClient,
+	}
 }
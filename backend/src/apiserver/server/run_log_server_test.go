@@ -0,0 +1,277 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/gorilla/mux"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initWithRunLogs creates a finished run whose pod node-1 still exists, and
+// whose pod node-2 was deleted after its log was archived.
+func initWithRunLogs(t *testing.T) (*resource.FakeClientManager, *resource.ResourceManager, *model.Run) {
+	clientManager, manager, run := initWithOneTimeRun(t)
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "workflow-name",
+			Namespace: "ns1",
+			UID:       "workflow1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"workflow-name": {ID: "workflow-name", Type: v1alpha1.NodeTypeDAG, StartedAt: v1.NewTime(time.Unix(100, 0))},
+				"node-2":        {ID: "node-2", DisplayName: "evaluate", Type: v1alpha1.NodeTypePod, StartedAt: v1.NewTime(time.Unix(200, 0)), FinishedAt: v1.NewTime(time.Unix(250, 0))},
+				"node-1":        {ID: "node-1", DisplayName: "train", Type: v1alpha1.NodeTypePod, StartedAt: v1.NewTime(time.Unix(100, 0)), FinishedAt: v1.NewTime(time.Unix(150, 0))},
+			},
+			FinishedAt: v1.NewTime(time.Unix(300, 0)),
+		},
+	})
+	_, err := manager.ReportWorkflowResource(context.Background(), workflow)
+	require.Nil(t, err)
+
+	clientManager.KubernetesCoreClient().(*client.FakeKuberneteCoreClient).SetPodLog("node-1",
+		"2020-08-31T15:00:00Z [INFO] Loading data\n"+
+			"2020-08-31T15:00:01Z [INFO] Training\n"+
+			"2020-08-31T15:00:02Z [ERROR] Out of memory\n")
+	require.Nil(t, clientManager.ObjectStore().AddFile([]byte(
+		`{"timestamp": "2020-08-31T15:01:00Z", "log": "[INFO] Evaluating"}`+"\n"+
+			`{"timestamp": "2020-08-31T15:01:01Z", "log": "[WARNING] Low accuracy"}`+"\n"),
+		"/logs/workflow-name/node-2/main.log"))
+	return clientManager, manager, run
+}
+
+func readRunLogs(t *testing.T, manager *resource.ResourceManager, url string) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v2beta1/runs/{run_id}/logs", NewRunLogServer(manager).ReadRunLogs)
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest(http.MethodGet, url, nil))
+	return response
+}
+
+func TestReadRunLogs(t *testing.T) {
+	clientManager, manager, run := initWithRunLogs(t)
+	defer clientManager.Close()
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "all nodes",
+			query: "",
+			want: `{"node_id":"node-1","timestamp":"2020-08-31T15:00:00Z","log":"[INFO] Loading data"}
+{"node_id":"node-1","timestamp":"2020-08-31T15:00:01Z","log":"[INFO] Training"}
+{"node_id":"node-1","timestamp":"2020-08-31T15:00:02Z","log":"[ERROR] Out of memory"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:00Z","log":"[INFO] Evaluating"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:01Z","log":"[WARNING] Low accuracy"}
+`,
+		},
+		{
+			name:  "archived node",
+			query: "?node_id=node-2",
+			want: `{"node_id":"node-2","timestamp":"2020-08-31T15:01:00Z","log":"[INFO] Evaluating"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:01Z","log":"[WARNING] Low accuracy"}
+`,
+		},
+		{
+			name:  "time range",
+			query: "?since_time=2020-08-31T15:00:01Z&until_time=2020-08-31T15:01:00Z",
+			want: `{"node_id":"node-1","timestamp":"2020-08-31T15:00:01Z","log":"[INFO] Training"}
+{"node_id":"node-1","timestamp":"2020-08-31T15:00:02Z","log":"[ERROR] Out of memory"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:00Z","log":"[INFO] Evaluating"}
+`,
+		},
+		{
+			name:  "filter",
+			query: "?filter=%5BINFO%5D",
+			want: `{"node_id":"node-1","timestamp":"2020-08-31T15:00:00Z","log":"[INFO] Loading data"}
+{"node_id":"node-1","timestamp":"2020-08-31T15:00:01Z","log":"[INFO] Training"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:00Z","log":"[INFO] Evaluating"}
+`,
+		},
+		{
+			name:  "regex",
+			query: "?regex=%5E%5C%5B(ERROR%7CWARNING)%5C%5D",
+			want: `{"node_id":"node-1","timestamp":"2020-08-31T15:00:02Z","log":"[ERROR] Out of memory"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:01Z","log":"[WARNING] Low accuracy"}
+`,
+		},
+		{
+			name:  "tail of each node",
+			query: "?tail=1&filter=%5BINFO%5D",
+			want: `{"node_id":"node-1","timestamp":"2020-08-31T15:00:01Z","log":"[INFO] Training"}
+{"node_id":"node-2","timestamp":"2020-08-31T15:01:00Z","log":"[INFO] Evaluating"}
+`,
+		},
+		{
+			name:  "text",
+			query: "?format=text&node_id=node-1&tail=1",
+			want:  "node-1 2020-08-31T15:00:02Z [ERROR] Out of memory\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := readRunLogs(t, manager, "/apis/v2beta1/runs/"+run.UUID+"/logs"+tt.query)
+			assert.Equal(t, http.StatusOK, response.Code)
+			assert.Equal(t, tt.want, response.Body.String())
+		})
+	}
+}
+
+func TestReadRunLogs_InvalidRequest(t *testing.T) {
+	clientManager, manager, run := initWithRunLogs(t)
+	defer clientManager.Close()
+
+	tests := []struct {
+		name     string
+		runId    string
+		query    string
+		wantCode int
+		wantErr  string
+	}{
+		{"invalid since time", run.UUID, "?since_time=yesterday", http.StatusBadRequest, "Invalid since_time"},
+		{"invalid time range", run.UUID, "?since_time=2020-08-31T15:00:01Z&until_time=2020-08-31T15:00:00Z", http.StatusBadRequest, "Invalid time range"},
+		{"invalid regex", run.UUID, "?regex=%5B", http.StatusBadRequest, "Invalid regex"},
+		{"invalid tail", run.UUID, "?tail=-1", http.StatusBadRequest, "Invalid tail"},
+		{"invalid format", run.UUID, "?format=xml", http.StatusBadRequest, "Invalid format"},
+		{"unknown node", run.UUID, "?node_id=node-3", http.StatusInternalServerError, "Failed to read logs"},
+		{"unknown run", "unknown-run", "", http.StatusNotFound, "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := readRunLogs(t, manager, "/apis/v2beta1/runs/"+tt.runId+"/logs"+tt.query)
+			assert.Equal(t, tt.wantCode, response.Code)
+			assert.Contains(t, response.Body.String(), tt.wantErr)
+		})
+	}
+}
+
+func TestStreamRunLogEvents(t *testing.T) {
+	clientManager, manager, run := initWithRunLogs(t)
+	defer clientManager.Close()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v2beta1/runs/{run_id}/logs:stream", NewRunLogServer(manager).StreamRunLogEvents)
+	streamEvents := func(query string, lastEventId string) (*httptest.ResponseRecorder, []string, []string) {
+		request := httptest.NewRequest(http.MethodGet, "/apis/v2beta1/runs/"+run.UUID+"/logs:stream"+query, nil)
+		if lastEventId != "" {
+			request.Header.Set("Last-Event-ID", lastEventId)
+		}
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		var ids, data []string
+		for _, event := range strings.Split(strings.TrimSuffix(response.Body.String(), "\n\n"), "\n\n") {
+			for _, line := range strings.Split(event, "\n") {
+				if strings.HasPrefix(line, "id: ") {
+					ids = append(ids, strings.TrimPrefix(line, "id: "))
+				} else if strings.HasPrefix(line, "data: ") {
+					data = append(data, strings.TrimPrefix(line, "data: "))
+				}
+			}
+		}
+		return response, ids, data
+	}
+
+	response, ids, data := streamEvents("?node_id=node-1", "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/event-stream", response.Header().Get("Content-Type"))
+	require.Len(t, ids, 3)
+	assert.Equal(t, []string{
+		`{"node_id":"node-1","task_name":"train","timestamp":"2020-08-31T15:00:00Z","log":"[INFO] Loading data","cursor":"` + ids[0] + `"}`,
+		`{"node_id":"node-1","task_name":"train","timestamp":"2020-08-31T15:00:01Z","log":"[INFO] Training","cursor":"` + ids[1] + `"}`,
+		`{"node_id":"node-1","task_name":"train","timestamp":"2020-08-31T15:00:02Z","log":"[ERROR] Out of memory","cursor":"` + ids[2] + `"}`,
+		"{}",
+	}, data)
+	assert.True(t, strings.HasSuffix(response.Body.String(), "event: end\ndata: {}\n\n"))
+
+	// The stream resumes after the last event received by the client.
+	_, resumedIds, data := streamEvents("?node_id=node-1", ids[0])
+	assert.Equal(t, ids[1:], resumedIds)
+	assert.Len(t, data, 3)
+	_, resumedIds, _ = streamEvents("?node_id=node-1&cursor="+ids[1], "")
+	assert.Equal(t, ids[2:], resumedIds)
+
+	// All the nodes are streamed by default.
+	_, ids, _ = streamEvents("", "")
+	assert.Len(t, ids, 5)
+
+	response, _, _ = streamEvents("?cursor=invalid", "")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Contains(t, response.Body.String(), "Invalid cursor")
+}
+
+// fakeRunLogStream is a StreamRunLogs server stream recording the sent entries.
+type fakeRunLogStream struct {
+	grpc.ServerStream
+	entries []*apiv2beta1.RunLogEntry
+}
+
+func (s *fakeRunLogStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeRunLogStream) Send(entry *apiv2beta1.RunLogEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestStreamRunLogs(t *testing.T) {
+	clientManager, manager, run := initWithRunLogs(t)
+	defer clientManager.Close()
+	server := NewRunLogServer(manager)
+
+	stream := &fakeRunLogStream{}
+	err := server.StreamRunLogs(&apiv2beta1.StreamRunLogsRequest{RunId: run.UUID, NodeIds: []string{"node-2"}}, stream)
+	require.Nil(t, err)
+	require.Len(t, stream.entries, 2)
+	assert.Equal(t, "node-2", stream.entries[0].GetNodeId())
+	assert.Equal(t, "evaluate", stream.entries[0].GetTaskName())
+	assert.Equal(t, "[INFO] Evaluating", stream.entries[0].GetLog())
+	assert.Equal(t, "2020-08-31T15:01:00Z", stream.entries[0].GetTimestamp().AsTime().Format(time.RFC3339))
+	assert.Equal(t, "[WARNING] Low accuracy", stream.entries[1].GetLog())
+
+	resumed := &fakeRunLogStream{}
+	err = server.StreamRunLogs(&apiv2beta1.StreamRunLogsRequest{RunId: run.UUID, NodeIds: []string{"node-2"}, Cursor: stream.entries[0].GetCursor()}, resumed)
+	require.Nil(t, err)
+	require.Len(t, resumed.entries, 1)
+	assert.Equal(t, "[WARNING] Low accuracy", resumed.entries[0].GetLog())
+
+	err = server.StreamRunLogs(&apiv2beta1.StreamRunLogsRequest{}, &fakeRunLogStream{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "run ID cannot be empty")
+
+	err = server.StreamRunLogs(&apiv2beta1.StreamRunLogsRequest{RunId: "unknown-run"}, &fakeRunLogStream{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -85,6 +85,11 @@ var (
 		Help: "The total number of RetryRun requests",
 	})
 
+	watchRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_watch_requests",
+		Help: "The total number of WatchRuns requests",
+	})
+
 	runCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "run_server_run_count",
 		Help: "The current number of runs in Kubeflow Pipelines instance",
@@ -631,6 +636,48 @@ func (s *RunServer) RetryRun(ctx context.Context, request *apiv2beta1.RetryRunRe
 	return &empty.Empty{}, nil
 }
 
+// Streams the lifecycle events of the runs, and optionally of their tasks,
+// matching the request, until the client cancels the stream.
+// Supports v2beta1 behavior.
+func (s *RunServer) WatchRuns(request *apiv2beta1.WatchRunsRequest, stream apiv2beta1.RunService_WatchRunsServer) error {
+	if s.options.CollectMetrics {
+		watchRunsRequests.Inc()
+	}
+
+	ctx := stream.Context()
+	namespace := s.resourceManager.ReplaceNamespace(request.GetNamespace())
+	experimentId := request.GetExperimentId()
+	if experimentId != "" {
+		ns, err := s.resourceManager.GetNamespaceFromExperimentId(experimentId)
+		if err != nil {
+			return util.Wrapf(err, "Failed to watch runs due to error fetching namespace for experiment %s. Try filtering based on namespace", experimentId)
+		}
+		namespace = ns
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      common.RbacResourceVerbList,
+	}
+	if err := s.canAccessRun(ctx, "", resourceAttributes); err != nil {
+		return util.Wrapf(err, "Failed to watch runs due to authorization error. Check if you have permission to access namespace %s", namespace)
+	}
+
+	opts := &resource.RunWatchOptions{
+		Namespace:    namespace,
+		ExperimentId: experimentId,
+		RunIds:       request.GetRunIds(),
+		IncludeTasks: request.GetIncludeTasks(),
+		ResumeToken:  request.GetResumeToken(),
+	}
+	err := s.resourceManager.WatchRuns(ctx, opts, func(event *model.RunEvent) error {
+		return stream.Send(toApiRunEvent(event))
+	})
+	if err != nil && ctx.Err() == nil {
+		return util.Wrap(err, "Failed to watch runs")
+	}
+	return nil
+}
+
 // Checks if a user can access a run.
 // Adds namespace of the parent experiment of a run id,
 // API group, version, and resource type.
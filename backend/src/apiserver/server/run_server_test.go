@@ -33,6 +33,8 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -252,7 +254,10 @@ func TestCreateRunV1_V1Params(t *testing.T) {
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{
 		{Name: "param1", Value: v1alpha1.AnyStringPtr("world")},
 	}
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: experiment.UUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = "pipeline-runner"
 	template := expectedRuntimeWorkflow.Spec.Templates[0]
@@ -434,7 +439,7 @@ func TestCreateRunV1Patch(t *testing.T) {
 			},
 		},
 		PipelineRuntime: &apiv1beta1.PipelineRuntime{
-			WorkflowManifest: "{\"kind\":\"Workflow\",\"apiVersion\":\"argoproj.io/v1alpha1\",\"metadata\":{\"name\":\"workflow-name\",\"namespace\":\"ns1\",\"uid\":\"workflow2\",\"creationTimestamp\":null,\"labels\":{\"pipeline/runid\":\"123e4567-e89b-12d3-a456-426655440000\"},\"annotations\":{\"pipelines.kubeflow.org/run_name\":\"run1\"}},\"spec\":{\"templates\":[{\"name\":\"testy\",\"inputs\":{},\"outputs\":{},\"metadata\":{\"annotations\":{\"sidecar.istio.io/inject\":\"false\"},\"labels\":{\"pipelines.kubeflow.org/cache_enabled\":\"true\"}},\"container\":{\"name\":\"\",\"image\":\"docker/whalesay\",\"command\":[\"cowsay\"],\"args\":[\"hello world\"],\"resources\":{}}}],\"entrypoint\":\"testy\",\"arguments\":{\"parameters\":[{\"name\":\"param1\",\"value\":\"test-default-bucket\"},{\"name\":\"param2\",\"value\":\"test-project-id\"}]},\"serviceAccountName\":\"pipeline-runner\",\"podMetadata\":{\"labels\":{\"pipeline/runid\":\"123e4567-e89b-12d3-a456-426655440000\"}}},\"status\":{\"startedAt\":null,\"finishedAt\":null}}",
+			WorkflowManifest: "{\"kind\":\"Workflow\",\"apiVersion\":\"argoproj.io/v1alpha1\",\"metadata\":{\"name\":\"workflow-name\",\"namespace\":\"ns1\",\"uid\":\"workflow2\",\"creationTimestamp\":null,\"labels\":{\"pipeline/runid\":\"123e4567-e89b-12d3-a456-426655440000\",\"pipelines.kubeflow.org/experiment_id\":\"123e4567-e89b-12d3-a456-426655440000\"},\"annotations\":{\"pipelines.kubeflow.org/run_name\":\"run1\"}},\"spec\":{\"templates\":[{\"name\":\"testy\",\"inputs\":{},\"outputs\":{},\"metadata\":{\"annotations\":{\"sidecar.istio.io/inject\":\"false\"},\"labels\":{\"pipelines.kubeflow.org/cache_enabled\":\"true\"}},\"container\":{\"name\":\"\",\"image\":\"docker/whalesay\",\"command\":[\"cowsay\"],\"args\":[\"hello world\"],\"resources\":{}}}],\"entrypoint\":\"testy\",\"arguments\":{\"parameters\":[{\"name\":\"param1\",\"value\":\"test-default-bucket\"},{\"name\":\"param2\",\"value\":\"test-project-id\"}]},\"serviceAccountName\":\"pipeline-runner\",\"podMetadata\":{\"labels\":{\"pipeline/runid\":\"123e4567-e89b-12d3-a456-426655440000\"}}},\"status\":{\"startedAt\":null,\"finishedAt\":null}}",
 		},
 	}
 
@@ -518,7 +523,10 @@ func TestCreateRunV1_Multiuser(t *testing.T) {
 	expectedRuntimeWorkflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{
 		{Name: "param1", Value: v1alpha1.AnyStringPtr("world")},
 	}
-	expectedRuntimeWorkflow.Labels = map[string]string{util.LabelKeyWorkflowRunId: "123e4567-e89b-12d3-a456-426655440000"}
+	expectedRuntimeWorkflow.Labels = map[string]string{
+		util.LabelKeyWorkflowRunId:        "123e4567-e89b-12d3-a456-426655440000",
+		util.LabelKeyWorkflowExperimentId: experiment.UUID,
+	}
 	expectedRuntimeWorkflow.Annotations = map[string]string{util.AnnotationKeyRunName: "run1"}
 	expectedRuntimeWorkflow.Spec.ServiceAccountName = "default-editor" // In multi-user mode, we use default service account.
 	template := expectedRuntimeWorkflow.Spec.Templates[0]
@@ -1676,3 +1684,73 @@ func TestRetryRun(t *testing.T) {
 
 	_, err = server.RetryRun(nil, &apiv2beta1.RetryRunRequest{RunId: run.RunId})
 }
+
+// fakeWatchRunsStream is a WatchRuns server stream recording the sent events,
+// which is canceled once it sent count events.
+type fakeWatchRunsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	count  int
+	events []*apiv2beta1.RunEvent
+}
+
+func newFakeWatchRunsStream(ctx context.Context, count int) *fakeWatchRunsStream {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	return &fakeWatchRunsStream{ctx: ctx, cancel: cancel, count: count}
+}
+
+func (s *fakeWatchRunsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchRunsStream) Send(event *apiv2beta1.RunEvent) error {
+	if len(s.events) < s.count {
+		s.events = append(s.events, event)
+	}
+	if len(s.events) == s.count {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestWatchRuns(t *testing.T) {
+	clientManager, resourceManager, run := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+	require.Nil(t, resourceManager.TerminateRun(context.Background(), run.UUID))
+
+	stream := newFakeWatchRunsStream(context.Background(), 2)
+	err := runServer.WatchRuns(&apiv2beta1.WatchRunsRequest{ExperimentId: run.ExperimentId, ResumeToken: "0"}, stream)
+	require.Nil(t, err)
+	require.Len(t, stream.events, 2)
+	assert.Equal(t, apiv2beta1.RunEvent_RUN_CREATED, stream.events[0].GetType())
+	assert.Equal(t, run.UUID, stream.events[0].GetRunId())
+	assert.Equal(t, run.ExperimentId, stream.events[0].GetExperimentId())
+	assert.Equal(t, apiv2beta1.RuntimeState_PENDING, stream.events[0].GetState())
+	assert.Equal(t, "1", stream.events[0].GetResumeToken())
+	assert.Equal(t, apiv2beta1.RunEvent_RUN_STATE_CHANGED, stream.events[1].GetType())
+	assert.Equal(t, apiv2beta1.RuntimeState_CANCELING, stream.events[1].GetState())
+	assert.Equal(t, "2", stream.events[1].GetResumeToken())
+
+	err = runServer.WatchRuns(&apiv2beta1.WatchRunsRequest{ResumeToken: "invalid"}, newFakeWatchRunsStream(context.Background(), 1))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid resume token")
+}
+
+func TestWatchRuns_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, _, _ := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+
+	err := runServer.WatchRuns(&apiv2beta1.WatchRunsRequest{Namespace: "ns1"}, newFakeWatchRunsStream(ctx, 1))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+}
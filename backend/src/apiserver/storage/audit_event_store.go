@@ -0,0 +1,182 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type AuditEventStoreInterface interface {
+	CreateAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error)
+	ListAuditEvents(opts *list.Options) ([]*model.AuditEvent, int, string, error)
+	// DeleteAuditEventsBefore deletes the audit events created before a time.
+	DeleteAuditEventsBefore(createdAtInSec int64) error
+}
+
+type AuditEventStore struct {
+	db   *DB
+	uuid util.UUIDGeneratorInterface
+}
+
+var auditEventColumns = []string{
+	"UUID",
+	"CreatedAtInSec",
+	"User",
+	"Method",
+	"ResourceIds",
+	"Namespace",
+	"Code",
+	"ErrorMessage",
+}
+
+// CreateAuditEvent stores an audit event, with a new ID.
+func (s *AuditEventStore) CreateAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error) {
+	newEvent := *event
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create an audit event id")
+	}
+	newEvent.UUID = id.String()
+
+	sql, args, err := sq.
+		Insert("audit_events").
+		SetMap(sq.Eq{
+			"UUID":           newEvent.UUID,
+			"CreatedAtInSec": newEvent.CreatedAtInSec,
+			"User":           newEvent.User,
+			"Method":         newEvent.Method,
+			"ResourceIds":    newEvent.ResourceIds,
+			"Namespace":      newEvent.Namespace,
+			"Code":           newEvent.Code,
+			"ErrorMessage":   newEvent.ErrorMessage,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert audit event: %v", err.Error())
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add audit event to audit_events table: %v", err.Error())
+	}
+	return &newEvent, nil
+}
+
+// Runs two SQL queries in a transaction to return a list of matching audit events, as well as
+// their total_size. The total_size does not reflect the page size.
+func (s *AuditEventStore) ListAuditEvents(opts *list.Options) ([]*model.AuditEvent, int, string, error) {
+	errorF := func(err error) ([]*model.AuditEvent, int, string, error) {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list audit events: %v", err)
+	}
+
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(
+		opts.AddFilterToSelect(sq.Select(auditEventColumns...).From("audit_events"))).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sq.Select("count(*)").From("audit_events")).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried
+	tx, err := s.db.Begin()
+	if err != nil {
+		glog.Errorf("Failed to start transaction to list audit events")
+		return errorF(err)
+	}
+
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	if err := rows.Err(); err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	events, err := s.scanRows(rows)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	defer rows.Close()
+
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	if err := sizeRow.Err(); err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	totalSize, err := list.ScanRowToTotalSize(sizeRow)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	defer sizeRow.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		glog.Errorf("Failed to commit transaction to list audit events")
+		return errorF(err)
+	}
+
+	if len(events) <= opts.PageSize {
+		return events, totalSize, "", nil
+	}
+
+	npt, err := opts.NextPageToken(events[opts.PageSize])
+	return events[:opts.PageSize], totalSize, npt, err
+}
+
+func (s *AuditEventStore) DeleteAuditEventsBefore(createdAtInSec int64) error {
+	sql, args, err := sq.
+		Delete("audit_events").
+		Where(sq.Lt{"CreatedAtInSec": createdAtInSec}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete audit events: %v", err.Error())
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete audit events: %v", err.Error())
+	}
+	return nil
+}
+
+func (s *AuditEventStore) scanRows(rows *sql.Rows) ([]*model.AuditEvent, error) {
+	var events []*model.AuditEvent
+	for rows.Next() {
+		event := &model.AuditEvent{}
+		err := rows.Scan(&event.UUID, &event.CreatedAtInSec, &event.User, &event.Method,
+			&event.ResourceIds, &event.Namespace, &event.Code, &event.ErrorMessage)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// NewAuditEventStore creates a new AuditEventStore.
+func NewAuditEventStore(db *DB, uuid util.UUIDGeneratorInterface) *AuditEventStore {
+	return &AuditEventStore{db: db, uuid: uuid}
+}
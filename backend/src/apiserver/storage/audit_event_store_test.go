@@ -0,0 +1,144 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	apiv1beta1 "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAuditEvent(t *testing.T, store *AuditEventStore, id string, createdAtInSec int64, user string) *model.AuditEvent {
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(id, nil)
+	event, err := store.CreateAuditEvent(&model.AuditEvent{
+		CreatedAtInSec: createdAtInSec,
+		User:           user,
+		Method:         "/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun",
+		ResourceIds:    "run1,experiment1",
+		Namespace:      "ns1",
+		Code:           "OK",
+	})
+	assert.Nil(t, err)
+	return event
+}
+
+func TestCreateAuditEvent(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewAuditEventStore(db, util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+
+	event := createAuditEvent(t, store, fakeID, 1, "user1@google.com")
+	assert.Equal(t, &model.AuditEvent{
+		UUID:           fakeID,
+		CreatedAtInSec: 1,
+		User:           "user1@google.com",
+		Method:         "/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun",
+		ResourceIds:    "run1,experiment1",
+		Namespace:      "ns1",
+		Code:           "OK",
+	}, event)
+
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	events, totalSize, _, err := store.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.AuditEvent{event}, events)
+}
+
+func TestCreateAuditEvent_UUIDError(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewAuditEventStore(db, util.NewFakeUUIDGeneratorOrFatal(fakeID, errors.New("error")))
+
+	_, err := store.CreateAuditEvent(&model.AuditEvent{User: "user1@google.com"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to create an audit event id")
+}
+
+func TestListAuditEvents_Pagination(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewAuditEventStore(db, util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	event1 := createAuditEvent(t, store, fakeID, 1, "user1@google.com")
+	event2 := createAuditEvent(t, store, fakeIDTwo, 2, "user2@google.com")
+	event3 := createAuditEvent(t, store, fakeIDThree, 3, "user1@google.com")
+
+	opts, err := list.NewOptions(&model.AuditEvent{}, 2, "created_at desc", nil)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err := store.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.NotEmpty(t, nextPageToken)
+	assert.Equal(t, []*model.AuditEvent{event3, event2}, events)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err = store.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, []*model.AuditEvent{event1}, events)
+}
+
+func TestListAuditEvents_Filter(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewAuditEventStore(db, util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	event1 := createAuditEvent(t, store, fakeID, 1, "user1@google.com")
+	createAuditEvent(t, store, fakeIDTwo, 2, "user2@google.com")
+	event3 := createAuditEvent(t, store, fakeIDThree, 3, "user1@google.com")
+
+	newFilter, err := filter.New(&apiv1beta1.Filter{
+		Predicates: []*apiv1beta1.Predicate{
+			{
+				Key:   "user",
+				Op:    apiv1beta1.Predicate_EQUALS,
+				Value: &apiv1beta1.Predicate_StringValue{StringValue: "user1@google.com"},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", newFilter)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err := store.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, []*model.AuditEvent{event1, event3}, events)
+}
+
+func TestDeleteAuditEventsBefore(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewAuditEventStore(db, util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	createAuditEvent(t, store, fakeID, 1, "user1@google.com")
+	createAuditEvent(t, store, fakeIDTwo, 2, "user2@google.com")
+	event3 := createAuditEvent(t, store, fakeIDThree, 3, "user1@google.com")
+
+	assert.Nil(t, store.DeleteAuditEventsBefore(3))
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	events, totalSize, _, err := store.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.AuditEvent{event3}, events)
+}
@@ -40,6 +40,10 @@ func NewFakeDB() (*DB, error) {
 		&model.Task{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{},
+		&model.AuditEvent{},
+		&model.RunEvent{},
+		&model.NotificationSubscription{},
+		&model.NotificationDelivery{},
 	)
 	return NewDB(db.DB(), NewSQLiteDialect()), nil
 }
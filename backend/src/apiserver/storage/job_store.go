@@ -35,15 +35,26 @@ var jobColumns = []string{
 	"Description",
 	"MaxConcurrency",
 	"NoCatchup",
+	"ConcurrencyPolicy",
+	"MaxConsecutiveFailures",
+	"FailureWebhookURL",
+	"ConsecutiveFailures",
+	"UseLatestPipelineVersion",
 	"CreatedAtInSec",
 	"UpdatedAtInSec",
 	"Enabled",
 	"CronScheduleStartTimeInSec",
 	"CronScheduleEndTimeInSec",
 	"Schedule",
+	"CronScheduleTimeZone",
 	"PeriodicScheduleStartTimeInSec",
 	"PeriodicScheduleEndTimeInSec",
 	"IntervalSecond",
+	"ObjectStoreTriggerURI",
+	"ObjectStoreTriggerPollIntervalSecond",
+	"RunCompletionTriggerExperimentId",
+	"RunCompletionTriggerPipelineId",
+	"RunCompletionTriggerStates",
 	"PipelineId",
 	"PipelineName",
 	"PipelineSpecManifest",
@@ -72,6 +83,9 @@ type JobStoreInterface interface {
 	// Update a recurring run entry in the database.
 	UpdateJob(swf *util.ScheduledWorkflow) error
 
+	// Update the pipeline spec and service account of a recurring run entry in the database.
+	UpdateJobPipelineSpec(j *model.Job) error
+
 	// Removes a recurring run entry from the database.
 	DeleteJob(id string) error
 }
@@ -231,16 +245,24 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 		var uuid, displayName, name, namespace, pipelineId, pipelineName, conditions, serviceAccount,
 			description, parameters, pipelineSpecManifest, workflowSpecManifest string
 		var cronScheduleStartTimeInSec, cronScheduleEndTimeInSec, createdAtInSec,
-			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond, updatedAtInSec sql.NullInt64
-		var cron, resourceReferencesInString, runtimeParameters, pipelineRoot sql.NullString
-		var experimentId, pipelineVersionId sql.NullString
+			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond, updatedAtInSec,
+			objectStorePollIntervalSecond sql.NullInt64
+		var objectStoreURI, runCompletionExperimentId, runCompletionPipelineId, runCompletionStates sql.NullString
+		var cron, cronTimeZone, resourceReferencesInString, runtimeParameters, pipelineRoot sql.NullString
+		var experimentId, pipelineVersionId, concurrencyPolicy, failureWebhookURL sql.NullString
+		var maxConsecutiveFailures, consecutiveFailures sql.NullInt64
+		var useLatestPipelineVersion sql.NullBool
 		var enabled, noCatchup bool
 		var maxConcurrency int64
 		err := r.Scan(
 			&uuid, &displayName, &name, &namespace, &serviceAccount, &description,
-			&maxConcurrency, &noCatchup, &createdAtInSec, &updatedAtInSec, &enabled,
-			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron,
+			&maxConcurrency, &noCatchup, &concurrencyPolicy,
+			&maxConsecutiveFailures, &failureWebhookURL, &consecutiveFailures, &useLatestPipelineVersion,
+			&createdAtInSec, &updatedAtInSec, &enabled,
+			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron, &cronTimeZone,
 			&periodicScheduleStartTimeInSec, &periodicScheduleEndTimeInSec, &intervalSecond,
+			&objectStoreURI, &objectStorePollIntervalSecond,
+			&runCompletionExperimentId, &runCompletionPipelineId, &runCompletionStates,
 			&pipelineId, &pipelineName, &pipelineSpecManifest, &workflowSpecManifest, &parameters,
 			&conditions, &runtimeParameters, &pipelineRoot, &experimentId,
 			&pipelineVersionId, &resourceReferencesInString)
@@ -266,29 +288,44 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 		}
 		runtimeConfig := parseRuntimeConfig(runtimeParameters, pipelineRoot)
 		job := &model.Job{
-			UUID:           uuid,
-			DisplayName:    displayName,
-			K8SName:        name,
-			Namespace:      namespace,
-			ServiceAccount: serviceAccount,
-			Description:    description,
-			Enabled:        enabled,
-			Conditions:     conditions,
-			ExperimentId:   expId,
-			MaxConcurrency: maxConcurrency,
-			NoCatchup:      noCatchup,
+			UUID:                     uuid,
+			DisplayName:              displayName,
+			K8SName:                  name,
+			Namespace:                namespace,
+			ServiceAccount:           serviceAccount,
+			Description:              description,
+			Enabled:                  enabled,
+			Conditions:               conditions,
+			ExperimentId:             expId,
+			MaxConcurrency:           maxConcurrency,
+			NoCatchup:                noCatchup,
+			ConcurrencyPolicy:        concurrencyPolicy.String,
+			MaxConsecutiveFailures:   maxConsecutiveFailures.Int64,
+			FailureWebhookURL:        failureWebhookURL.String,
+			ConsecutiveFailures:      consecutiveFailures.Int64,
+			UseLatestPipelineVersion: useLatestPipelineVersion.Bool,
 			// ResourceReferences: resourceReferences,
 			Trigger: model.Trigger{
 				CronSchedule: model.CronSchedule{
 					CronScheduleStartTimeInSec: NullInt64ToPointer(cronScheduleStartTimeInSec),
 					CronScheduleEndTimeInSec:   NullInt64ToPointer(cronScheduleEndTimeInSec),
 					Cron:                       NullStringToPointer(cron),
+					CronScheduleTimeZone:       NullStringToPointer(cronTimeZone),
 				},
 				PeriodicSchedule: model.PeriodicSchedule{
 					PeriodicScheduleStartTimeInSec: NullInt64ToPointer(periodicScheduleStartTimeInSec),
 					PeriodicScheduleEndTimeInSec:   NullInt64ToPointer(periodicScheduleEndTimeInSec),
 					IntervalSecond:                 NullInt64ToPointer(intervalSecond),
 				},
+				ObjectStoreTrigger: model.ObjectStoreTrigger{
+					ObjectStoreTriggerURI:                NullStringToPointer(objectStoreURI),
+					ObjectStoreTriggerPollIntervalSecond: NullInt64ToPointer(objectStorePollIntervalSecond),
+				},
+				RunCompletionTrigger: model.RunCompletionTrigger{
+					RunCompletionTriggerExperimentId: NullStringToPointer(runCompletionExperimentId),
+					RunCompletionTriggerPipelineId:   NullStringToPointer(runCompletionPipelineId),
+					RunCompletionTriggerStates:       NullStringToPointer(runCompletionStates),
+				},
 			},
 			PipelineSpec: model.PipelineSpec{
 				PipelineId:           pipelineId,
@@ -347,33 +384,44 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 	jobSql, jobArgs, err := sq.
 		Insert("jobs").
 		SetMap(sq.Eq{
-			"UUID":                           j.UUID,
-			"DisplayName":                    j.DisplayName,
-			"Name":                           j.K8SName,
-			"Namespace":                      j.Namespace,
-			"ServiceAccount":                 j.ServiceAccount,
-			"Description":                    j.Description,
-			"MaxConcurrency":                 j.MaxConcurrency,
-			"NoCatchup":                      j.NoCatchup,
-			"Enabled":                        j.Enabled,
-			"Conditions":                     j.Conditions,
-			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleStartTimeInSec),
-			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleEndTimeInSec),
-			"Schedule":                       PointerToNullString(j.Trigger.CronSchedule.Cron),
-			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleStartTimeInSec),
-			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleEndTimeInSec),
-			"IntervalSecond":                 PointerToNullInt64(j.Trigger.PeriodicSchedule.IntervalSecond),
-			"CreatedAtInSec":                 j.CreatedAtInSec,
-			"UpdatedAtInSec":                 j.UpdatedAtInSec,
-			"PipelineId":                     j.PipelineSpec.PipelineId,
-			"PipelineName":                   j.PipelineSpec.PipelineName,
-			"PipelineSpecManifest":           j.PipelineSpec.PipelineSpecManifest,
-			"WorkflowSpecManifest":           j.PipelineSpec.WorkflowSpecManifest,
-			"Parameters":                     j.PipelineSpec.Parameters,
-			"RuntimeParameters":              j.PipelineSpec.RuntimeConfig.Parameters,
-			"PipelineRoot":                   j.PipelineSpec.RuntimeConfig.PipelineRoot,
-			"ExperimentUUID":                 j.ExperimentId,
-			"PipelineVersionId":              j.PipelineSpec.PipelineVersionId,
+			"UUID":                                 j.UUID,
+			"DisplayName":                          j.DisplayName,
+			"Name":                                 j.K8SName,
+			"Namespace":                            j.Namespace,
+			"ServiceAccount":                       j.ServiceAccount,
+			"Description":                          j.Description,
+			"MaxConcurrency":                       j.MaxConcurrency,
+			"NoCatchup":                            j.NoCatchup,
+			"ConcurrencyPolicy":                    j.ConcurrencyPolicy,
+			"MaxConsecutiveFailures":               j.MaxConsecutiveFailures,
+			"FailureWebhookURL":                    j.FailureWebhookURL,
+			"ConsecutiveFailures":                  j.ConsecutiveFailures,
+			"UseLatestPipelineVersion":             j.UseLatestPipelineVersion,
+			"Enabled":                              j.Enabled,
+			"Conditions":                           j.Conditions,
+			"CronScheduleStartTimeInSec":           PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleStartTimeInSec),
+			"CronScheduleEndTimeInSec":             PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleEndTimeInSec),
+			"Schedule":                             PointerToNullString(j.Trigger.CronSchedule.Cron),
+			"CronScheduleTimeZone":                 PointerToNullString(j.Trigger.CronSchedule.CronScheduleTimeZone),
+			"PeriodicScheduleStartTimeInSec":       PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleStartTimeInSec),
+			"PeriodicScheduleEndTimeInSec":         PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleEndTimeInSec),
+			"IntervalSecond":                       PointerToNullInt64(j.Trigger.PeriodicSchedule.IntervalSecond),
+			"ObjectStoreTriggerURI":                PointerToNullString(j.Trigger.ObjectStoreTrigger.ObjectStoreTriggerURI),
+			"ObjectStoreTriggerPollIntervalSecond": PointerToNullInt64(j.Trigger.ObjectStoreTrigger.ObjectStoreTriggerPollIntervalSecond),
+			"RunCompletionTriggerExperimentId":     PointerToNullString(j.Trigger.RunCompletionTrigger.RunCompletionTriggerExperimentId),
+			"RunCompletionTriggerPipelineId":       PointerToNullString(j.Trigger.RunCompletionTrigger.RunCompletionTriggerPipelineId),
+			"RunCompletionTriggerStates":           PointerToNullString(j.Trigger.RunCompletionTrigger.RunCompletionTriggerStates),
+			"CreatedAtInSec":                       j.CreatedAtInSec,
+			"UpdatedAtInSec":                       j.UpdatedAtInSec,
+			"PipelineId":                           j.PipelineSpec.PipelineId,
+			"PipelineName":                         j.PipelineSpec.PipelineName,
+			"PipelineSpecManifest":                 j.PipelineSpec.PipelineSpecManifest,
+			"WorkflowSpecManifest":                 j.PipelineSpec.WorkflowSpecManifest,
+			"Parameters":                           j.PipelineSpec.Parameters,
+			"RuntimeParameters":                    j.PipelineSpec.RuntimeConfig.Parameters,
+			"PipelineRoot":                         j.PipelineSpec.RuntimeConfig.PipelineRoot,
+			"ExperimentUUID":                       j.ExperimentId,
+			"PipelineVersionId":                    j.PipelineSpec.PipelineVersionId,
 		}).ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create query to add job to job table: %v",
@@ -440,17 +488,27 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 			"Name": swf.Name,
 			// Namespace changes for recurring runs is forbidden
 			// "Namespace":                      swf.Namespace,
-			"Enabled":                        swf.Spec.Enabled,
-			"Conditions":                     model.StatusState(swf.ConditionSummary()).ToString(),
-			"MaxConcurrency":                 swf.MaxConcurrencyOr0(),
-			"NoCatchup":                      swf.NoCatchupOrFalse(),
-			"UpdatedAtInSec":                 now,
-			"CronScheduleStartTimeInSec":     PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
-			"CronScheduleEndTimeInSec":       PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
-			"Schedule":                       swf.CronOrEmpty(),
-			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
-			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
-			"IntervalSecond":                 swf.IntervalSecondOr0(),
+			"Enabled":                              swf.Spec.Enabled,
+			"Conditions":                           model.StatusState(swf.ConditionSummary()).ToString(),
+			"MaxConcurrency":                       swf.MaxConcurrencyOr0(),
+			"NoCatchup":                            swf.NoCatchupOrFalse(),
+			"ConcurrencyPolicy":                    string(swf.Spec.ConcurrencyPolicy),
+			"MaxConsecutiveFailures":               swf.MaxConsecutiveFailuresOr0(),
+			"FailureWebhookURL":                    swf.FailureWebhookURLOrEmpty(),
+			"ConsecutiveFailures":                  swf.Status.ConsecutiveFailures,
+			"UpdatedAtInSec":                       now,
+			"CronScheduleStartTimeInSec":           PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
+			"CronScheduleEndTimeInSec":             PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
+			"Schedule":                             swf.CronOrEmpty(),
+			"CronScheduleTimeZone":                 PointerToNullString(swf.CronScheduleTimeZoneOrNull()),
+			"PeriodicScheduleStartTimeInSec":       PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
+			"PeriodicScheduleEndTimeInSec":         PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
+			"IntervalSecond":                       swf.IntervalSecondOr0(),
+			"ObjectStoreTriggerURI":                PointerToNullString(swf.ObjectStoreTriggerURIOrNull()),
+			"ObjectStoreTriggerPollIntervalSecond": PointerToNullInt64(swf.ObjectStoreTriggerPollIntervalSecondOrNull()),
+			"RunCompletionTriggerExperimentId":     PointerToNullString(swf.RunCompletionTriggerExperimentIdOrNull()),
+			"RunCompletionTriggerPipelineId":       PointerToNullString(swf.RunCompletionTriggerPipelineIdOrNull()),
+			"RunCompletionTriggerStates":           PointerToNullString(swf.RunCompletionTriggerStatesOrNull()),
 		})
 	if len(parameters) > 0 {
 		if swf.GetVersion() == util.SWFv1 {
@@ -489,6 +547,43 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 	return nil
 }
 
+// UpdateJobPipelineSpec updates the pipeline, runtime config and service account of a job.
+// The fields derived from the scheduled workflow are updated by UpdateJob.
+func (s *JobStore) UpdateJobPipelineSpec(j *model.Job) error {
+	sql, args, err := sq.
+		Update("jobs").
+		SetMap(sq.Eq{
+			"ServiceAccount":           j.ServiceAccount,
+			"UseLatestPipelineVersion": j.UseLatestPipelineVersion,
+			"UpdatedAtInSec":           s.time.Now().Unix(),
+			"PipelineId":               j.PipelineSpec.PipelineId,
+			"PipelineName":             j.PipelineSpec.PipelineName,
+			"PipelineVersionId":        j.PipelineSpec.PipelineVersionId,
+			"PipelineSpecManifest":     j.PipelineSpec.PipelineSpecManifest,
+			"WorkflowSpecManifest":     j.PipelineSpec.WorkflowSpecManifest,
+			"Parameters":               j.PipelineSpec.Parameters,
+			"RuntimeParameters":        j.PipelineSpec.RuntimeConfig.Parameters,
+			"PipelineRoot":             j.PipelineSpec.RuntimeConfig.PipelineRoot,
+		}).
+		Where(sq.Eq{"UUID": j.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error when creating query to update the pipeline spec of job %v", j.UUID)
+	}
+	r, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Error when updating the pipeline spec of job %v", j.UUID)
+	}
+	rowsAffected, err := r.RowsAffected()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error getting affected rows while updating the pipeline spec of job %v", j.UUID)
+	}
+	if rowsAffected <= 0 {
+		return util.NewResourceNotFoundError("RecurringRun", j.UUID)
+	}
+	return nil
+}
+
 // factory function for job store.
 func NewJobStore(db *DB, time util.TimeInterface) *JobStore {
 	return &JobStore{
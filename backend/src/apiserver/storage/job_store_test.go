@@ -751,6 +751,10 @@ func TestUpdateJob_Success(t *testing.T) {
 			Enabled:        false,
 			MaxConcurrency: util.Int64Pointer(200),
 			NoCatchup:      util.BoolPointer(true),
+			FailurePolicy: &swfapi.FailurePolicy{
+				MaxConsecutiveFailures: 3,
+				WebhookURL:             "https://example.com/hook",
+			},
 			Workflow: &swfapi.WorkflowResource{
 				Parameters: []swfapi.Parameter{
 					{Name: "PARAM1", Value: "NEW_VALUE1"},
@@ -780,6 +784,7 @@ func TestUpdateJob_Success(t *testing.T) {
 					Message:            "The schedule is enabled",
 				},
 			},
+			ConsecutiveFailures: 2,
 		},
 	})
 
@@ -787,16 +792,19 @@ func TestUpdateJob_Success(t *testing.T) {
 	assert.Nil(t, err)
 
 	jobExpected = &model.Job{
-		UUID:           "1",
-		DisplayName:    "pp 1",
-		K8SName:        "MY_NAME",
-		Namespace:      "n1",
-		Enabled:        false,
-		Conditions:     "ENABLED",
-		CreatedAtInSec: 1,
-		UpdatedAtInSec: 3,
-		MaxConcurrency: 200,
-		NoCatchup:      true,
+		UUID:                   "1",
+		DisplayName:            "pp 1",
+		K8SName:                "MY_NAME",
+		Namespace:              "n1",
+		Enabled:                false,
+		Conditions:             "ENABLED",
+		CreatedAtInSec:         1,
+		UpdatedAtInSec:         3,
+		MaxConcurrency:         200,
+		NoCatchup:              true,
+		MaxConsecutiveFailures: 3,
+		FailureWebhookURL:      "https://example.com/hook",
+		ConsecutiveFailures:    2,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:   DefaultFakePipelineIdTwo,
 			PipelineName: "p1",
@@ -932,6 +940,48 @@ func TestUpdateJob_InternalError(t *testing.T) {
 	assert.Equal(t, err.(*util.UserError).ExternalStatusCode(), codes.Internal)
 }
 
+func TestUpdateJobPipelineSpec(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	job, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	job.ServiceAccount = "sa2"
+	job.UseLatestPipelineVersion = true
+	job.PipelineSpec = model.PipelineSpec{
+		PipelineId:           DefaultFakePipelineId,
+		PipelineName:         "p2",
+		PipelineSpecManifest: "manifest",
+		RuntimeConfig: model.RuntimeConfig{
+			Parameters:   `{"param1":"value1"}`,
+			PipelineRoot: "gs://my-bucket",
+		},
+	}
+	err = jobStore.UpdateJobPipelineSpec(job)
+	assert.Nil(t, err)
+
+	updated, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "sa2", updated.ServiceAccount)
+	assert.True(t, updated.UseLatestPipelineVersion)
+	assert.Equal(t, DefaultFakePipelineId, updated.PipelineSpec.PipelineId)
+	assert.Equal(t, "p2", updated.PipelineSpec.PipelineName)
+	assert.Empty(t, updated.PipelineSpec.PipelineVersionId)
+	assert.Equal(t, "manifest", updated.PipelineSpec.PipelineSpecManifest)
+	assert.Equal(t, `{"param1":"value1"}`, updated.PipelineSpec.RuntimeConfig.Parameters)
+	assert.Equal(t, "gs://my-bucket", updated.PipelineSpec.RuntimeConfig.PipelineRoot)
+	assert.Equal(t, job.Trigger, updated.Trigger)
+}
+
+func TestUpdateJobPipelineSpec_RecordNotFound(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	err := jobStore.UpdateJobPipelineSpec(&model.Job{UUID: "UNKNOWN_UID"})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteJob(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
@@ -16,6 +16,8 @@ package storage
 
 import (
 	"io"
+	"net/url"
+	"time"
 
 	minio "github.com/minio/minio-go/v6"
 )
@@ -25,6 +27,8 @@ type MinioClientInterface interface {
 	PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (n int64, err error)
 	GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error)
 	DeleteObject(bucketName, objectName string) error
+	StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+	PresignedGetObject(bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error)
 }
 
 type MinioClient struct {
@@ -42,3 +46,11 @@ func (c *MinioClient) GetObject(bucketName, objectName string, opts minio.GetObj
 func (c *MinioClient) DeleteObject(bucketName, objectName string) error {
 	return c.Client.RemoveObject(bucketName, objectName)
 }
+
+func (c *MinioClient) StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return c.Client.StatObject(bucketName, objectName, opts)
+}
+
+func (c *MinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+	return c.Client.PresignedGetObject(bucketName, objectName, expiry, reqParams)
+}
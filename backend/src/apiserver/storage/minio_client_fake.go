@@ -16,7 +16,10 @@ package storage
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"net/url"
+	"time"
 
 	"github.com/minio/minio-go/v6"
 	"github.com/pkg/errors"
@@ -47,7 +50,15 @@ func (c *FakeMinioClient) GetObject(bucketName, objectName string,
 	if _, ok := c.minioClient[objectName]; !ok {
 		return nil, errors.New("object not found")
 	}
-	return bytes.NewReader(c.minioClient[objectName]), nil
+	content := c.minioClient[objectName]
+	var start, end int64
+	switch n, _ := fmt.Sscanf(opts.Header().Get("Range"), "bytes=%d-%d", &start, &end); n {
+	case 1:
+		content = content[start:]
+	case 2:
+		content = content[start : end+1]
+	}
+	return bytes.NewReader(content), nil
 }
 
 func (c *FakeMinioClient) DeleteObject(bucketName, objectName string) error {
@@ -58,6 +69,22 @@ func (c *FakeMinioClient) DeleteObject(bucketName, objectName string) error {
 	return nil
 }
 
+func (c *FakeMinioClient) StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	if _, ok := c.minioClient[objectName]; !ok {
+		return minio.ObjectInfo{}, minio.ErrorResponse{Code: "NoSuchKey", Key: objectName}
+	}
+	return minio.ObjectInfo{
+		Key:         objectName,
+		Size:        int64(len(c.minioClient[objectName])),
+		ContentType: "application/octet-stream",
+	}, nil
+}
+
+func (c *FakeMinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+	query := url.Values{"X-Amz-Expires": []string{fmt.Sprint(int64(expiry.Seconds()))}}
+	return &url.URL{Scheme: "http", Host: "minio-service.kubeflow:9000", Path: "/" + bucketName + "/" + objectName, RawQuery: query.Encode()}, nil
+}
+
 func (c *FakeMinioClient) GetObjectCount() int {
 	return len(c.minioClient)
 }
@@ -0,0 +1,393 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type NotificationStoreInterface interface {
+	// CreateNotificationSubscription stores a subscription, with a new ID and creation time.
+	CreateNotificationSubscription(subscription *model.NotificationSubscription) (*model.NotificationSubscription, error)
+	GetNotificationSubscription(id string) (*model.NotificationSubscription, error)
+	// ListNotificationSubscriptions lists the subscriptions of an experiment, if
+	// experimentId is not empty, else of a namespace.
+	ListNotificationSubscriptions(namespace string, experimentId string, opts *list.Options) ([]*model.NotificationSubscription, int, string, error)
+	// ListRunNotificationSubscriptions returns the subscriptions to the runs of
+	// an experiment of a namespace.
+	ListRunNotificationSubscriptions(namespace string, experimentId string) ([]*model.NotificationSubscription, error)
+	// DeleteNotificationSubscription deletes a subscription and its deliveries.
+	DeleteNotificationSubscription(id string) error
+	// CreateNotificationDelivery stores a delivery, with a new ID.
+	CreateNotificationDelivery(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error)
+	UpdateNotificationDelivery(delivery *model.NotificationDelivery) error
+	ListNotificationDeliveries(subscriptionId string, opts *list.Options) ([]*model.NotificationDelivery, int, string, error)
+	// ListPendingNotificationDeliveries returns the deliveries which neither
+	// succeeded nor failed.
+	ListPendingNotificationDeliveries() ([]*model.NotificationDelivery, error)
+}
+
+type NotificationStore struct {
+	db   *DB
+	time util.TimeInterface
+	uuid util.UUIDGeneratorInterface
+}
+
+var notificationSubscriptionColumns = []string{
+	"UUID",
+	"DisplayName",
+	"Namespace",
+	"ExperimentUUID",
+	"States",
+	"TargetURL",
+	"PayloadTemplate",
+	"Secret",
+	"CreatedAtInSec",
+}
+
+var notificationDeliveryColumns = []string{
+	"UUID",
+	"SubscriptionUUID",
+	"RunUUID",
+	"State",
+	"Payload",
+	"Status",
+	"Attempts",
+	"ResponseCode",
+	"ErrorMessage",
+	"CreatedAtInSec",
+	"FinishedAtInSec",
+}
+
+func (s *NotificationStore) CreateNotificationSubscription(subscription *model.NotificationSubscription) (*model.NotificationSubscription, error) {
+	newSubscription := *subscription
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a notification subscription id")
+	}
+	newSubscription.UUID = id.String()
+	newSubscription.CreatedAtInSec = s.time.Now().Unix()
+
+	sql, args, err := sq.
+		Insert("notification_subscriptions").
+		SetMap(sq.Eq{
+			"UUID":            newSubscription.UUID,
+			"DisplayName":     newSubscription.DisplayName,
+			"Namespace":       newSubscription.Namespace,
+			"ExperimentUUID":  newSubscription.ExperimentId,
+			"States":          newSubscription.States,
+			"TargetURL":       newSubscription.TargetURL,
+			"PayloadTemplate": newSubscription.PayloadTemplate,
+			"Secret":          newSubscription.Secret,
+			"CreatedAtInSec":  newSubscription.CreatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert notification subscription: %v", err.Error())
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add notification subscription to notification_subscriptions table: %v", err.Error())
+	}
+	return &newSubscription, nil
+}
+
+func (s *NotificationStore) GetNotificationSubscription(id string) (*model.NotificationSubscription, error) {
+	sql, args, err := sq.
+		Select(notificationSubscriptionColumns...).
+		From("notification_subscriptions").
+		Where(sq.Eq{"UUID": id}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get notification subscription: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get notification subscription: %v", err.Error())
+	}
+	defer rows.Close()
+	subscriptions, err := s.scanSubscriptionRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get notification subscription: %v", err.Error())
+	}
+	if len(subscriptions) == 0 {
+		return nil, util.NewResourceNotFoundError("NotificationSubscription", id)
+	}
+	return subscriptions[0], nil
+}
+
+func (s *NotificationStore) ListNotificationSubscriptions(namespace string, experimentId string, opts *list.Options) ([]*model.NotificationSubscription, int, string, error) {
+	where := sq.Eq{"Namespace": namespace}
+	if experimentId != "" {
+		where = sq.Eq{"ExperimentUUID": experimentId}
+	}
+	var subscriptions []*model.NotificationSubscription
+	totalSize, err := s.list(
+		sq.Select(notificationSubscriptionColumns...).From("notification_subscriptions").Where(where),
+		sq.Select("count(*)").From("notification_subscriptions").Where(where),
+		opts,
+		func(rows *sql.Rows) error {
+			var err error
+			subscriptions, err = s.scanSubscriptionRows(rows)
+			return err
+		})
+	if err != nil {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list notification subscriptions: %v", err)
+	}
+	if len(subscriptions) <= opts.PageSize {
+		return subscriptions, totalSize, "", nil
+	}
+	npt, err := opts.NextPageToken(subscriptions[opts.PageSize])
+	return subscriptions[:opts.PageSize], totalSize, npt, err
+}
+
+func (s *NotificationStore) ListRunNotificationSubscriptions(namespace string, experimentId string) ([]*model.NotificationSubscription, error) {
+	sql, args, err := sq.
+		Select(notificationSubscriptionColumns...).
+		From("notification_subscriptions").
+		Where(sq.Eq{"Namespace": namespace}).
+		Where(sq.Or{sq.Eq{"ExperimentUUID": ""}, sq.Eq{"ExperimentUUID": experimentId}}).
+		OrderBy("CreatedAtInSec").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list notification subscriptions: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list notification subscriptions: %v", err.Error())
+	}
+	defer rows.Close()
+	subscriptions, err := s.scanSubscriptionRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list notification subscriptions: %v", err.Error())
+	}
+	return subscriptions, nil
+}
+
+func (s *NotificationStore) DeleteNotificationSubscription(id string) error {
+	deliverySql, deliveryArgs, err := sq.Delete("notification_deliveries").Where(sq.Eq{"SubscriptionUUID": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete notification deliveries: %v", err.Error())
+	}
+	subscriptionSql, subscriptionArgs, err := sq.Delete("notification_subscriptions").Where(sq.Eq{"UUID": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete notification subscription: %v", err.Error())
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete notification subscription: %v", err.Error())
+	}
+	if _, err := tx.Exec(deliverySql, deliveryArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete notification deliveries: %v", err.Error())
+	}
+	if _, err := tx.Exec(subscriptionSql, subscriptionArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete notification subscription: %v", err.Error())
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete notification subscription: %v", err.Error())
+	}
+	return nil
+}
+
+func (s *NotificationStore) CreateNotificationDelivery(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error) {
+	newDelivery := *delivery
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a notification delivery id")
+	}
+	newDelivery.UUID = id.String()
+
+	sql, args, err := sq.
+		Insert("notification_deliveries").
+		SetMap(sq.Eq{
+			"UUID":             newDelivery.UUID,
+			"SubscriptionUUID": newDelivery.SubscriptionId,
+			"RunUUID":          newDelivery.RunId,
+			"State":            string(newDelivery.State),
+			"Payload":          newDelivery.Payload,
+			"Status":           string(newDelivery.Status),
+			"Attempts":         newDelivery.Attempts,
+			"ResponseCode":     newDelivery.ResponseCode,
+			"ErrorMessage":     newDelivery.ErrorMessage,
+			"CreatedAtInSec":   newDelivery.CreatedAtInSec,
+			"FinishedAtInSec":  newDelivery.FinishedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert notification delivery: %v", err.Error())
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add notification delivery to notification_deliveries table: %v", err.Error())
+	}
+	return &newDelivery, nil
+}
+
+func (s *NotificationStore) UpdateNotificationDelivery(delivery *model.NotificationDelivery) error {
+	sql, args, err := sq.
+		Update("notification_deliveries").
+		SetMap(sq.Eq{
+			"Status":          string(delivery.Status),
+			"Attempts":        delivery.Attempts,
+			"ResponseCode":    delivery.ResponseCode,
+			"ErrorMessage":    delivery.ErrorMessage,
+			"FinishedAtInSec": delivery.FinishedAtInSec,
+		}).
+		Where(sq.Eq{"UUID": delivery.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to update notification delivery: %v", err.Error())
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to update notification delivery %v: %v", delivery.UUID, err.Error())
+	}
+	return nil
+}
+
+func (s *NotificationStore) ListNotificationDeliveries(subscriptionId string, opts *list.Options) ([]*model.NotificationDelivery, int, string, error) {
+	where := sq.Eq{"SubscriptionUUID": subscriptionId}
+	var deliveries []*model.NotificationDelivery
+	totalSize, err := s.list(
+		sq.Select(notificationDeliveryColumns...).From("notification_deliveries").Where(where),
+		sq.Select("count(*)").From("notification_deliveries").Where(where),
+		opts,
+		func(rows *sql.Rows) error {
+			var err error
+			deliveries, err = s.scanDeliveryRows(rows)
+			return err
+		})
+	if err != nil {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list notification deliveries: %v", err)
+	}
+	if len(deliveries) <= opts.PageSize {
+		return deliveries, totalSize, "", nil
+	}
+	npt, err := opts.NextPageToken(deliveries[opts.PageSize])
+	return deliveries[:opts.PageSize], totalSize, npt, err
+}
+
+func (s *NotificationStore) ListPendingNotificationDeliveries() ([]*model.NotificationDelivery, error) {
+	sql, args, err := sq.
+		Select(notificationDeliveryColumns...).
+		From("notification_deliveries").
+		Where(sq.Eq{"Status": string(model.NotificationDeliveryPending)}).
+		OrderBy("CreatedAtInSec").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list pending notification deliveries: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list pending notification deliveries: %v", err.Error())
+	}
+	defer rows.Close()
+	deliveries, err := s.scanDeliveryRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list pending notification deliveries: %v", err.Error())
+	}
+	return deliveries, nil
+}
+
+// list runs two SQL queries in a transaction to scan a page of matching rows,
+// and to return their total size. The total size does not reflect the page size.
+func (s *NotificationStore) list(rowsSelect sq.SelectBuilder, sizeSelect sq.SelectBuilder, opts *list.Options, scan func(rows *sql.Rows) error) (int, error) {
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(opts.AddFilterToSelect(rowsSelect)).ToSql()
+	if err != nil {
+		return 0, err
+	}
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sizeSelect).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried
+	tx, err := s.db.Begin()
+	if err != nil {
+		glog.Errorf("Failed to start transaction to list notifications")
+		return 0, err
+	}
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	err = scan(rows)
+	rows.Close()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	totalSize, err := list.ScanRowToTotalSize(sizeRow)
+	sizeRow.Close()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		glog.Errorf("Failed to commit transaction to list notifications")
+		return 0, err
+	}
+	return totalSize, nil
+}
+
+func (s *NotificationStore) scanSubscriptionRows(rows *sql.Rows) ([]*model.NotificationSubscription, error) {
+	var subscriptions []*model.NotificationSubscription
+	for rows.Next() {
+		subscription := &model.NotificationSubscription{}
+		err := rows.Scan(&subscription.UUID, &subscription.DisplayName, &subscription.Namespace,
+			&subscription.ExperimentId, &subscription.States, &subscription.TargetURL,
+			&subscription.PayloadTemplate, &subscription.Secret, &subscription.CreatedAtInSec)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, rows.Err()
+}
+
+func (s *NotificationStore) scanDeliveryRows(rows *sql.Rows) ([]*model.NotificationDelivery, error) {
+	var deliveries []*model.NotificationDelivery
+	for rows.Next() {
+		var state, status string
+		delivery := &model.NotificationDelivery{}
+		err := rows.Scan(&delivery.UUID, &delivery.SubscriptionId, &delivery.RunId, &state,
+			&delivery.Payload, &status, &delivery.Attempts, &delivery.ResponseCode,
+			&delivery.ErrorMessage, &delivery.CreatedAtInSec, &delivery.FinishedAtInSec)
+		if err != nil {
+			return nil, err
+		}
+		delivery.State = model.RuntimeState(state)
+		delivery.Status = model.NotificationDeliveryStatus(status)
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// NewNotificationStore creates a new NotificationStore.
+func NewNotificationStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *NotificationStore {
+	return &NotificationStore{db: db, time: time, uuid: uuid}
+}
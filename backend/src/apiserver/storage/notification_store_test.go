@@ -0,0 +1,163 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func createNotificationSubscription(t *testing.T, store *NotificationStore, id string, namespace string, experimentId string) *model.NotificationSubscription {
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(id, nil)
+	subscription, err := store.CreateNotificationSubscription(&model.NotificationSubscription{
+		DisplayName:  "failures",
+		Namespace:    namespace,
+		ExperimentId: experimentId,
+		States:       "FAILED",
+		TargetURL:    "https://hooks.example.com/run",
+		Secret:       "secret",
+	})
+	require.Nil(t, err)
+	return subscription
+}
+
+func TestCreateNotificationSubscription(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewNotificationStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+
+	subscription := createNotificationSubscription(t, store, fakeID, "ns1", "")
+	assert.Equal(t, &model.NotificationSubscription{
+		UUID:           fakeID,
+		DisplayName:    "failures",
+		Namespace:      "ns1",
+		States:         "FAILED",
+		TargetURL:      "https://hooks.example.com/run",
+		Secret:         "secret",
+		CreatedAtInSec: 1,
+	}, subscription)
+
+	fetched, err := store.GetNotificationSubscription(fakeID)
+	require.Nil(t, err)
+	assert.Equal(t, subscription, fetched)
+
+	_, err = store.GetNotificationSubscription(fakeIDTwo)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestListNotificationSubscriptions(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewNotificationStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	subscription1 := createNotificationSubscription(t, store, fakeID, "ns1", "")
+	subscription2 := createNotificationSubscription(t, store, fakeIDTwo, "ns1", "experiment1")
+	createNotificationSubscription(t, store, fakeIDThree, "ns2", "")
+
+	opts, err := list.NewOptions(&model.NotificationSubscription{}, 1, "", nil)
+	require.Nil(t, err)
+	subscriptions, totalSize, nextPageToken, err := store.ListNotificationSubscriptions("ns1", "", opts)
+	require.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.NotEmpty(t, nextPageToken)
+	assert.Equal(t, []*model.NotificationSubscription{subscription1}, subscriptions)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	require.Nil(t, err)
+	subscriptions, _, nextPageToken, err = store.ListNotificationSubscriptions("ns1", "", opts)
+	require.Nil(t, err)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, []*model.NotificationSubscription{subscription2}, subscriptions)
+
+	opts, err = list.NewOptions(&model.NotificationSubscription{}, 10, "", nil)
+	require.Nil(t, err)
+	subscriptions, totalSize, _, err = store.ListNotificationSubscriptions("ns1", "experiment1", opts)
+	require.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.NotificationSubscription{subscription2}, subscriptions)
+}
+
+func TestListRunNotificationSubscriptions(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewNotificationStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	subscription1 := createNotificationSubscription(t, store, fakeID, "ns1", "")
+	subscription2 := createNotificationSubscription(t, store, fakeIDTwo, "ns1", "experiment1")
+	createNotificationSubscription(t, store, fakeIDThree, "ns1", "experiment2")
+	createNotificationSubscription(t, store, fakeIDFour, "ns2", "")
+
+	subscriptions, err := store.ListRunNotificationSubscriptions("ns1", "experiment1")
+	require.Nil(t, err)
+	assert.Equal(t, []*model.NotificationSubscription{subscription1, subscription2}, subscriptions)
+}
+
+func TestNotificationDeliveries(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewNotificationStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	createNotificationSubscription(t, store, fakeID, "ns1", "")
+
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	delivery1, err := store.CreateNotificationDelivery(&model.NotificationDelivery{
+		SubscriptionId: fakeID,
+		RunId:          "run1",
+		State:          model.RuntimeStateFailed,
+		Payload:        `{"run_id": "run1"}`,
+		Status:         model.NotificationDeliveryPending,
+		CreatedAtInSec: 1,
+	})
+	require.Nil(t, err)
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDThree, nil)
+	delivery2, err := store.CreateNotificationDelivery(&model.NotificationDelivery{
+		SubscriptionId: fakeID,
+		RunId:          "run2",
+		State:          model.RuntimeStateFailed,
+		Status:         model.NotificationDeliveryPending,
+		CreatedAtInSec: 2,
+	})
+	require.Nil(t, err)
+
+	delivery2.Status = model.NotificationDeliverySucceeded
+	delivery2.Attempts = 2
+	delivery2.ResponseCode = 200
+	delivery2.FinishedAtInSec = 3
+	require.Nil(t, store.UpdateNotificationDelivery(delivery2))
+
+	pending, err := store.ListPendingNotificationDeliveries()
+	require.Nil(t, err)
+	assert.Equal(t, []*model.NotificationDelivery{delivery1}, pending)
+
+	opts, err := list.NewOptions(&model.NotificationDelivery{}, 10, "created_at desc", nil)
+	require.Nil(t, err)
+	deliveries, totalSize, _, err := store.ListNotificationDeliveries(fakeID, opts)
+	require.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, []*model.NotificationDelivery{delivery2, delivery1}, deliveries)
+
+	// Deleting the subscription deletes its deliveries.
+	require.Nil(t, store.DeleteNotificationSubscription(fakeID))
+	deliveries, totalSize, _, err = store.ListNotificationDeliveries(fakeID, opts)
+	require.Nil(t, err)
+	assert.Equal(t, 0, totalSize)
+	assert.Empty(t, deliveries)
+	_, err = store.GetNotificationSubscription(fakeID)
+	assert.NotNil(t, err)
+}
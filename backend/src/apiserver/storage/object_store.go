@@ -16,8 +16,11 @@ package storage
 
 import (
 	"bytes"
+	"io"
+	"io/ioutil"
 	"path"
 	"regexp"
+	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go/v6"
@@ -36,6 +39,22 @@ type ObjectStoreInterface interface {
 	AddAsYamlFile(o interface{}, filePath string) error
 	GetFromYamlFile(o interface{}, filePath string) error
 	GetPipelineKey(pipelineId string) string
+	// StatFile returns the size and modification time of a file, or a not found
+	// error if it doesn't exist.
+	StatFile(filePath string) (*ObjectInfo, error)
+	// GetFileRange streams length bytes of a file starting at offset. A negative
+	// length reads until the end of the file.
+	GetFileRange(filePath string, offset int64, length int64) (io.ReadCloser, error)
+	// GetSignedURL returns a URL which allows downloading the file without
+	// credentials until expiry elapses.
+	GetSignedURL(filePath string, expiry time.Duration) (string, error)
+}
+
+// ObjectInfo describes a file in the object store.
+type ObjectInfo struct {
+	Size        int64
+	ModTime     time.Time
+	ContentType string
 }
 
 // Managing pipeline using Minio.
@@ -97,6 +116,49 @@ func (m *MinioObjectStore) GetFile(filePath string) ([]byte, error) {
 	return bytes, nil
 }
 
+func (m *MinioObjectStore) StatFile(filePath string) (*ObjectInfo, error) {
+	info, err := m.minioClient.StatObject(m.bucketName, filePath, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, util.NewResourceNotFoundError("file", filePath)
+		}
+		return nil, util.NewInternalServerError(err, "Failed to get the attributes of file %v", filePath)
+	}
+	return &ObjectInfo{Size: info.Size, ModTime: info.LastModified, ContentType: info.ContentType}, nil
+}
+
+func (m *MinioObjectStore) GetFileRange(filePath string, offset int64, length int64) (io.ReadCloser, error) {
+	if length == 0 {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+	opts := minio.GetObjectOptions{}
+	var err error
+	if length > 0 {
+		err = opts.SetRange(offset, offset+length-1)
+	} else if offset > 0 {
+		err = opts.SetRange(offset, 0)
+	}
+	if err != nil {
+		return nil, util.NewInvalidInputError("Invalid range of file %v: %v", filePath, err.Error())
+	}
+	reader, err := m.minioClient.GetObject(m.bucketName, filePath, opts)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get file %v", filePath)
+	}
+	if readCloser, ok := reader.(io.ReadCloser); ok {
+		return readCloser, nil
+	}
+	return ioutil.NopCloser(reader), nil
+}
+
+func (m *MinioObjectStore) GetSignedURL(filePath string, expiry time.Duration) (string, error) {
+	signedURL, err := m.minioClient.PresignedGetObject(m.bucketName, filePath, expiry, nil)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to sign a URL for file %v", filePath)
+	}
+	return signedURL.String(), nil
+}
+
 func (m *MinioObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
 	bytes, err := yaml.Marshal(o)
 	if err != nil {
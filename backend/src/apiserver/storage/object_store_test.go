@@ -17,7 +17,10 @@ package storage
 import (
 	"bytes"
 	"io"
+	"io/ioutil"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go/v6"
@@ -46,6 +49,14 @@ func (c *FakeBadMinioClient) DeleteObject(bucketName, objectName string) error {
 	return errors.New("some error")
 }
 
+func (c *FakeBadMinioClient) StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return minio.ObjectInfo{}, errors.New("some error")
+}
+
+func (c *FakeBadMinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+	return nil, errors.New("some error")
+}
+
 func TestAddFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -125,3 +136,56 @@ func TestGetFromYamlFile_UnmarshalError(t *testing.T) {
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 	assert.Contains(t, error.Error(), "Failed to unmarshal")
 }
+
+func TestStatFile(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	info, err := manager.StatFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), info.Size)
+
+	_, err = manager.StatFile(manager.GetPipelineKey("2"))
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	manager = &MinioObjectStore{minioClient: &FakeBadMinioClient{}, baseFolder: "pipeline"}
+	_, err = manager.StatFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetFileRange(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile([]byte("0123456789"), manager.GetPipelineKey("1"))
+	tests := []struct {
+		name   string
+		offset int64
+		length int64
+		want   string
+	}{
+		{name: "whole file", offset: 0, length: -1, want: "0123456789"},
+		{name: "from offset", offset: 4, length: -1, want: "456789"},
+		{name: "range", offset: 2, length: 3, want: "234"},
+		{name: "empty range", offset: 2, length: 0, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := manager.GetFileRange(manager.GetPipelineKey("1"), tt.offset, tt.length)
+			assert.Nil(t, err)
+			defer reader.Close()
+			content, err := ioutil.ReadAll(reader)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, string(content))
+		})
+	}
+}
+
+func TestGetSignedURL(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), bucketName: "mlpipeline", baseFolder: "pipeline"}
+	signedURL, err := manager.GetSignedURL("artifacts/run/file.tgz", 15*time.Minute)
+	assert.Nil(t, err)
+	assert.Contains(t, signedURL, "/mlpipeline/artifacts/run/file.tgz")
+	assert.Contains(t, signedURL, "X-Amz-Expires=900")
+
+	manager = &MinioObjectStore{minioClient: &FakeBadMinioClient{}, bucketName: "mlpipeline"}
+	_, err = manager.GetSignedURL("artifacts/run/file.tgz", 15*time.Minute)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+}
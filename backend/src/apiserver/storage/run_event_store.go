@@ -0,0 +1,163 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type RunEventStoreInterface interface {
+	// CreateRunEvent stores a run event, and sets its sequence number, which
+	// is assigned by the DB in increasing order.
+	CreateRunEvent(event *model.RunEvent) error
+	// ListRunEvents returns at most limit events with a sequence number greater
+	// than afterSequence, in sequence order.
+	ListRunEvents(afterSequence int64, limit int) ([]*model.RunEvent, error)
+	// GetRunEventSequenceRange returns the first and last sequence numbers of
+	// the stored events, zero if there are none.
+	GetRunEventSequenceRange() (int64, int64, error)
+	// DeleteRunEventsBefore deletes the events created before a time, except
+	// the last event, which keeps the last sequence number.
+	DeleteRunEventsBefore(createdAtInSec int64) error
+}
+
+type RunEventStore struct {
+	db *DB
+}
+
+var runEventColumns = []string{
+	"Sequence",
+	"Type",
+	"RunUUID",
+	"ExperimentUUID",
+	"Namespace",
+	"TaskId",
+	"TaskName",
+	"State",
+	"CreatedAtInSec",
+}
+
+func (s *RunEventStore) CreateRunEvent(event *model.RunEvent) error {
+	sql, args, err := sq.
+		Insert("run_events").
+		SetMap(sq.Eq{
+			"Type":           string(event.Type),
+			"RunUUID":        event.RunId,
+			"ExperimentUUID": event.ExperimentId,
+			"Namespace":      event.Namespace,
+			"TaskId":         event.TaskId,
+			"TaskName":       event.TaskName,
+			"State":          string(event.State),
+			"CreatedAtInSec": event.CreatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to insert run event: %v", err.Error())
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to add run event to run_events table: %v", err.Error())
+	}
+	sequence, err := result.LastInsertId()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to get the sequence number of a new run event: %v", err.Error())
+	}
+	event.Sequence = sequence
+	return nil
+}
+
+func (s *RunEventStore) ListRunEvents(afterSequence int64, limit int) ([]*model.RunEvent, error) {
+	sql, args, err := sq.
+		Select(runEventColumns...).
+		From("run_events").
+		Where(sq.Gt{"Sequence": afterSequence}).
+		OrderBy("Sequence").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list run events: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run events: %v", err.Error())
+	}
+	defer rows.Close()
+	events, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run events: %v", err.Error())
+	}
+	return events, nil
+}
+
+func (s *RunEventStore) GetRunEventSequenceRange() (int64, int64, error) {
+	sql, args, err := sq.
+		Select("coalesce(min(Sequence), 0)", "coalesce(max(Sequence), 0)").
+		From("run_events").
+		ToSql()
+	if err != nil {
+		return 0, 0, util.NewInternalServerError(err, "Failed to create query to get the sequence of run events: %v", err.Error())
+	}
+	var first, last int64
+	if err := s.db.QueryRow(sql, args...).Scan(&first, &last); err != nil {
+		return 0, 0, util.NewInternalServerError(err, "Failed to get the sequence of run events: %v", err.Error())
+	}
+	return first, last, nil
+}
+
+func (s *RunEventStore) DeleteRunEventsBefore(createdAtInSec int64) error {
+	// Keep the last event, so that the DB does not reuse its sequence number
+	// and the resume tokens of the watches stay valid.
+	_, last, err := s.GetRunEventSequenceRange()
+	if err != nil {
+		return err
+	}
+	sql, args, err := sq.
+		Delete("run_events").
+		Where(sq.And{sq.Lt{"CreatedAtInSec": createdAtInSec}, sq.Lt{"Sequence": last}}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete run events: %v", err.Error())
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete run events: %v", err.Error())
+	}
+	return nil
+}
+
+func (s *RunEventStore) scanRows(rows *sql.Rows) ([]*model.RunEvent, error) {
+	var events []*model.RunEvent
+	for rows.Next() {
+		var eventType, state string
+		event := &model.RunEvent{}
+		err := rows.Scan(&event.Sequence, &eventType, &event.RunId, &event.ExperimentId, &event.Namespace,
+			&event.TaskId, &event.TaskName, &state, &event.CreatedAtInSec)
+		if err != nil {
+			return nil, err
+		}
+		event.Type = model.RunEventType(eventType)
+		event.State = model.RuntimeState(state)
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// NewRunEventStore creates a new RunEventStore.
+func NewRunEventStore(db *DB) *RunEventStore {
+	return &RunEventStore{db: db}
+}
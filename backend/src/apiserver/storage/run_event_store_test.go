@@ -0,0 +1,115 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRunEvent(t *testing.T, store *RunEventStore, createdAtInSec int64) *model.RunEvent {
+	event := &model.RunEvent{
+		Type:           model.RunEventTypeRunStateChanged,
+		RunId:          "run1",
+		ExperimentId:   "experiment1",
+		Namespace:      "ns1",
+		State:          model.RuntimeStateRunning,
+		CreatedAtInSec: createdAtInSec,
+	}
+	assert.Nil(t, store.CreateRunEvent(event))
+	return event
+}
+
+func TestListRunEvents(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewRunEventStore(db)
+
+	event1 := createRunEvent(t, store, 10)
+	event2 := createRunEvent(t, store, 20)
+	event3 := createRunEvent(t, store, 30)
+
+	events, err := store.ListRunEvents(0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunEvent{event1, event2, event3}, events)
+
+	events, err = store.ListRunEvents(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunEvent{event2}, events)
+
+	events, err = store.ListRunEvents(3, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestCreateRunEvent_AssignsSequence(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewRunEventStore(db)
+
+	assert.Equal(t, int64(1), createRunEvent(t, store, 10).Sequence)
+	assert.Equal(t, int64(2), createRunEvent(t, store, 10).Sequence)
+
+	// A sequence number set by the caller is ignored.
+	event := &model.RunEvent{Sequence: 1, Type: model.RunEventTypeRunDeleted, RunId: "run1"}
+	assert.Nil(t, store.CreateRunEvent(event))
+	assert.Equal(t, int64(3), event.Sequence)
+}
+
+func TestGetRunEventSequenceRange(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewRunEventStore(db)
+
+	first, last, err := store.GetRunEventSequenceRange()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), first)
+	assert.Equal(t, int64(0), last)
+
+	createRunEvent(t, store, 10)
+	createRunEvent(t, store, 20)
+	first, last, err = store.GetRunEventSequenceRange()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), first)
+	assert.Equal(t, int64(2), last)
+}
+
+func TestDeleteRunEventsBefore(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	store := NewRunEventStore(db)
+
+	createRunEvent(t, store, 10)
+	createRunEvent(t, store, 20)
+	event3 := createRunEvent(t, store, 30)
+
+	assert.Nil(t, store.DeleteRunEventsBefore(30))
+	events, err := store.ListRunEvents(0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunEvent{event3}, events)
+
+	first, _, err := store.GetRunEventSequenceRange()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), first)
+
+	// The last event is kept, and the sequence continues after it.
+	assert.Nil(t, store.DeleteRunEventsBefore(100))
+	events, err = store.ListRunEvents(0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunEvent{event3}, events)
+	assert.Equal(t, int64(4), createRunEvent(t, store, 40).Sequence)
+}
@@ -95,6 +95,9 @@ type RunStoreInterface interface {
 
 	// Terminates a run.
 	TerminateRun(runId string) error
+
+	// Fetches the scheduled times of the runs of a recurring run within a time window.
+	ListRunScheduledTimes(jobId string, startTime int64, endTime int64) ([]int64, error)
 }
 
 type RunStore struct {
@@ -746,6 +749,36 @@ func (s *RunStore) TerminateRun(runId string) error {
 	return nil
 }
 
+func (s *RunStore) ListRunScheduledTimes(jobId string, startTime int64, endTime int64) ([]int64, error) {
+	sql, args, err := sq.
+		Select("ScheduledAtInSec").
+		From("run_details").
+		Where(sq.And{
+			sq.Eq{"JobUUID": jobId},
+			sq.GtOrEq{"ScheduledAtInSec": startTime},
+			sq.LtOrEq{"ScheduledAtInSec": endTime},
+		}).
+		OrderBy("ScheduledAtInSec").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list the scheduled times of the runs of recurring run %v", jobId)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the scheduled times of the runs of recurring run %v", jobId)
+	}
+	defer rows.Close()
+	var scheduledTimes []int64
+	for rows.Next() {
+		var scheduledTime int64
+		if err := rows.Scan(&scheduledTime); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to scan the scheduled times of the runs of recurring run %v", jobId)
+		}
+		scheduledTimes = append(scheduledTimes, scheduledTime)
+	}
+	return scheduledTimes, nil
+}
+
 // Add a metric as a new field to the select clause by join the passed-in SQL query with run_metrics table.
 // With the metric as a field in the select clause enable sorting on this metric afterwards.
 // TODO(jingzhang36): example of resulting SQL query and explanation for it.
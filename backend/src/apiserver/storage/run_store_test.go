@@ -1004,6 +1004,44 @@ func TestTerminateRun_RunHasAlreadyFinished(t *testing.T) {
 	assert.Contains(t, err.Error(), "Row not found")
 }
 
+func TestListRunScheduledTimes(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	jobStore := NewJobStore(db, util.NewFakeTimeForEpoch())
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:         "job1",
+		K8SName:      "job1",
+		DisplayName:  "job1",
+		ExperimentId: defaultFakeExpId,
+	})
+	assert.Nil(t, err)
+
+	for i, scheduledTime := range []int64{300, 100, 200, 400} {
+		_, err := runStore.CreateRun(&model.Run{
+			UUID:           fmt.Sprintf("job-run-%d", i),
+			ExperimentId:   defaultFakeExpId,
+			RecurringRunId: "job1",
+			K8SName:        fmt.Sprintf("job-run-%d", i),
+			StorageState:   model.StorageStateAvailable,
+			RunDetails: model.RunDetails{
+				CreatedAtInSec:   scheduledTime,
+				ScheduledAtInSec: scheduledTime,
+				State:            model.RuntimeStateRunning,
+			},
+		})
+		assert.Nil(t, err)
+	}
+
+	// Runs of other recurring runs and runs outside the window are ignored.
+	scheduledTimes, err := runStore.ListRunScheduledTimes("job1", 100, 300)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{100, 200, 300}, scheduledTimes)
+
+	scheduledTimes, err = runStore.ListRunScheduledTimes("job2", 0, 1000)
+	assert.Nil(t, err)
+	assert.Empty(t, scheduledTimes)
+}
+
 func TestCreateMetric_Success(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
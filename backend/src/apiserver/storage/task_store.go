@@ -62,6 +62,9 @@ type TaskStoreInterface interface {
 
 	// Creates new tasks or updates the existing ones.
 	CreateOrUpdateTasks(tasks []*model.Task) ([]*model.Task, error)
+
+	// Fetches the cache entries, i.e. tasks with a cache fingerprint, of the given ML Metadata executions.
+	ListCacheEntries(mlmdExecutionIds []string) ([]*model.Task, error)
 }
 
 type TaskStore struct {
@@ -323,6 +326,31 @@ func (s *TaskStore) GetTask(id string) (*model.Task, error) {
 	return tasks[0], nil
 }
 
+func (s *TaskStore) ListCacheEntries(mlmdExecutionIds []string) ([]*model.Task, error) {
+	if len(mlmdExecutionIds) == 0 {
+		return nil, nil
+	}
+	sql, args, err := sq.
+		Select(taskColumns...).
+		From("tasks").
+		Where(sq.Eq{"tasks.MLMDExecutionID": mlmdExecutionIds}).
+		Where(sq.NotEq{"tasks.Fingerprint": ""}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list cache entries: %v", err.Error())
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list cache entries: %v", err.Error())
+	}
+	defer r.Close()
+	tasks, err := s.scanRows(r)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list cache entries: %v", err.Error())
+	}
+	return tasks, nil
+}
+
 // Updates missing fields with existing data entries.
 func (s *TaskStore) patchWithExistingTasks(tasks []*model.Task) error {
 	var podNames []string
@@ -617,3 +617,29 @@ func TestTaskStore_UpdateOrCreateTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskStore_ListCacheEntries(t *testing.T) {
+	db, taskStore := initializeTaskStore()
+	defer db.Close()
+
+	// A task without fingerprint is not a cache entry.
+	taskStore.CreateTask(&model.Task{
+		Namespace:       "ns1",
+		PodName:         "pod6",
+		PipelineName:    "namespace/ns1/pipeline/pipeline1",
+		RunId:           defaultFakeRunIdThree,
+		MLMDExecutionID: "6",
+	})
+
+	tasks, err := taskStore.ListCacheEntries([]string{"2", "4", "6", "100"})
+	assert.Nil(t, err)
+	var executionIds []string
+	for _, task := range tasks {
+		executionIds = append(executionIds, task.MLMDExecutionID)
+	}
+	assert.ElementsMatch(t, []string{"2", "4"}, executionIds)
+
+	tasks, err = taskStore.ListCacheEntries(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, tasks)
+}
@@ -69,6 +69,7 @@ func (t *Argo) RunWorkflow(modelRun *model.Run, options RunWorkflowOptions) (uti
 
 	// Add label to the workflow so it can be persisted by persistent agent later.
 	workflow.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
+	setOwnerLabels(workflow, modelRun.ExperimentId, modelRun.PipelineSpec.PipelineId)
 	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
 	workflow.SetAnnotations(util.AnnotationKeyRunName, modelRun.DisplayName)
 	// Replace {{workflow.uid}} with runId
@@ -121,13 +122,14 @@ func (t *Argo) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Schedu
 	// Marking auto-added artifacts as optional. Otherwise most older workflows will start failing after upgrade to Argo 2.3.
 	// TODO: Fix the components to explicitly declare the artifacts they really output.
 	workflow.PatchTemplateOutputArtifacts()
+	setOwnerLabels(workflow, modelJob.ExperimentId, modelJob.PipelineSpec.PipelineId)
 
 	// We assume that v1 Argo template use v1 parameters ignoring runtime config
 	swfParameters, err := stringArrayToCRDParameters(modelJob.Parameters)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to convert v1 parameters to CRD parameters")
 	}
-	crdTrigger, err := modelToCRDTrigger(modelJob.Trigger)
+	crdTrigger, err := ModelToCRDTrigger(modelJob.Trigger)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +148,10 @@ func (t *Argo) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Schedu
 				Parameters: swfParameters,
 				Spec:       workflow.ToStringForSchedule(),
 			},
-			NoCatchup: util.BoolPointer(modelJob.NoCatchup),
+			NoCatchup:                util.BoolPointer(modelJob.NoCatchup),
+			ConcurrencyPolicy:        scheduledworkflow.ConcurrencyPolicy(modelJob.ConcurrencyPolicy),
+			FailurePolicy:            modelToCRDFailurePolicy(modelJob),
+			UseLatestPipelineVersion: modelJob.UseLatestPipelineVersion,
 		},
 	}
 	return scheduledWorkflow, nil
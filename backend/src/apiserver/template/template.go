@@ -180,6 +180,9 @@ func stringMapToCRDParameters(modelParams string) ([]scheduledworkflow.Parameter
 		return nil, util.NewInternalServerError(err, "error unmarshalling model parameters")
 	}
 	for name, value := range parameters {
+		if err := validateParameterValueMacros(value); err != nil {
+			return nil, util.Wrapf(err, "Invalid parameter %v", name)
+		}
 		valueBytes, err := value.MarshalJSON()
 		if err != nil {
 			return nil, util.NewInternalServerError(err, "error marshalling model parameters")
@@ -208,11 +211,36 @@ func stringArrayToCRDParameters(modelParameters string) ([]scheduledworkflow.Par
 		return nil, util.NewInternalServerError(err, "error unmarshalling model parameters")
 	}
 	for _, param := range paramsMapList {
+		if err := util.ValidateParameterMacros((*param)["value"]); err != nil {
+			return nil, util.Wrapf(err, "Invalid parameter %v", (*param)["name"])
+		}
 		desiredParams = append(desiredParams, scheduledworkflow.Parameter{Name: (*param)["name"], Value: (*param)["value"]})
 	}
 	return desiredParams, nil
 }
 
+// validateParameterValueMacros validates the parameter macros of the strings in a
+// runtime config parameter value, which are formatted when the recurring run submits a run.
+func validateParameterValueMacros(value *structpb.Value) error {
+	switch v := value.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return util.ValidateParameterMacros(v.StringValue)
+	case *structpb.Value_ListValue:
+		for _, item := range v.ListValue.GetValues() {
+			if err := validateParameterValueMacros(item); err != nil {
+				return err
+			}
+		}
+	case *structpb.Value_StructValue:
+		for _, field := range v.StructValue.GetFields() {
+			if err := validateParameterValueMacros(field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func modelToParametersMap(modelParameters string) (map[string]string, error) {
 	var paramsMapList []*map[string]string
 	desiredParamsMap := make(map[string]string)
@@ -229,7 +257,7 @@ func modelToParametersMap(modelParameters string) (map[string]string, error) {
 	return desiredParamsMap, nil
 }
 
-func modelToCRDTrigger(modelTrigger model.Trigger) (scheduledworkflow.Trigger, error) {
+func ModelToCRDTrigger(modelTrigger model.Trigger) (scheduledworkflow.Trigger, error) {
 	crdTrigger := scheduledworkflow.Trigger{}
 	// CronSchedule and PeriodicSchedule can have at most one being non-empty
 	if modelTrigger.CronSchedule != (model.CronSchedule{}) {
@@ -246,6 +274,9 @@ func modelToCRDTrigger(modelTrigger model.Trigger) (scheduledworkflow.Trigger, e
 			endTime := metav1.NewTime(time.Unix(*modelTrigger.CronScheduleEndTimeInSec, 0))
 			crdCronSchedule.EndTime = &endTime
 		}
+		if modelTrigger.CronScheduleTimeZone != nil {
+			crdCronSchedule.TimeZone = *modelTrigger.CronScheduleTimeZone
+		}
 		crdTrigger.CronSchedule = &crdCronSchedule
 	} else if modelTrigger.PeriodicSchedule != (model.PeriodicSchedule{}) {
 		// Check if PeriodicSchedule is non-empty
@@ -262,10 +293,43 @@ func modelToCRDTrigger(modelTrigger model.Trigger) (scheduledworkflow.Trigger, e
 			crdPeriodicSchedule.EndTime = &endTime
 		}
 		crdTrigger.PeriodicSchedule = &crdPeriodicSchedule
+	} else if modelTrigger.ObjectStoreTrigger != (model.ObjectStoreTrigger{}) {
+		crdObjectStoreTrigger := scheduledworkflow.ObjectStoreTrigger{}
+		if modelTrigger.ObjectStoreTriggerURI != nil {
+			crdObjectStoreTrigger.URI = *modelTrigger.ObjectStoreTriggerURI
+		}
+		if modelTrigger.ObjectStoreTriggerPollIntervalSecond != nil {
+			crdObjectStoreTrigger.PollIntervalSecond = *modelTrigger.ObjectStoreTriggerPollIntervalSecond
+		}
+		crdTrigger.ObjectStoreTrigger = &crdObjectStoreTrigger
+	} else if modelTrigger.RunCompletionTrigger != (model.RunCompletionTrigger{}) {
+		crdRunCompletionTrigger := scheduledworkflow.RunCompletionTrigger{}
+		if modelTrigger.RunCompletionTriggerExperimentId != nil {
+			crdRunCompletionTrigger.ExperimentID = *modelTrigger.RunCompletionTriggerExperimentId
+		}
+		if modelTrigger.RunCompletionTriggerPipelineId != nil {
+			crdRunCompletionTrigger.PipelineID = *modelTrigger.RunCompletionTriggerPipelineId
+		}
+		if modelTrigger.RunCompletionTriggerStates != nil && *modelTrigger.RunCompletionTriggerStates != "" {
+			crdRunCompletionTrigger.States = strings.Split(*modelTrigger.RunCompletionTriggerStates, ",")
+		}
+		crdTrigger.RunCompletionTrigger = &crdRunCompletionTrigger
 	}
 	return crdTrigger, nil
 }
 
+// Converts the failure policy of a job to its CRD representation.
+// Returns nil if the job has no failure policy.
+func modelToCRDFailurePolicy(modelJob *model.Job) *scheduledworkflow.FailurePolicy {
+	if modelJob.MaxConsecutiveFailures == 0 && modelJob.FailureWebhookURL == "" {
+		return nil
+	}
+	return &scheduledworkflow.FailurePolicy{
+		MaxConsecutiveFailures: modelJob.MaxConsecutiveFailures,
+		WebhookURL:             modelJob.FailureWebhookURL,
+	}
+}
+
 // Patch the system-specified default parameters if available.
 func OverrideParameterWithSystemDefault(execSpec util.ExecutionSpec) error {
 	// Patch the default value to workflow spec.
@@ -305,6 +369,17 @@ func setDefaultServiceAccount(workflow util.ExecutionSpec, serviceAccount string
 	}
 }
 
+// setOwnerLabels labels the workflow with the experiment and the pipeline of
+// the run, so that runs can be selected by the ScheduledWorkflow controller.
+func setOwnerLabels(workflow util.ExecutionSpec, experimentId string, pipelineId string) {
+	if experimentId != "" {
+		workflow.SetLabels(util.LabelKeyWorkflowExperimentId, experimentId)
+	}
+	if pipelineId != "" {
+		workflow.SetLabels(util.LabelKeyWorkflowPipelineId, pipelineId)
+	}
+}
+
 // Process the job name to remove special char, prepend with "job-" prefix if empty, and
 // truncate size to <=25.
 func toSWFCRDResourceGeneratedName(displayName string) (string, error) {
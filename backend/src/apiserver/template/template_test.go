@@ -176,10 +176,13 @@ func TestScheduledWorkflow(t *testing.T) {
 	v2Template, _ := New([]byte(v2SpecHelloWorldYAML))
 
 	modelJob := &model.Job{
-		K8SName:        "name1",
-		Enabled:        true,
-		MaxConcurrency: 1,
-		NoCatchup:      true,
+		K8SName:                "name1",
+		Enabled:                true,
+		MaxConcurrency:         1,
+		NoCatchup:              true,
+		ConcurrencyPolicy:      "Forbid",
+		MaxConsecutiveFailures: 3,
+		FailureWebhookURL:      "https://example.com/hook",
 		Trigger: model.Trigger{
 			CronSchedule: model.CronSchedule{
 				CronScheduleStartTimeInSec: util.Int64Pointer(1),
@@ -217,7 +220,12 @@ func TestScheduledWorkflow(t *testing.T) {
 				Parameters: []scheduledworkflow.Parameter{{Name: "y", Value: "\"world\""}},
 				Spec:       "",
 			},
-			NoCatchup: util.BoolPointer(true),
+			NoCatchup:         util.BoolPointer(true),
+			ConcurrencyPolicy: scheduledworkflow.ForbidConcurrent,
+			FailurePolicy: &scheduledworkflow.FailurePolicy{
+				MaxConsecutiveFailures: 3,
+				WebhookURL:             "https://example.com/hook",
+			},
 		},
 	}
 
@@ -230,6 +238,31 @@ func TestScheduledWorkflow(t *testing.T) {
 	assert.Equal(t, &expectedScheduledWorkflow, actualScheduledWorkflow)
 }
 
+func TestScheduledWorkflow_InvalidParameterMacro(t *testing.T) {
+	v2SpecHelloWorldYAML := loadYaml(t, "testdata/hello_world.yaml")
+	v2Template, _ := New([]byte(v2SpecHelloWorldYAML))
+
+	modelJob := &model.Job{
+		K8SName: "name1",
+		Enabled: true,
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorldYAML,
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters: `{"y":"{{scheduled_time | date}}"}`,
+			},
+		},
+	}
+	_, err := v2Template.ScheduledWorkflow(modelJob)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Invalid parameter y")
+
+	_, err = stringArrayToCRDParameters(`[{"name":"date","value":"{{scheduled_time - 1d}}"}]`)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Invalid parameter date")
+}
+
 func TestModelToCRDTrigger_Cron(t *testing.T) {
 	inputModelTrigger := model.Trigger{
 		CronSchedule: model.CronSchedule{
@@ -246,11 +279,63 @@ func TestModelToCRDTrigger_Cron(t *testing.T) {
 		},
 	}
 
-	actualCRDTrigger, err := modelToCRDTrigger(inputModelTrigger)
+	actualCRDTrigger, err := ModelToCRDTrigger(inputModelTrigger)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedCRDTrigger, actualCRDTrigger)
 }
 
+func TestModelToCRDTrigger_CronTimeZone(t *testing.T) {
+	inputModelTrigger := model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron:                 util.StringPointer("0 0 2 * * *"),
+			CronScheduleTimeZone: util.StringPointer("Europe/Paris"),
+		},
+	}
+	expectedCRDTrigger := scheduledworkflow.Trigger{
+		CronSchedule: &scheduledworkflow.CronSchedule{
+			Cron:     "0 0 2 * * *",
+			TimeZone: "Europe/Paris",
+		},
+	}
+
+	actualCRDTrigger, err := ModelToCRDTrigger(inputModelTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedCRDTrigger, actualCRDTrigger)
+}
+
+func TestModelToCRDTrigger_EventDriven(t *testing.T) {
+	inputModelTrigger := model.Trigger{
+		ObjectStoreTrigger: model.ObjectStoreTrigger{
+			ObjectStoreTriggerURI:                util.StringPointer("s3://bucket/data/"),
+			ObjectStoreTriggerPollIntervalSecond: util.Int64Pointer(30),
+		},
+	}
+	actualCRDTrigger, err := ModelToCRDTrigger(inputModelTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, scheduledworkflow.Trigger{
+		ObjectStoreTrigger: &scheduledworkflow.ObjectStoreTrigger{
+			URI:                "s3://bucket/data/",
+			PollIntervalSecond: 30,
+		},
+	}, actualCRDTrigger)
+
+	inputModelTrigger = model.Trigger{
+		RunCompletionTrigger: model.RunCompletionTrigger{
+			RunCompletionTriggerExperimentId: util.StringPointer("exp1"),
+			RunCompletionTriggerPipelineId:   util.StringPointer(""),
+			RunCompletionTriggerStates:       util.StringPointer("SUCCEEDED,FAILED"),
+		},
+	}
+	actualCRDTrigger, err = ModelToCRDTrigger(inputModelTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, scheduledworkflow.Trigger{
+		RunCompletionTrigger: &scheduledworkflow.RunCompletionTrigger{
+			ExperimentID: "exp1",
+			States:       []string{"SUCCEEDED", "FAILED"},
+		},
+	}, actualCRDTrigger)
+}
+
 func loadYaml(t *testing.T, path string) string {
 	res, err := ioutil.ReadFile(path)
 	if err != nil {
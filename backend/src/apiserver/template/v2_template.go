@@ -90,6 +90,7 @@ func (t *V2Spec) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Sche
 	setDefaultServiceAccount(executionSpec, modelJob.ServiceAccount)
 	// Disable istio sidecar injection if not specified
 	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
+	setOwnerLabels(executionSpec, modelJob.ExperimentId, modelJob.PipelineSpec.PipelineId)
 	swfGeneratedName, err := toSWFCRDResourceGeneratedName(modelJob.K8SName)
 	if err != nil {
 		return nil, util.Wrap(err, "Create job failed")
@@ -98,7 +99,7 @@ func (t *V2Spec) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Sche
 	if err != nil {
 		return nil, util.Wrap(err, "Converting runtime config's parameters to CDR parameters failed")
 	}
-	crdTrigger, err := modelToCRDTrigger(modelJob.Trigger)
+	crdTrigger, err := ModelToCRDTrigger(modelJob.Trigger)
 	if err != nil {
 		return nil, util.Wrap(err, "converting model trigger to crd trigger failed")
 	}
@@ -117,7 +118,10 @@ func (t *V2Spec) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Sche
 				Parameters: parameters,
 				Spec:       executionSpec.ToStringForSchedule(),
 			},
-			NoCatchup: util.BoolPointer(modelJob.NoCatchup),
+			NoCatchup:                util.BoolPointer(modelJob.NoCatchup),
+			ConcurrencyPolicy:        scheduledworkflow.ConcurrencyPolicy(modelJob.ConcurrencyPolicy),
+			FailurePolicy:            modelToCRDFailurePolicy(modelJob),
+			UseLatestPipelineVersion: modelJob.UseLatestPipelineVersion,
 		},
 	}
 	return scheduledWorkflow, nil
@@ -302,6 +306,7 @@ func (t *V2Spec) RunWorkflow(modelRun *model.Run, options RunWorkflowOptions) (u
 	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
 	// Add label to the workflow so it can be persisted by persistent agent later.
 	executionSpec.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
+	setOwnerLabels(executionSpec, modelRun.ExperimentId, modelRun.PipelineSpec.PipelineId)
 	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
 	executionSpec.SetAnnotations(util.AnnotationKeyRunName, modelRun.DisplayName)
 	// Replace {{workflow.uid}} with runId
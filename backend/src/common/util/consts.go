@@ -43,6 +43,17 @@ const (
 	LabelKeyWorkflowRunId               = "pipeline/runid"
 	LabelKeyWorkflowPersistedFinalState = "pipeline/persistedFinalState"
 
+	// LabelKeyWorkflowExperimentId is a label on a Workflow.
+	// It captures the ID of the experiment of the run.
+	LabelKeyWorkflowExperimentId = "pipelines.kubeflow.org/experiment_id"
+	// LabelKeyWorkflowPipelineId is a label on a Workflow.
+	// It captures the ID of the pipeline of the run.
+	LabelKeyWorkflowPipelineId = "pipelines.kubeflow.org/pipeline_id"
+	// LabelKeyWorkflowPipelineVersionId is a label on a Workflow.
+	// It captures the ID of the pipeline version of a run created by a
+	// recurring run that uses the latest version of its pipeline.
+	LabelKeyWorkflowPipelineVersionId = "pipelines.kubeflow.org/pipeline_version_id"
+
 	// LabelKeyWorkflowEpoch is a Workflow annotation key.
 	// It captures the the name of the Run.
 	AnnotationKeyRunName = "pipelines.kubeflow.org/run_name"
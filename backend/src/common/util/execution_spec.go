@@ -62,6 +62,10 @@ type ExecutionSpec interface {
 	// OverrideParameters overrides some of the parameters.
 	OverrideParameters(desiredParams map[string]string)
 
+	// FormatRuntimeConfig substitutes the special strings in the parameters of the
+	// runtime config of v2 pipelines.
+	FormatRuntimeConfig(formatter *ParameterFormatter) error
+
 	// SetAnnotationsToAllTemplatesIfKeyNotExist sets annotations on all templates in a Workflow
 	// if the annotation key does not exist
 	SetAnnotationsToAllTemplatesIfKeyNotExist(key string, value string)
@@ -101,6 +105,10 @@ type ExecutionSpec interface {
 
 	SetVersion(version string)
 
+	// A hash of the labels and the status of the ExecutionSpec, which changes
+	// when the ExecutionSpec has to be reported again. Empty if it cannot be hashed.
+	StatusHash() string
+
 	// Name of the ExecutionSpec
 	// having Execution prefix to avoid name conflict with underlying data struct
 	ExecutionName() string
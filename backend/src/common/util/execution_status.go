@@ -20,10 +20,14 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// The type of the nodes running a pod.
+const NodeTypePod = "Pod"
+
 // Data struct to represent Node status
 type NodeStatus struct {
 	ID          string
 	DisplayName string
+	Type        string
 	State       string
 	StartTime   int64
 	CreateTime  int64
@@ -31,6 +31,7 @@ const (
 	IndexExpression         = "[[Index]]"
 	scheduledTimePrefix     = "[[ScheduledTime."
 	currentTimePrefix       = "[[CurrentTime."
+	triggerEventPrefix      = "[[TriggerEvent."
 	defaultTimeFormat       = "20060102150405"
 	suffix                  = "]]"
 
@@ -51,15 +52,25 @@ type ParameterFormatter struct {
 	scheduledEpoch int64
 	nowEpoch       int64
 	index          int64
+	// Start of the window of time covered by the run, substituted by
+	// {{window_start}}.
+	windowStartEpoch int64
+	// Time zone of the schedule, in which the date and strftime macros format
+	// the times. Nil for UTC.
+	location *time.Location
+	// Details of the event which triggered the run, substituted by
+	// [[TriggerEvent.<key>]]. Nil when the run was not triggered by an event.
+	event map[string]string
 }
 
 // NewRunParameterFormatter returns a new ParameterFormatter to substitute run macros.
 func NewRunParameterFormatter(runUUID string, runAt int64) *ParameterFormatter {
 	return &ParameterFormatter{
-		runUUID:        runUUID,
-		nowEpoch:       runAt,
-		scheduledEpoch: disabledField,
-		index:          disabledField,
+		runUUID:          runUUID,
+		nowEpoch:         runAt,
+		scheduledEpoch:   disabledField,
+		index:            disabledField,
+		windowStartEpoch: disabledField,
 	}
 }
 
@@ -68,13 +79,35 @@ func NewSWFParameterFormatter(runUUID string, scheduledEpoch int64, nowEpoch int
 	index int64,
 ) *ParameterFormatter {
 	return &ParameterFormatter{
-		runUUID:        runUUID,
-		scheduledEpoch: scheduledEpoch,
-		nowEpoch:       nowEpoch,
-		index:          index,
+		runUUID:          runUUID,
+		scheduledEpoch:   scheduledEpoch,
+		nowEpoch:         nowEpoch,
+		index:            index,
+		windowStartEpoch: disabledField,
 	}
 }
 
+// NewSWFEventParameterFormatter returns a new ParameterFormatter to substitute recurring run macros,
+// including the details of the event which triggered the run.
+func NewSWFEventParameterFormatter(runUUID string, scheduledEpoch int64, nowEpoch int64,
+	index int64, event map[string]string,
+) *ParameterFormatter {
+	formatter := NewSWFParameterFormatter(runUUID, scheduledEpoch, nowEpoch, index)
+	formatter.event = event
+	return formatter
+}
+
+// SetWindowStart sets the start of the window of time covered by the run.
+func (p *ParameterFormatter) SetWindowStart(windowStartEpoch int64) {
+	p.windowStartEpoch = windowStartEpoch
+}
+
+// SetLocation sets the time zone of the schedule, in which the date and strftime
+// macros format the times.
+func (p *ParameterFormatter) SetLocation(location *time.Location) {
+	p.location = location
+}
+
 func (p *ParameterFormatter) FormatWorkflowParameters(
 	parameters map[string]string,
 ) map[string]string {
@@ -88,6 +121,7 @@ func (p *ParameterFormatter) FormatWorkflowParameters(
 
 // Format substitutes special strings in the provided string.
 func (p *ParameterFormatter) Format(s string) string {
+	s = parameterMacroRegexp.ReplaceAllStringFunc(s, p.formatMacro)
 	re := regexp.MustCompile(`\[\[(.*?)\]\]|\{\{\$\.(.*?)\}\}`)
 	matches := re.FindAllString(s, -1)
 	if matches == nil {
@@ -140,7 +174,38 @@ func (p *ParameterFormatter) createSubstitutes(match string) string {
 			return match
 		}
 		return formatter.FormatString(time.Unix(p.nowEpoch, 0).UTC())
+	} else if p.event != nil && strings.HasPrefix(match, triggerEventPrefix) {
+		key := strings.TrimSuffix(strings.TrimPrefix(match, triggerEventPrefix), suffix)
+		if value, ok := p.event[key]; ok {
+			return value
+		}
+		return match
 	} else {
 		return match
 	}
 }
+
+// formatMacro substitutes a parameter macro. Malformed macros, and macros whose variable is
+// not available, are left as they are.
+func (p *ParameterFormatter) formatMacro(match string) string {
+	macro, err := parseParameterMacro(match)
+	if err != nil {
+		glog.Errorf("Could not parse the parameter macro '%v'. Error: %v", match, err)
+		return match
+	}
+	var value int64
+	switch macro.variable {
+	case MacroScheduledTime, MacroWindowEnd:
+		value = p.scheduledEpoch
+	case MacroCurrentTime:
+		value = p.nowEpoch
+	case MacroWindowStart:
+		value = p.windowStartEpoch
+	case MacroIndex:
+		value = p.index
+	}
+	if value == disabledField {
+		return match
+	}
+	return macro.format(value, p.location)
+}
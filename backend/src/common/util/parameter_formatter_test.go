@@ -16,6 +16,7 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -58,3 +59,75 @@ func TestParameterFormatter_Format(t *testing.T) {
 	assert.Equal(t, "FOO 1970-01-01 00:00:25 FOO", formatter.Format("FOO {{$.scheduledTime.strftime('%Y-%m-%d %H:%M:%S')}} FOO"))
 	assert.Equal(t, "FOO 1970-01-01 00:00:26 FOO", formatter.Format("FOO {{$.currentTime.strftime('%Y-%m-%d %H:%M:%S')}} FOO"))
 }
+
+func TestParameterFormatter_FormatTriggerEvent(t *testing.T) {
+	formatter := NewSWFEventParameterFormatter(
+		"some-run-uuid",
+		25, /* scheduled time */
+		26, /* current time */
+		27, /* index */
+		map[string]string{"uri": "s3://bucket/data/file.csv", "key": "data/file.csv"})
+
+	assert.Equal(t, "FOO s3://bucket/data/file.csv FOO", formatter.Format("FOO [[TriggerEvent.uri]] FOO"))
+	assert.Equal(t, "data/file.csv 27", formatter.Format("[[TriggerEvent.key]] [[Index]]"))
+
+	// Unknown event details are not substituted.
+	assert.Equal(t, "FOO [[TriggerEvent.run_id]] FOO", formatter.Format("FOO [[TriggerEvent.run_id]] FOO"))
+
+	// Event macros are not substituted for runs which were not triggered by an event.
+	formatter = NewSWFParameterFormatter("some-run-uuid", 25, 26, 27)
+	assert.Equal(t, "FOO [[TriggerEvent.uri]] FOO", formatter.Format("FOO [[TriggerEvent.uri]] FOO"))
+}
+
+func TestParameterFormatter_FormatMacros(t *testing.T) {
+	day := int64(24 * 60 * 60)
+	formatter := NewSWFParameterFormatter(
+		"some-run-uuid",
+		19*day+3600, /* scheduled time */
+		19*day+3700, /* current time */
+		27 /* index */)
+	formatter.SetWindowStart(18*day + 3600)
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"{{scheduled_time}}", "1970-01-20T01:00:00Z"},
+		{"{{ current_time | unix }}", "1645300"},
+		{`FOO {{scheduled_time - 24h | date "2006-01-02"}} FOO`, "FOO 1970-01-19 FOO"},
+		{`{{window_start | date "2006-01-02T15"}}/{{window_end | date "2006-01-02T15"}}`, "1970-01-19T01/1970-01-20T01"},
+		{`{{scheduled_time + 90m | strftime "%Y/%m/%d %H:%M"}}`, "1970/01/20 02:30"},
+		{"run-{{index}}", "run-27"},
+		{"{{index}} [[Index]] [[ScheduledTime]]", "27 27 19700120010000"},
+		// Malformed macros and other templates are left as they are.
+		{"{{scheduled_time | unknown}}", "{{scheduled_time | unknown}}"},
+		{"{{inputs.parameters.message}}", "{{inputs.parameters.message}}"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, formatter.Format(test.value), test.value)
+	}
+
+	// The date and strftime macros format the times in the time zone of the schedule,
+	// unless the macro has a time zone.
+	location, err := time.LoadLocation("Asia/Tokyo")
+	assert.Nil(t, err)
+	formatter.SetLocation(location)
+	tests = []struct {
+		value    string
+		expected string
+	}{
+		{"{{scheduled_time}}", "1970-01-20T01:00:00Z"},
+		{`{{scheduled_time | date "2006-01-02T15"}}`, "1970-01-20T10"},
+		{`{{scheduled_time | strftime "%Y/%m/%d %H:%M"}}`, "1970/01/20 10:00"},
+		{`{{scheduled_time | date "2006-01-02T15" "UTC"}}`, "1970-01-20T01"},
+		{`{{scheduled_time | strftime "%H:%M" "UTC"}}`, "01:00"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, formatter.Format(test.value), test.value)
+	}
+
+	// Macros are not substituted when their variable is not available.
+	formatter = NewRunParameterFormatter("some-run-uuid", 26)
+	assert.Equal(t, "{{scheduled_time}} 1970-01-01T00:00:26Z",
+		formatter.Format("{{scheduled_time}} {{current_time}}"))
+}
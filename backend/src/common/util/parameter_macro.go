@@ -0,0 +1,215 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/strftime"
+)
+
+// Parameter macros are substituted in the parameters of recurring runs when their runs are
+// created. A macro has the form
+//
+//	{{<variable> [+|- <duration>] [| <function> ["<argument>"] ["<time zone>"]]}}
+//
+// The variables are:
+//   - scheduled_time: the time at which the run is scheduled.
+//   - current_time: the time at which the run is created.
+//   - window_start: the scheduled time of the previous run of the schedule, i.e. the start
+//     of the window of time covered by the run.
+//   - window_end: the end of the window of time covered by the run, i.e. its scheduled time.
+//   - index: the index of the run (e.g. 3 means that it is the 3rd run of the schedule).
+//
+// Time variables can be offset by a Go duration (e.g. 24h, 90m), and formatted by one of
+// the functions below. Without a function, times are formatted as RFC 3339 in UTC.
+//   - date "<layout>": formats the time with a Go time layout (e.g. "2006-01-02").
+//   - strftime "<format>": formats the time with a strftime format (e.g. "%Y/%m/%d").
+//   - unix: formats the time as the number of seconds since the epoch.
+//
+// The date and strftime functions format the time in the time zone of the schedule, or in
+// UTC if the schedule has no time zone. An IANA time zone given as a second argument, e.g.
+// "UTC" or "Europe/Paris", is used instead.
+//
+// For example, {{scheduled_time - 24h | date "2006-01-02"}} is the date of the day before
+// the scheduled time of the run, and {{scheduled_time | date "15:04" "UTC"}} is its time
+// in UTC.
+const (
+	MacroScheduledTime = "scheduled_time"
+	MacroCurrentTime   = "current_time"
+	MacroWindowStart   = "window_start"
+	MacroWindowEnd     = "window_end"
+	MacroIndex         = "index"
+
+	macroFunctionDate     = "date"
+	macroFunctionStrftime = "strftime"
+	macroFunctionUnix     = "unix"
+)
+
+var (
+	parameterMacroRegexp = regexp.MustCompile(
+		`\{\{\s*(?:scheduled_time|current_time|window_start|window_end|index)\b.*?\}\}`)
+	macroVariableRegexp = regexp.MustCompile(`^\s*([a-z_]+)\s*(?:([+-])\s*(\S+))?\s*$`)
+	macroFunctionRegexp = regexp.MustCompile(
+		`^\s*([a-z]+)(?:\s+("(?:[^"\\]|\\.)*"))?(?:\s+("(?:[^"\\]|\\.)*"))?\s*$`)
+)
+
+// parameterMacro is a parsed parameter macro.
+type parameterMacro struct {
+	variable string
+	offset   time.Duration
+	function string
+	argument string
+	// The time zone of the date and strftime functions, empty for the one of the schedule.
+	timeZone string
+}
+
+// ValidateParameterMacros returns an error if the value has a malformed parameter macro.
+func ValidateParameterMacros(value string) error {
+	for _, match := range parameterMacroRegexp.FindAllString(value, -1) {
+		if _, err := parseParameterMacro(match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseParameterMacro parses a macro, including its braces.
+func parseParameterMacro(macro string) (*parameterMacro, error) {
+	expression := strings.TrimSuffix(strings.TrimPrefix(macro, "{{"), "}}")
+	// The variable and its offset have no quotes, so the first pipe separates the function.
+	variableExpression, functionExpression := expression, ""
+	if i := strings.Index(expression, "|"); i >= 0 {
+		variableExpression, functionExpression = expression[:i], expression[i+1:]
+	}
+
+	result := &parameterMacro{}
+	parts := macroVariableRegexp.FindStringSubmatch(variableExpression)
+	if parts == nil {
+		return nil, NewInvalidInputError("Invalid parameter macro %v: expected a variable with an optional offset", macro)
+	}
+	result.variable = parts[1]
+	if parts[2] != "" {
+		offset, err := time.ParseDuration(parts[3])
+		if err != nil {
+			return nil, NewInvalidInputError("Invalid parameter macro %v: invalid offset %v", macro, parts[3])
+		}
+		if parts[2] == "-" {
+			offset = -offset
+		}
+		result.offset = offset
+	}
+
+	if i := strings.Index(expression, "|"); i >= 0 {
+		parts = macroFunctionRegexp.FindStringSubmatch(functionExpression)
+		if parts == nil {
+			return nil, NewInvalidInputError("Invalid parameter macro %v: expected a function with an optional quoted argument", macro)
+		}
+		result.function = parts[1]
+		if parts[2] != "" {
+			argument, err := strconv.Unquote(parts[2])
+			if err != nil {
+				return nil, NewInvalidInputError("Invalid parameter macro %v: invalid argument %v", macro, parts[2])
+			}
+			result.argument = argument
+		}
+		if parts[3] != "" {
+			timeZone, err := strconv.Unquote(parts[3])
+			if err != nil {
+				return nil, NewInvalidInputError("Invalid parameter macro %v: invalid time zone %v", macro, parts[3])
+			}
+			result.timeZone = timeZone
+		}
+	}
+	return result, result.validate(macro)
+}
+
+func (m *parameterMacro) validate(macro string) error {
+	switch m.variable {
+	case MacroScheduledTime, MacroCurrentTime, MacroWindowStart, MacroWindowEnd, MacroIndex:
+	default:
+		return NewInvalidInputError("Invalid parameter macro %v: unknown variable %v", macro, m.variable)
+	}
+	if m.variable == MacroIndex {
+		if m.offset != 0 || m.function != "" {
+			return NewInvalidInputError("Invalid parameter macro %v: %v has neither offsets nor functions", macro, MacroIndex)
+		}
+		return nil
+	}
+	switch m.function {
+	case "":
+	case macroFunctionDate:
+		if m.argument == "" {
+			return NewInvalidInputError("Invalid parameter macro %v: %v needs a layout", macro, macroFunctionDate)
+		}
+	case macroFunctionStrftime:
+		if _, err := strftime.New(m.argument); err != nil || m.argument == "" {
+			return NewInvalidInputError("Invalid parameter macro %v: invalid strftime format %q", macro, m.argument)
+		}
+	case macroFunctionUnix:
+		if m.argument != "" || m.timeZone != "" {
+			return NewInvalidInputError("Invalid parameter macro %v: %v has no argument", macro, macroFunctionUnix)
+		}
+	default:
+		return NewInvalidInputError("Invalid parameter macro %v: unknown function %v", macro, m.function)
+	}
+	if m.timeZone != "" {
+		if _, err := time.LoadLocation(m.timeZone); err != nil {
+			return NewInvalidInputError("Invalid parameter macro %v: invalid time zone %q", macro, m.timeZone)
+		}
+	}
+	return nil
+}
+
+// format returns the value of the macro given the value of its variable, which is an
+// epoch for the time variables. The date and strftime functions format the time in
+// location, unless the macro has a time zone.
+func (m *parameterMacro) format(value int64, location *time.Location) string {
+	if m.variable == MacroIndex {
+		return fmt.Sprintf("%v", value)
+	}
+	t := time.Unix(value, 0).UTC().Add(m.offset)
+	switch m.function {
+	case macroFunctionDate:
+		return t.In(m.location(location)).Format(m.argument)
+	case macroFunctionStrftime:
+		formatter, err := strftime.New(m.argument)
+		if err != nil {
+			return ""
+		}
+		return formatter.FormatString(t.In(m.location(location)))
+	case macroFunctionUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	default:
+		return t.Format(time.RFC3339)
+	}
+}
+
+// location returns the time zone of the date and strftime functions.
+func (m *parameterMacro) location(location *time.Location) *time.Location {
+	if m.timeZone != "" {
+		if macroLocation, err := time.LoadLocation(m.timeZone); err == nil {
+			return macroLocation
+		}
+	}
+	if location == nil {
+		return time.UTC
+	}
+	return location
+}
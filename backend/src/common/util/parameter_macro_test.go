@@ -0,0 +1,53 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateParameterMacros(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr string
+	}{
+		{value: "no macro"},
+		{value: "{{inputs.parameters.message}}"},
+		{value: `gs://bucket/{{scheduled_time - 24h | date "2006/01/02"}}/{{index}}`},
+		{value: `{{window_start|strftime "%Y-%m-%d"}} {{window_end | unix}} {{current_time}}`},
+		{value: `{{scheduled_time | date "15:04" "UTC"}} {{current_time | strftime "%H" "Asia/Tokyo"}}`},
+		{value: "{{scheduled_time - 1d}}", wantErr: "invalid offset 1d"},
+		{value: "{{scheduled_time * 2h}}", wantErr: "expected a variable"},
+		{value: "{{scheduled_time | date}}", wantErr: "date needs a layout"},
+		{value: "{{scheduled_time | date 2006}}", wantErr: "expected a function"},
+		{value: `{{scheduled_time | unix "s"}}`, wantErr: "unix has no argument"},
+		{value: `{{scheduled_time | date "15:04" "Mars/Olympus"}}`, wantErr: `invalid time zone "Mars/Olympus"`},
+		{value: "{{scheduled_time | format}}", wantErr: "unknown function format"},
+		{value: "{{index + 1h}}", wantErr: "index has neither offsets nor functions"},
+	}
+	for _, test := range tests {
+		err := ValidateParameterMacros(test.value)
+		if test.wantErr == "" {
+			assert.Nil(t, err, test.value)
+			continue
+		}
+		assert.NotNil(t, err, test.value)
+		assert.Equal(t, codes.InvalidArgument, err.(*UserError).ExternalStatusCode(), test.value)
+		assert.Contains(t, err.Error(), test.wantErr, test.value)
+	}
+}
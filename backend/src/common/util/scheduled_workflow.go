@@ -74,6 +74,13 @@ func (s *ScheduledWorkflow) CronOrEmpty() string {
 	return ""
 }
 
+func (s *ScheduledWorkflow) CronScheduleTimeZoneOrNull() *string {
+	if s.Spec.CronSchedule != nil && s.Spec.CronSchedule.TimeZone != "" {
+		return StringPointer(s.Spec.CronSchedule.TimeZone)
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) PeriodicScheduleStartTimeInSecOrNull() *int64 {
 	if s.Spec.PeriodicSchedule != nil && s.Spec.PeriodicSchedule.StartTime != nil {
 		return Int64Pointer(s.Spec.PeriodicSchedule.StartTime.Unix())
@@ -88,6 +95,41 @@ func (s *ScheduledWorkflow) PeriodicScheduleEndTimeInSecOrNull() *int64 {
 	return nil
 }
 
+func (s *ScheduledWorkflow) ObjectStoreTriggerURIOrNull() *string {
+	if s.Spec.ObjectStoreTrigger != nil {
+		return StringPointer(s.Spec.ObjectStoreTrigger.URI)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) ObjectStoreTriggerPollIntervalSecondOrNull() *int64 {
+	if s.Spec.ObjectStoreTrigger != nil {
+		return Int64Pointer(s.Spec.ObjectStoreTrigger.PollIntervalSecond)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionTriggerExperimentIdOrNull() *string {
+	if s.Spec.RunCompletionTrigger != nil {
+		return StringPointer(s.Spec.RunCompletionTrigger.ExperimentID)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionTriggerPipelineIdOrNull() *string {
+	if s.Spec.RunCompletionTrigger != nil {
+		return StringPointer(s.Spec.RunCompletionTrigger.PipelineID)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionTriggerStatesOrNull() *string {
+	if s.Spec.RunCompletionTrigger != nil {
+		return StringPointer(strings.Join(s.Spec.RunCompletionTrigger.States, ","))
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) MaxConcurrencyOr0() int64 {
 	if s.Spec.MaxConcurrency != nil {
 		return *s.Spec.MaxConcurrency
@@ -102,6 +144,20 @@ func (s *ScheduledWorkflow) NoCatchupOrFalse() bool {
 	return false
 }
 
+func (s *ScheduledWorkflow) MaxConsecutiveFailuresOr0() int64 {
+	if s.Spec.FailurePolicy != nil {
+		return s.Spec.FailurePolicy.MaxConsecutiveFailures
+	}
+	return 0
+}
+
+func (s *ScheduledWorkflow) FailureWebhookURLOrEmpty() string {
+	if s.Spec.FailurePolicy != nil {
+		return s.Spec.FailurePolicy.WebhookURL
+	}
+	return ""
+}
+
 func (s *ScheduledWorkflow) IntervalSecondOr0() int64 {
 	if s.Spec.PeriodicSchedule != nil {
 		return s.Spec.PeriodicSchedule.IntervalSecond
@@ -0,0 +1,154 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The networks of the cluster and of its nodes, which notifications are not
+// posted to unless they are allowed explicitly.
+var blockedNetworks = mustParseNetworks([]string{
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"fc00::/7",
+})
+
+func mustParseNetworks(cidrs []string) []*net.IPNet {
+	networks, err := parseNetworks(cidrs)
+	if err != nil {
+		panic(err)
+	}
+	return networks
+}
+
+func parseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid network %q", cidr)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// TargetPolicy restricts the addresses to which notifications are posted, so
+// that a user supplied URL can't reach the services of the cluster or the
+// metadata server of the cloud. Loopback, private, link-local and unspecified
+// addresses are blocked, unless they belong to an allowed network.
+type TargetPolicy struct {
+	allowedNetworks []*net.IPNet
+}
+
+// NewTargetPolicy creates a TargetPolicy which allows the addresses of a list
+// of networks in CIDR notation, e.g. the receivers deployed in the cluster.
+func NewTargetPolicy(allowedNetworks []string) (*TargetPolicy, error) {
+	networks, err := parseNetworks(allowedNetworks)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetPolicy{allowedNetworks: networks}, nil
+}
+
+// AllowsIP reports whether notifications may be posted to an IP address.
+func (p *TargetPolicy) AllowsIP(ip net.IP) bool {
+	for _, network := range p.allowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	if ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateTargetURL checks that the target URL of notifications is an absolute
+// http or https URL whose host is not a blocked address. Host names are checked
+// when connecting, since they may resolve to other addresses by then.
+func (p *TargetPolicy) ValidateTargetURL(targetURL string) error {
+	u, err := url.Parse(targetURL)
+	if err != nil {
+		return NewInvalidInputError("Invalid target URL %q: %v", targetURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return NewInvalidInputError("Invalid target URL %q: it must be an absolute http or https URL", targetURL)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return NewInvalidInputError("Invalid target URL %q: notifications can't be posted to localhost", targetURL)
+	}
+	if ip := net.ParseIP(host); ip != nil && !p.AllowsIP(ip) {
+		return NewInvalidInputError("Invalid target URL %q: notifications can't be posted to address %v", targetURL, ip)
+	}
+	return nil
+}
+
+// NewTransport creates an HTTP transport which only connects to the addresses
+// allowed by the policy. The addresses are checked when connecting, after the
+// host names are resolved, so the transport doesn't use a proxy.
+func (p *TargetPolicy) NewTransport(dialTimeout time.Duration) *http.Transport {
+	dialer := &net.Dialer{Timeout: dialTimeout, Control: p.control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// control checks the address of a connection after the host name of the target
+// URL is resolved, and before connecting to it.
+func (p *TargetPolicy) control(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid address %q", address)
+	}
+	if ip := net.ParseIP(host); ip == nil || !p.AllowsIP(ip) {
+		return &blockedTargetError{address: address}
+	}
+	return nil
+}
+
+// blockedTargetError is the error of a connection to a blocked address.
+type blockedTargetError struct {
+	address string
+}
+
+func (e *blockedTargetError) Error() string {
+	return "Notifications can't be posted to address " + e.address
+}
+
+// IsBlockedTargetError returns whether an error is caused by a connection to an
+// address blocked by a TargetPolicy. Such errors are permanent.
+func IsBlockedTargetError(err error) bool {
+	var blocked *blockedTargetError
+	return errors.As(err, &blocked)
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTargetURL(t *testing.T) {
+	policy, err := NewTargetPolicy([]string{"10.96.0.0/12"})
+	require.Nil(t, err)
+	for _, targetURL := range []string{
+		"https://hooks.slack.com/services/T0/B0/X",
+		"http://receiver.ns1:8080/notify",
+		"https://203.0.113.10/notify",
+		"http://10.96.0.10/notify",
+	} {
+		assert.Nil(t, policy.ValidateTargetURL(targetURL), targetURL)
+	}
+	for _, targetURL := range []string{
+		"", "hooks.slack.com/services", "ftp://example.com", "https://", "http://%zz",
+		"http://localhost:8888/", "http://metadata.localhost/",
+		"http://127.0.0.1/", "http://[::1]/", "http://169.254.169.254/computeMetadata/v1/",
+		"http://10.0.0.1/", "http://192.168.1.1/", "http://[fd00::1]/", "http://0.0.0.0/",
+	} {
+		assert.NotNil(t, policy.ValidateTargetURL(targetURL), targetURL)
+	}
+}
+
+func TestTargetPolicy_AllowsIP(t *testing.T) {
+	_, err := NewTargetPolicy([]string{"10.0.0.0"})
+	assert.NotNil(t, err)
+
+	policy, err := NewTargetPolicy([]string{"172.20.0.0/16"})
+	require.Nil(t, err)
+	tests := []struct {
+		ip      string
+		allowed bool
+	}{
+		{"203.0.113.10", true},
+		{"2001:db8::1", true},
+		{"172.20.1.1", true},
+		{"172.21.1.1", false},
+		{"127.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"100.64.0.1", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.allowed, policy.AllowsIP(net.ParseIP(tt.ip)), tt.ip)
+	}
+}
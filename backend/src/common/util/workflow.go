@@ -16,6 +16,8 @@ package util
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
@@ -256,6 +258,19 @@ func (w *Workflow) SetVersion(version string) {
 	w.ResourceVersion = version
 }
 
+func (w *Workflow) StatusHash() string {
+	state, err := json.Marshal(struct {
+		Labels map[string]string          `json:"labels"`
+		Status workflowapi.WorkflowStatus `json:"status"`
+	}{w.Labels, w.Status})
+	if err != nil {
+		glog.Errorf("Could not marshal the status of the workflow: %v", w.Workflow)
+		return ""
+	}
+	hash := sha256.Sum256(state)
+	return hex.EncodeToString(hash[:])
+}
+
 func (w *Workflow) ExecutionName() string {
 	return w.Name
 }
@@ -312,6 +327,54 @@ func (w *Workflow) OverrideParameters(desiredParams map[string]string) {
 	w.Spec.Arguments.Parameters = desiredSlice
 }
 
+// The argument of the root DAG driver of v2 pipelines which holds their runtime config.
+const runtimeConfigParameter = "runtime-config"
+
+// FormatRuntimeConfig substitutes the special strings in the string values of the runtime
+// config which v2 pipelines pass to their root DAG driver. Workflows of v1 pipelines have
+// no runtime config.
+func (w *Workflow) FormatRuntimeConfig(formatter *ParameterFormatter) error {
+	for i := range w.Spec.Templates {
+		if w.Spec.Templates[i].DAG == nil {
+			continue
+		}
+		for _, task := range w.Spec.Templates[i].DAG.Tasks {
+			for k, param := range task.Arguments.Parameters {
+				if param.Name != runtimeConfigParameter || param.Value == nil {
+					continue
+				}
+				var runtimeConfig interface{}
+				if err := json.Unmarshal([]byte(param.Value.String()), &runtimeConfig); err != nil {
+					return NewInternalServerError(err, "Failed to unmarshal the runtime config of workflow %v", w.Name)
+				}
+				formatted, err := json.Marshal(formatJSONStrings(runtimeConfig, formatter))
+				if err != nil {
+					return NewInternalServerError(err, "Failed to marshal the runtime config of workflow %v", w.Name)
+				}
+				task.Arguments.Parameters[k].Value = workflowapi.AnyStringPtr(string(formatted))
+			}
+		}
+	}
+	return nil
+}
+
+// formatJSONStrings substitutes the special strings in the string values of a decoded JSON value.
+func formatJSONStrings(value interface{}, formatter *ParameterFormatter) interface{} {
+	switch value := value.(type) {
+	case string:
+		return formatter.Format(value)
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = formatJSONStrings(item, formatter)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = formatJSONStrings(item, formatter)
+		}
+	}
+	return value
+}
+
 func (w *Workflow) GetWorkflowParametersAsMap() map[string]string {
 	resultAsArray := w.Spec.Arguments.Parameters
 	resultAsMap := make(map[string]string)
@@ -757,6 +820,7 @@ func (w *Workflow) NodeStatuses() map[string]NodeStatus {
 		rev[id] = NodeStatus{
 			ID:          node.ID,
 			DisplayName: node.DisplayName,
+			Type:        string(node.Type),
 			State:       string(node.Phase),
 			StartTime:   node.StartedAt.Unix(),
 			CreateTime:  node.StartedAt.Unix(),
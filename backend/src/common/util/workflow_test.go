@@ -748,3 +748,60 @@ spec:
 	assert.Nil(t, err)
 	assert.Equal(t, expectedWorkflow, workflow)
 }
+
+func TestFormatRuntimeConfig(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "WORKFLOW_NAME"},
+		Spec: workflowapi.WorkflowSpec{
+			Templates: []workflowapi.Template{
+				{Name: "executor"},
+				{
+					Name: "entrypoint",
+					DAG: &workflowapi.DAGTemplate{
+						Tasks: []workflowapi.DAGTask{{
+							Name: "root-driver",
+							Arguments: workflowapi.Arguments{Parameters: []workflowapi.Parameter{
+								{Name: "component", Value: workflowapi.AnyStringPtr("{{index}}")},
+								{Name: "runtime-config", Value: workflowapi.AnyStringPtr(
+									`{"parameterValues":{"date":"{{scheduled_time - 24h | date \"2006-01-02\"}}","count":3,"paths":["run-{{index}}"]}}`)},
+							}},
+						}},
+					},
+				},
+			},
+		},
+	})
+	formatter := NewSWFParameterFormatter("some-run-uuid", 2*24*60*60, 3*24*60*60, 7)
+
+	err := workflow.FormatRuntimeConfig(formatter)
+	assert.Nil(t, err)
+	parameters := workflow.Spec.Templates[1].DAG.Tasks[0].Arguments.Parameters
+	assert.Equal(t, "{{index}}", parameters[0].Value.String())
+	assert.JSONEq(t,
+		`{"parameterValues":{"date":"1970-01-02","count":3,"paths":["run-7"]}}`,
+		parameters[1].Value.String())
+}
+
+func TestStatusHash(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "workflow-name",
+			ResourceVersion: "1",
+			Labels:          map[string]string{LabelKeyWorkflowRunId: "run1"},
+		},
+		Status: workflowapi.WorkflowStatus{Phase: workflowapi.WorkflowRunning},
+	})
+	hash := workflow.StatusHash()
+	assert.NotEmpty(t, hash)
+
+	// Changes of the resource version alone do not change the hash.
+	workflow.SetVersion("2")
+	assert.Equal(t, hash, workflow.StatusHash())
+
+	workflow.Status.Phase = workflowapi.WorkflowSucceeded
+	succeededHash := workflow.StatusHash()
+	assert.NotEqual(t, hash, succeededHash)
+
+	workflow.SetLabels(LabelKeyWorkflowPersistedFinalState, "true")
+	assert.NotEqual(t, succeededHash, workflow.StatusHash())
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	wraperror "github.com/pkg/errors"
+	"gocloud.dev/blob"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ObjectStoreClient is a client to list the objects watched by an ObjectStoreTrigger.
+type ObjectStoreClient struct {
+	// The Kubernetes API client, used to read the object store settings and
+	// credentials of the namespace.
+	kubeClientSet kubernetes.Interface
+}
+
+// NewObjectStoreClient creates an instance of the ObjectStoreClient.
+func NewObjectStoreClient(kubeClientSet kubernetes.Interface) *ObjectStoreClient {
+	return &ObjectStoreClient{
+		kubeClientSet: kubeClientSet,
+	}
+}
+
+// ListObjectEvents returns an event for each object stored under the prefix
+// of an ObjectStoreTrigger. The bucket is opened with the settings configured
+// for the launcher in the kfp-launcher ConfigMap of the namespace. The URI is
+// chosen by the owner of the recurring run, so the credentials must be read
+// from a Secret of the namespace, the ambient credentials of the controller
+// are never used.
+func (c *ObjectStoreClient) ListObjectEvents(ctx context.Context, namespace string,
+	trigger *swfapi.ObjectStoreTrigger) ([]util.TriggerEvent, error) {
+
+	bucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(trigger.URI)
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Invalid object store trigger URI (%v)", trigger.URI)
+	}
+	prefix := strings.TrimLeft(strings.TrimPrefix(trigger.URI, bucketConfig.PrefixedBucket()), "/")
+
+	launcherConfig, err := config.FromConfigMap(ctx, c.kubeClientSet, namespace)
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Could not read the launcher config of namespace (%v)", namespace)
+	}
+	providers, err := launcherConfig.BucketProviders()
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Could not read the object store providers of namespace (%v)", namespace)
+	}
+	if bucketConfig.Settings, err = providers.SettingsFor(trigger.URI); err != nil {
+		return nil, wraperror.Wrapf(err, "Could not get the object store settings of (%v)", trigger.URI)
+	}
+	if settings := bucketConfig.Settings; settings == nil || settings.Credentials == nil || settings.Credentials.SecretRef == nil {
+		return nil, wraperror.Errorf(
+			"No credentials Secret is configured for (%v) in the kfp-launcher ConfigMap of namespace (%v)", trigger.URI, namespace)
+	}
+	bucket, err := objectstore.OpenBucket(ctx, c.kubeClientSet, namespace, bucketConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	result := make([]util.TriggerEvent, 0)
+	iter := bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, wraperror.Wrapf(err, "Could not list the objects under (%v)", trigger.URI)
+		}
+		if obj.IsDir {
+			continue
+		}
+		result = append(result, util.TriggerEvent{
+			ID:   obj.Key,
+			Time: obj.ModTime,
+			Details: map[string]string{
+				"uri":      bucketConfig.UriFromKey(obj.Key),
+				"key":      obj.Key,
+				"size":     strconv.FormatInt(obj.Size, 10),
+				"mod_time": obj.ModTime.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeS3Object struct {
+	Key          string
+	LastModified time.Time
+	Size         int64
+}
+
+type fakeS3ListBucketResult struct {
+	XMLName     xml.Name       `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name        string         `xml:"Name"`
+	Prefix      string         `xml:"Prefix"`
+	KeyCount    int            `xml:"KeyCount"`
+	IsTruncated bool           `xml:"IsTruncated"`
+	Contents    []fakeS3Object `xml:"Contents"`
+}
+
+// fakeS3Server lists the objects of a bucket, for the requests signed with accessKey.
+type fakeS3Server struct {
+	bucket    string
+	accessKey string
+	objects   []fakeS3Object
+}
+
+func (s *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.Contains(r.Header.Get("Authorization"), "Credential="+s.accessKey+"/") {
+		http.Error(w, "access denied", http.StatusForbidden)
+		return
+	}
+	if r.Method != http.MethodGet || strings.Trim(r.URL.Path, "/") != s.bucket {
+		http.Error(w, "unsupported request", http.StatusBadRequest)
+		return
+	}
+	prefix := r.URL.Query().Get("prefix")
+	result := fakeS3ListBucketResult{Name: s.bucket, Prefix: prefix}
+	for _, obj := range s.objects {
+		if strings.HasPrefix(obj.Key, prefix) {
+			result.Contents = append(result.Contents, obj)
+		}
+	}
+	sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+	result.KeyCount = len(result.Contents)
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(result)
+}
+
+// newObjectStoreClientset returns a clientset with the kfp-launcher ConfigMap
+// and credentials Secret of a namespace, for the S3 compatible store at endpoint.
+func newObjectStoreClientset(endpoint string, credentials string) *fake.Clientset {
+	providers := `
+s3:
+  default:
+    endpoint: ` + endpoint + `
+    region: us-east-1
+    disableSSL: true
+    forcePathStyle: true
+    credentials:
+` + credentials
+	return fake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kfp-launcher", Namespace: "NAMESPACE"},
+			Data:       map[string]string{"providers": providers},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3-credentials", Namespace: "NAMESPACE"},
+			Data:       map[string][]byte{"accesskey": []byte("ACCESSKEY"), "secretkey": []byte("SECRETKEY")},
+		},
+	)
+}
+
+func TestListObjectEvents(t *testing.T) {
+	modTime := time.Unix(1000, 0).UTC()
+	server := httptest.NewServer(&fakeS3Server{
+		bucket:    "bucket",
+		accessKey: "ACCESSKEY",
+		objects: []fakeS3Object{
+			{Key: "data/a.csv", LastModified: modTime, Size: 3},
+			{Key: "data/nested/b.csv", LastModified: modTime, Size: 3},
+			{Key: "other/c.csv", LastModified: modTime, Size: 3},
+		},
+	})
+	defer server.Close()
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+
+	client := NewObjectStoreClient(newObjectStoreClientset(endpoint, "      secretRef:\n        secretName: s3-credentials\n"))
+	events, err := client.ListObjectEvents(context.Background(), "NAMESPACE",
+		&swfapi.ObjectStoreTrigger{URI: "s3://bucket/data/"})
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+
+	var keys []string
+	for _, event := range events {
+		keys = append(keys, event.ID)
+		assert.Equal(t, modTime, event.Time.UTC())
+		assert.Equal(t, "3", event.Details["size"])
+	}
+	assert.ElementsMatch(t, []string{"data/a.csv", "data/nested/b.csv"}, keys)
+	assert.Equal(t, "data/a.csv", events[0].Details["key"])
+	assert.Equal(t, "s3://bucket/data/a.csv", events[0].Details["uri"])
+
+	_, err = client.ListObjectEvents(context.Background(), "NAMESPACE",
+		&swfapi.ObjectStoreTrigger{URI: "not a uri"})
+	assert.NotNil(t, err)
+}
+
+func TestListObjectEvents_AmbientCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientset *fake.Clientset
+		uri       string
+	}{
+		{name: "no launcher config", clientset: fake.NewSimpleClientset(), uri: "s3://bucket/data/"},
+		{name: "credentials from the environment", clientset: newObjectStoreClientset("localhost:1", "      fromEnv: true\n"), uri: "s3://bucket/data/"},
+		{name: "web identity", clientset: newObjectStoreClientset("localhost:1", "      webIdentity:\n        roleARN: arn:aws:iam::123456789012:role/r\n"), uri: "s3://bucket/data/"},
+		{name: "no provider for the scheme", clientset: newObjectStoreClientset("localhost:1", "      fromEnv: true\n"), uri: "gs://bucket/data/"},
+		{name: "local file system", clientset: fake.NewSimpleClientset(), uri: "file:///etc/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewObjectStoreClient(tt.clientset).ListObjectEvents(context.Background(), "NAMESPACE",
+				&swfapi.ObjectStoreTrigger{URI: tt.uri})
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "No credentials Secret is configured")
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	wraperror "github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// SaTokenFile is the projected service account token used to authenticate to the API server.
+const SaTokenFile = "/var/run/secrets/kubeflow/tokens/scheduledworkflow-sa-token"
+
+type PipelineClientInterface interface {
+	MaterializeWorkflow(ctx context.Context, recurringRunId string) (*swfapi.WorkflowResource, error)
+}
+
+// PipelineClient is a client to call the API server.
+type PipelineClient struct {
+	timeout             time.Duration
+	tokenFile           string
+	reportServiceClient api.ReportServiceClient
+}
+
+// NewPipelineClient creates an instance of the PipelineClient. The token file is
+// optional and is read before each call.
+func NewPipelineClient(timeout time.Duration, tokenFile string, mlPipelineServiceName string,
+	mlPipelineServiceGRPCPort string) (*PipelineClient, error) {
+	connection, err := commonutil.GetRpcConnection(fmt.Sprintf("%s:%s", mlPipelineServiceName, mlPipelineServiceGRPCPort))
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Failed to get RPC connection")
+	}
+	return newPipelineClient(timeout, tokenFile, api.NewReportServiceClient(connection)), nil
+}
+
+func newPipelineClient(timeout time.Duration, tokenFile string,
+	reportServiceClient api.ReportServiceClient) *PipelineClient {
+	return &PipelineClient{
+		timeout:             timeout,
+		tokenFile:           tokenFile,
+		reportServiceClient: reportServiceClient,
+	}
+}
+
+// MaterializeWorkflow asks the API server for the workflow of the next run of a
+// recurring run that uses the latest version of its pipeline.
+func (p *PipelineClient) MaterializeWorkflow(ctx context.Context, recurringRunId string) (
+	*swfapi.WorkflowResource, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	token, err := p.readToken()
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+token)
+	}
+	response, err := p.reportServiceClient.MaterializeScheduledWorkflow(ctx,
+		&api.MaterializeScheduledWorkflowRequest{RecurringRunId: recurringRunId})
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Failed to materialize the workflow of recurring run (%v)", recurringRunId)
+	}
+	var workflow swfapi.WorkflowResource
+	if err := json.Unmarshal([]byte(response.GetWorkflow()), &workflow); err != nil {
+		return nil, wraperror.Wrapf(err, "Failed to unmarshal the workflow of recurring run (%v)", recurringRunId)
+	}
+	return &workflow, nil
+}
+
+func (p *PipelineClient) readToken() (string, error) {
+	if p.tokenFile == "" {
+		return "", nil
+	}
+	b, err := ioutil.ReadFile(p.tokenFile)
+	if os.IsNotExist(err) {
+		// The token is only mounted when the API server authenticates its callers.
+		return "", nil
+	}
+	if err != nil {
+		return "", wraperror.Wrapf(err, "Failed to read the service account token (%v)", p.tokenFile)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeReportServiceClient struct {
+	api.ReportServiceClient
+	request       *api.MaterializeScheduledWorkflowRequest
+	authorization []string
+	response      *api.MaterializeScheduledWorkflowResponse
+	err           error
+}
+
+func (f *fakeReportServiceClient) MaterializeScheduledWorkflow(ctx context.Context,
+	in *api.MaterializeScheduledWorkflowRequest, opts ...grpc.CallOption) (
+	*api.MaterializeScheduledWorkflowResponse, error) {
+	f.request = in
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.authorization = md.Get("Authorization")
+	return f.response, f.err
+}
+
+func TestMaterializeWorkflow(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.Nil(t, ioutil.WriteFile(tokenFile, []byte("TOKEN\n"), 0644))
+	reportClient := &fakeReportServiceClient{
+		response: &api.MaterializeScheduledWorkflowResponse{
+			PipelineVersionId: "VERSION1",
+			Workflow:          `{"parameters":[{"name":"x","value":"1"}],"spec":"{\"kind\":\"Workflow\"}"}`,
+		},
+	}
+	client := newPipelineClient(time.Minute, tokenFile, reportClient)
+
+	workflow, err := client.MaterializeWorkflow(context.Background(), "RECURRING_RUN1")
+	require.Nil(t, err)
+	assert.Equal(t, "RECURRING_RUN1", reportClient.request.GetRecurringRunId())
+	assert.Equal(t, []string{"Bearer TOKEN"}, reportClient.authorization)
+	assert.Equal(t, `{"kind":"Workflow"}`, workflow.Spec)
+	require.Len(t, workflow.Parameters, 1)
+	assert.Equal(t, "x", workflow.Parameters[0].Name)
+	assert.Equal(t, "1", workflow.Parameters[0].Value)
+}
+
+func TestMaterializeWorkflow_NoToken(t *testing.T) {
+	reportClient := &fakeReportServiceClient{
+		response: &api.MaterializeScheduledWorkflowResponse{Workflow: `{"spec":"{}"}`},
+	}
+	client := newPipelineClient(time.Minute, filepath.Join(t.TempDir(), "missing"), reportClient)
+
+	_, err := client.MaterializeWorkflow(context.Background(), "RECURRING_RUN1")
+	require.Nil(t, err)
+	assert.Empty(t, reportClient.authorization)
+}
+
+func TestMaterializeWorkflow_Error(t *testing.T) {
+	client := newPipelineClient(time.Minute, "", &fakeReportServiceClient{err: errors.New("unavailable")})
+	_, err := client.MaterializeWorkflow(context.Background(), "RECURRING_RUN1")
+	assert.NotNil(t, err)
+
+	client = newPipelineClient(time.Minute, "", &fakeReportServiceClient{
+		response: &api.MaterializeScheduledWorkflowResponse{Workflow: "not json"},
+	})
+	_, err = client.MaterializeWorkflow(context.Background(), "RECURRING_RUN1")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	wraperror "github.com/pkg/errors"
+)
+
+// FailureNotification is the body POSTed to the webhook of a ScheduledWorkflow
+// disabled by its failure policy.
+type FailureNotification struct {
+	Namespace           string    `json:"namespace"`
+	ScheduledWorkflow   string    `json:"scheduledWorkflow"`
+	RecurringRunID      string    `json:"recurringRunId"`
+	ConsecutiveFailures int64     `json:"consecutiveFailures"`
+	LastFailedWorkflow  string    `json:"lastFailedWorkflow,omitempty"`
+	Message             string    `json:"message"`
+	DisabledAt          time.Time `json:"disabledAt"`
+}
+
+// WebhookClient is a client to notify webhooks. The webhook URLs are chosen by
+// the owners of the ScheduledWorkflows, so the addresses the client connects to
+// are restricted by a TargetPolicy.
+type WebhookClient struct {
+	httpClient *http.Client
+	policy     *commonutil.TargetPolicy
+}
+
+// NewWebhookClient creates an instance of the WebhookClient.
+func NewWebhookClient(timeout time.Duration, policy *commonutil.TargetPolicy) *WebhookClient {
+	return &WebhookClient{
+		httpClient: &http.Client{Transport: policy.NewTransport(timeout), Timeout: timeout},
+		policy:     policy,
+	}
+}
+
+// permanentError is an error of a notification which fails again if it's retried.
+type permanentError struct {
+	error
+}
+
+func (e *permanentError) Cause() error {
+	return e.error
+}
+
+// IsPermanentError returns whether posting a notification failed for a reason
+// which retrying doesn't fix, e.g. a blocked address or a rejected notification.
+func IsPermanentError(err error) bool {
+	_, ok := err.(*permanentError)
+	return ok
+}
+
+// PostFailureNotification POSTs a notification as JSON to the webhook URL.
+func (c *WebhookClient) PostFailureNotification(ctx context.Context, url string,
+	notification *FailureNotification) error {
+	if err := c.policy.ValidateTargetURL(url); err != nil {
+		return &permanentError{wraperror.Wrapf(err, "Invalid webhook URL (%v)", url)}
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return &permanentError{wraperror.Wrapf(err, "Failed to marshal the notification of (%v)", notification.ScheduledWorkflow)}
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{wraperror.Wrapf(err, "Invalid webhook URL (%v)", url)}
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		err = wraperror.Wrapf(err, "Failed to POST the notification to (%v)", url)
+		if commonutil.IsBlockedTargetError(err) {
+			return &permanentError{err}
+		}
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("Failed to POST the notification to (%v): %v", url, response.Status)
+	// Client errors are permanent, except timeouts and throttling.
+	if response.StatusCode >= 400 && response.StatusCode < 500 &&
+		response.StatusCode != http.StatusRequestTimeout && response.StatusCode != http.StatusTooManyRequests {
+		return &permanentError{err}
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLoopbackWebhookClient creates a WebhookClient allowed to POST to the test servers.
+func newLoopbackWebhookClient(t *testing.T) *WebhookClient {
+	policy, err := commonutil.NewTargetPolicy([]string{"127.0.0.0/8", "::1/128"})
+	require.Nil(t, err)
+	return NewWebhookClient(time.Second, policy)
+}
+
+func TestPostFailureNotification(t *testing.T) {
+	var received FailureNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	notification := &FailureNotification{
+		Namespace:           "NAMESPACE",
+		ScheduledWorkflow:   "SCHEDULE1",
+		RecurringRunID:      "123e4567-e89b-12d3-a456-426655440000",
+		ConsecutiveFailures: 3,
+		LastFailedWorkflow:  "WORKFLOW3",
+		Message:             "MESSAGE",
+		DisabledAt:          time.Unix(3600, 0).UTC(),
+	}
+	err := newLoopbackWebhookClient(t).PostFailureNotification(context.Background(), server.URL, notification)
+	require.Nil(t, err)
+	assert.Equal(t, *notification, received)
+}
+
+func TestPostFailureNotification_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newLoopbackWebhookClient(t)
+	err := client.PostFailureNotification(context.Background(), server.URL, &FailureNotification{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "500")
+	assert.False(t, IsPermanentError(err))
+
+	err = client.PostFailureNotification(context.Background(), "://not a url", &FailureNotification{})
+	assert.NotNil(t, err)
+	assert.True(t, IsPermanentError(err))
+}
+
+func TestPostFailureNotification_ClientError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	err := newLoopbackWebhookClient(t).PostFailureNotification(context.Background(), server.URL, &FailureNotification{})
+	assert.NotNil(t, err)
+	assert.True(t, IsPermanentError(err))
+}
+
+func TestPostFailureNotification_BlockedTarget(t *testing.T) {
+	received := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer server.Close()
+
+	policy, err := commonutil.NewTargetPolicy(nil)
+	require.Nil(t, err)
+	client := NewWebhookClient(time.Second, policy)
+	err = client.PostFailureNotification(context.Background(), server.URL, &FailureNotification{})
+	assert.NotNil(t, err)
+	assert.True(t, IsPermanentError(err))
+	assert.False(t, received)
+
+	err = client.PostFailureNotification(context.Background(), "http://169.254.169.254/latest/meta-data", &FailureNotification{})
+	assert.NotNil(t, err)
+	assert.True(t, IsPermanentError(err))
+}
@@ -16,14 +16,18 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"time"
 
+	"github.com/kubeflow/pipelines/backend/src/common"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	wraperror "github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/cache"
 )
@@ -130,6 +134,80 @@ func retrieveIndex(workflow commonutil.ExecutionSpec) int64 {
 	return result
 }
 
+// ListRunCompletionEvents returns the completion events of the runs matching a
+// RunCompletionTrigger in a namespace. The workflows created by the ScheduledWorkflow
+// itself are ignored, so that a schedule never triggers itself.
+func (p *WorkflowClient) ListRunCompletionEvents(namespace string, swfName string,
+	trigger *swfapi.RunCompletionTrigger, states []string) ([]util.TriggerEvent, error) {
+
+	labelSelector, err := getLabelSelectorToGetRunCompletions(trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := p.informer.List(labelSelector)
+	if err != nil {
+		return nil, wraperror.Wrapf(err,
+			"Could not retrieve the completed runs of experiment (%v): %v", trigger.ExperimentID, err)
+	}
+
+	result := make([]util.TriggerEvent, 0)
+	for _, workflow := range workflows {
+		objMeta := workflow.ExecutionObjectMeta()
+		if workflow.ExecutionNamespace() != namespace ||
+			objMeta.Labels[commonutil.LabelKeyWorkflowScheduledWorkflowName] == swfName {
+			continue
+		}
+		state := toRunState(workflow.ExecutionStatus().Condition())
+		if !containsState(states, state) {
+			continue
+		}
+		result = append(result, *toRunCompletionEvent(workflow, state))
+	}
+	return result, nil
+}
+
+func toRunState(phase common.ExecutionPhase) string {
+	switch phase {
+	case common.ExecutionSucceeded:
+		return util.RunStateSucceeded
+	case common.ExecutionFailed, common.ExecutionError:
+		return util.RunStateFailed
+	default:
+		return ""
+	}
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if state != "" && strings.EqualFold(s, state) {
+			return true
+		}
+	}
+	return false
+}
+
+func toRunCompletionEvent(workflow commonutil.ExecutionSpec, state string) *util.TriggerEvent {
+	objMeta := workflow.ExecutionObjectMeta()
+	runId := objMeta.Labels[commonutil.LabelKeyWorkflowRunId]
+	if runId == "" {
+		runId = workflow.ExecutionName()
+	}
+	finishedAt := workflow.ExecutionStatus().FinishedAtTime()
+	return &util.TriggerEvent{
+		ID:   runId,
+		Time: finishedAt.Time,
+		Details: map[string]string{
+			"run_id":        runId,
+			"run_name":      objMeta.Annotations[commonutil.AnnotationKeyRunName],
+			"experiment_id": objMeta.Labels[commonutil.LabelKeyWorkflowExperimentId],
+			"pipeline_id":   objMeta.Labels[commonutil.LabelKeyWorkflowPipelineId],
+			"state":         state,
+			"finished_at":   finishedAt.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
 // Create creates a workflow given a namespace and its specification.
 func (p *WorkflowClient) Create(ctx context.Context, namespace string, workflow commonutil.ExecutionSpec) (
 	commonutil.ExecutionSpec, error) {
@@ -141,6 +219,23 @@ func (p *WorkflowClient) Create(ctx context.Context, namespace string, workflow
 	return result, nil
 }
 
+// Terminate stops a running workflow, given a namespace and name.
+func (p *WorkflowClient) Terminate(ctx context.Context, namespace string, name string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"activeDeadlineSeconds": 0,
+		},
+	})
+	if err != nil {
+		return wraperror.Wrapf(err, "Failed to create the patch to terminate workflow (%v)", name)
+	}
+	_, err = p.clientSet.Execution(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return wraperror.Wrapf(err, "Failed to terminate workflow (%v) in namespace (%v)", name, namespace)
+	}
+	return nil
+}
+
 func getLabelSelectorToGetWorkflows(swfName string, completed bool, minIndex int64) *labels.Selector {
 	labelSelector := labels.NewSelector()
 	// The Argo workflow should be active or completed
@@ -151,3 +246,26 @@ func getLabelSelectorToGetWorkflows(swfName string, completed bool, minIndex int
 	labelSelector = labelSelector.Add(*util.GetRequirementForMinIndexOrFatal(minIndex))
 	return &labelSelector
 }
+
+func getLabelSelectorToGetRunCompletions(trigger *swfapi.RunCompletionTrigger) (*labels.Selector, error) {
+	labelSelector := labels.NewSelector()
+	// The Argo workflow should be completed
+	labelSelector = labelSelector.Add(*util.GetRequirementForCompletedWorkflowOrFatal(true))
+	// The Argo workflow should be labelled with the experiment of the trigger.
+	req, err := util.GetRequirementForExperiment(trigger.ExperimentID)
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Invalid experiment ID (%v) in run completion trigger: %v",
+			trigger.ExperimentID, err)
+	}
+	labelSelector = labelSelector.Add(*req)
+	if trigger.PipelineID != "" {
+		// The Argo workflow should be labelled with the pipeline of the trigger.
+		req, err = util.GetRequirementForPipeline(trigger.PipelineID)
+		if err != nil {
+			return nil, wraperror.Wrapf(err, "Invalid pipeline ID (%v) in run completion trigger: %v",
+				trigger.PipelineID, err)
+		}
+		labelSelector = labelSelector.Add(*req)
+	}
+	return &labelSelector, nil
+}
@@ -15,13 +15,16 @@
 package client
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	workflowcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	apiclient "github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/common"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -218,3 +221,102 @@ func TestLabelSelectorToGetWorkflows(t *testing.T) {
 
 	assert.Equal(t, expected, *result)
 }
+
+func TestLabelSelectorToGetRunCompletions(t *testing.T) {
+	result, err := getLabelSelectorToGetRunCompletions(&swfapi.RunCompletionTrigger{
+		ExperimentID: "EXPERIMENT1",
+		PipelineID:   "PIPELINE1",
+	})
+	assert.Nil(t, err)
+
+	expected := labels.NewSelector()
+
+	req, err := labels.NewRequirement(workflowcommon.LabelKeyCompleted, selection.Equals,
+		[]string{"true"})
+	assert.Nil(t, err)
+	expected = expected.Add(*req)
+
+	req, err = labels.NewRequirement(commonutil.LabelKeyWorkflowExperimentId, selection.Equals,
+		[]string{"EXPERIMENT1"})
+	assert.Nil(t, err)
+	expected = expected.Add(*req)
+
+	req, err = labels.NewRequirement(commonutil.LabelKeyWorkflowPipelineId, selection.Equals,
+		[]string{"PIPELINE1"})
+	assert.Nil(t, err)
+	expected = expected.Add(*req)
+
+	assert.Equal(t, expected, *result)
+}
+
+func TestLabelSelectorToGetRunCompletions_InvalidLabelValue(t *testing.T) {
+	_, err := getLabelSelectorToGetRunCompletions(&swfapi.RunCompletionTrigger{
+		ExperimentID: "not a label value",
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid experiment ID")
+
+	_, err = getLabelSelectorToGetRunCompletions(&swfapi.RunCompletionTrigger{
+		ExperimentID: "EXPERIMENT1",
+		PipelineID:   "PIPELINE/1",
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid pipeline ID")
+}
+
+func TestToRunCompletionEvent(t *testing.T) {
+	workflow := commonutil.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "WORKFLOW_NAME",
+			Namespace: "NAMESPACE",
+			Labels: map[string]string{
+				commonutil.LabelKeyWorkflowRunId:        "RUN1",
+				commonutil.LabelKeyWorkflowExperimentId: "EXPERIMENT1",
+				commonutil.LabelKeyWorkflowPipelineId:   "PIPELINE1",
+			},
+			Annotations: map[string]string{
+				commonutil.AnnotationKeyRunName: "RUN_NAME",
+			},
+		},
+		Status: workflowapi.WorkflowStatus{
+			Phase:      workflowapi.WorkflowError,
+			FinishedAt: metav1.NewTime(time.Unix(52, 0).UTC()),
+		},
+	})
+
+	state := toRunState(workflow.ExecutionStatus().Condition())
+	assert.Equal(t, "FAILED", state)
+	assert.True(t, containsState([]string{"SUCCEEDED", "failed"}, state))
+	assert.False(t, containsState([]string{"SUCCEEDED"}, state))
+	assert.Equal(t, "", toRunState(common.ExecutionRunning))
+
+	expected := &util.TriggerEvent{
+		ID:   "RUN1",
+		Time: time.Unix(52, 0).UTC(),
+		Details: map[string]string{
+			"run_id":        "RUN1",
+			"run_name":      "RUN_NAME",
+			"experiment_id": "EXPERIMENT1",
+			"pipeline_id":   "PIPELINE1",
+			"state":         "FAILED",
+			"finished_at":   "1970-01-01T00:00:52Z",
+		},
+	}
+	assert.Equal(t, expected, toRunCompletionEvent(workflow, state))
+}
+func TestTerminate(t *testing.T) {
+	clientSet := apiclient.NewFakeExecClient()
+	workflow := commonutil.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "WORKFLOW1", Namespace: "NAMESPACE"},
+	})
+	_, err := clientSet.Execution("NAMESPACE").Create(context.Background(), workflow, metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	client := NewWorkflowClient(clientSet, nil)
+	assert.Nil(t, client.Terminate(context.Background(), "NAMESPACE", "WORKFLOW1"))
+	terminated, err := clientSet.IsTerminated("WORKFLOW1")
+	assert.Nil(t, err)
+	assert.True(t, terminated)
+
+	assert.NotNil(t, client.Terminate(context.Background(), "NAMESPACE", "WORKFLOW2"))
+}
@@ -31,6 +31,7 @@ import (
 	wraperror "github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -48,18 +49,44 @@ const (
 	ScheduledWorkflow = "ScheduledWorkflow"
 )
 
+const (
+	webhookTimeout = 10 * time.Second
+	// webhookMaxAttempts is the number of attempts to POST a failure notification
+	// before giving up.
+	webhookMaxAttempts = 5
+)
+
 var (
 	// DefaultJobBackOff is the max backoff period
 	DefaultJobBackOff = 10 * time.Second
 	// MaxJobBackOff is the max backoff period
 	MaxJobBackOff = 360 * time.Second
+	// DefaultWebhookBackOff is the backoff period after the first failed failure notification
+	DefaultWebhookBackOff = 5 * time.Second
+	// MaxWebhookBackOff is the max backoff period of the failure notifications
+	MaxWebhookBackOff = 2 * time.Minute
 )
 
+// failureNotification is a failure notification queued to be POSTed to the
+// webhook of a ScheduledWorkflow.
+type failureNotification struct {
+	swf          *swfapi.ScheduledWorkflow
+	url          string
+	notification *client.FailureNotification
+}
+
 // Controller is the controller implementation for ScheduledWorkflow resources
 type Controller struct {
-	kubeClient     *client.KubeClient
-	swfClient      *client.ScheduledWorkflowClient
-	workflowClient *client.WorkflowClient
+	kubeClient        *client.KubeClient
+	swfClient         *client.ScheduledWorkflowClient
+	workflowClient    *client.WorkflowClient
+	objectStoreClient *client.ObjectStoreClient
+	webhookClient     *client.WebhookClient
+	pipelineClient    client.PipelineClientInterface
+
+	// The namespaces handled by this controller, when several controllers
+	// share the ScheduledWorkflows of the cluster.
+	shard *util.NamespaceShard
 
 	// workqueue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
@@ -68,6 +95,10 @@ type Controller struct {
 	// simultaneously in two different workers.
 	workqueue workqueue.RateLimitingInterface
 
+	// notificationQueue is a rate limited queue of the failure notifications, so
+	// that slow or failing webhooks neither block nor fail the syncs.
+	notificationQueue workqueue.RateLimitingInterface
+
 	// An interface to generate the current time.
 	time commonutil.TimeInterface
 
@@ -82,6 +113,9 @@ func NewController(
 	workflowClientSet commonutil.ExecutionClient,
 	swfInformerFactory swfinformers.SharedInformerFactory,
 	executionInformer commonutil.ExecutionInformer,
+	pipelineClient client.PipelineClientInterface,
+	shard *util.NamespaceShard,
+	webhookPolicy *commonutil.TargetPolicy,
 	time commonutil.TimeInterface,
 	location *time.Location) *Controller {
 
@@ -100,11 +134,18 @@ func NewController(
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: util.ControllerAgentName})
 
 	controller := &Controller{
-		kubeClient:     client.NewKubeClient(kubeClientSet, recorder),
-		swfClient:      client.NewScheduledWorkflowClient(swfClientSet, swfInformer),
-		workflowClient: client.NewWorkflowClient(workflowClientSet, executionInformer),
+		kubeClient:        client.NewKubeClient(kubeClientSet, recorder),
+		swfClient:         client.NewScheduledWorkflowClient(swfClientSet, swfInformer),
+		workflowClient:    client.NewWorkflowClient(workflowClientSet, executionInformer),
+		objectStoreClient: client.NewObjectStoreClient(kubeClientSet),
+		webhookClient:     client.NewWebhookClient(webhookTimeout, webhookPolicy),
+		pipelineClient:    pipelineClient,
+		shard:             shard,
 		workqueue: workqueue.NewNamedRateLimitingQueue(
 			workqueue.NewItemExponentialFailureRateLimiter(DefaultJobBackOff, MaxJobBackOff), swfregister.Kind),
+		notificationQueue: workqueue.NewNamedRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(DefaultWebhookBackOff, MaxWebhookBackOff),
+			"FailureNotifications"),
 		time:     time,
 		location: location,
 	}
@@ -151,9 +192,10 @@ func NewController(
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
 	defer runtime.HandleCrash()
 	defer c.workqueue.ShutDown()
+	defer c.notificationQueue.ShutDown()
 
 	// Start the informer factories to begin populating the informer caches
-	log.Info("Starting ScheduledWorkflow controller")
+	log.Infof("Starting ScheduledWorkflow controller for %v", c.shard)
 
 	// Wait for the caches to be synced before starting workers
 	log.Info("Waiting for informer caches to sync")
@@ -169,6 +211,7 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
 	}
+	go wait.Until(c.runNotificationWorker, time.Second, stopCh)
 	log.Info("Started workers")
 
 	log.Info("Wait for shut down")
@@ -196,16 +239,30 @@ func (c *Controller) enqueueScheduledWorkflow(obj interface{}) {
 		runtime.HandleError(fmt.Errorf("Equeuing object: error: %v: %+v", err, obj))
 		return
 	}
+	if !c.ownsKey(key) {
+		return
+	}
 	c.workqueue.AddRateLimited(key)
 }
 
 func (c *Controller) enqueueScheduledWorkflowForDelete(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
-	if err == nil {
+	if err == nil && c.ownsKey(key) {
 		c.workqueue.Add(key)
 	}
 }
 
+// ownsKey returns whether the ScheduledWorkflow with the namespace/name key is handled
+// by the shard of this controller.
+func (c *Controller) ownsKey(key string) bool {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		// Let the syncHandler report the invalid key.
+		return true
+	}
+	return c.shard.Owns(namespace)
+}
+
 // handleWorkflow will take any resource implementing metav1.Object and attempt
 // to find the ScheduledWorkflow that 'owns' it. It does this by looking at the
 // objects metadata.ownerReferences field for an appropriate OwnerReference.
@@ -379,6 +436,11 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 			wraperror.Wrapf(err, "Invalid resource key (%s): %v", key, err)
 	}
 
+	if !c.shard.Owns(namespace) {
+		// Another controller handles this namespace.
+		return false, false, nil, nil
+	}
+
 	// Get the ScheduledWorkflow with this namespace/name
 	swf, err = c.swfClient.Get(namespace, name)
 	if err != nil {
@@ -388,6 +450,13 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 			wraperror.Wrapf(err, "ScheduledWorkflow (%s) in work queue no longer exists: %v", key, err)
 	}
 
+	if err := swf.ValidateTrigger(); err != nil {
+		// Permanent failure, recorded as an event on this ScheduledWorkflow only.
+		// It is synced again when its spec changes.
+		return false, false, swf,
+			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): permanent failure, invalid trigger: %v", name, err)
+	}
+
 	// Get the current time
 	// NOTE: call time.Now() only once per event so that all the functions have a consistent
 	// number for the current time.
@@ -411,21 +480,30 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	submitted, nextScheduledEpoch, err := c.submitNextWorkflowIfNeeded(ctx, swf, len(active), nowEpoch)
+	// Apply the failure policy before submitting the next workflow, so that a
+	// schedule disabled by too many failed workflows does not submit another one.
+	swf = util.NewScheduledWorkflow(swf.Get().DeepCopy())
+	disabled := swf.RecordCompletedWorkflows(active, completed)
+
+	result, err := c.submitNextWorkflowIfNeeded(ctx, swf, active, nowEpoch)
 	if err != nil {
 		return false, true, swf,
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	err = c.updateStatus(ctx, swf, submitted, active, completed, nextScheduledEpoch, nowEpoch)
+	err = c.updateStatus(ctx, swf, result, active, completed, nowEpoch)
 	if err != nil {
 		return false, true, swf,
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't update swf status: %v", name, err)
 	}
 
-	if submitted {
-		// Success. Since we created a new workflow, sync again soon since there might be one more
-		// resource to create.
+	if disabled {
+		c.notifyDisabledByFailurePolicy(swf, completed, nowEpoch)
+	}
+
+	if result.submitted || result.skipped {
+		// Success. Since we created or skipped a new workflow, sync again soon since there might
+		// be one more resource to create.
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: name,
 		}).Infof("Syncing ScheduledWorkflow (%v): success, requeuing for further processing.", name)
@@ -439,26 +517,73 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 	return false, false, swf, nil
 }
 
-// Submits the next workflow if a workflow is due to execute. Returns the submitted workflow,
-// an error (if any), and a boolean indicating (in case of an error) whether handling the
-// ScheduledWorkflow should be attempted again at a later time.
+// submitResult describes what submitNextWorkflowIfNeeded did with a ScheduledWorkflow.
+type submitResult struct {
+	// Whether a new workflow was submitted.
+	submitted bool
+	// Whether the workflow due was skipped by the Forbid concurrency policy.
+	skipped bool
+	// The scheduled time of the next workflow.
+	nextScheduledEpoch int64
+	// For event-driven ScheduledWorkflows, the event which triggered or
+	// skipped the workflow.
+	event *util.TriggerEvent
+	// For event-driven ScheduledWorkflows, whether the source of the events was
+	// polled without finding any new event.
+	polled bool
+}
+
+// Submits the next workflow if a workflow is due to execute, applying the concurrency
+// policy if MaxConcurrency workflows are active. Returns what was done, and an error (if
+// any) indicating that handling the ScheduledWorkflow should be attempted again at a
+// later time.
 func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
-	activeWorkflowCount int, nowEpoch int64) (
-	submitted bool, nextScheduledEpoch int64, err error) {
+	active []swfapi.WorkflowStatus, nowEpoch int64) (*submitResult, error) {
 	// Compute the next scheduled time.
 	nextScheduledEpoch, shouldRunNow := swf.GetNextScheduledEpoch(
-		int64(activeWorkflowCount), nowEpoch, *c.location)
+		int64(len(active)), nowEpoch, *c.location)
+	result := &submitResult{nextScheduledEpoch: nextScheduledEpoch}
 
 	if !shouldRunNow {
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
 		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (next scheduled at: %v)",
 			swf.Name, commonutil.FormatTimeForLogging(nextScheduledEpoch))
-		return false, nextScheduledEpoch, nil
+		return result, nil
 	}
 
-	var workflowName string
-	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, nextScheduledEpoch, nowEpoch)
+	if swf.IsEventDriven() {
+		events, err := c.listTriggerEvents(ctx, swf)
+		if err != nil {
+			return nil, err
+		}
+		result.event = swf.NextTriggerEvent(events)
+		if result.event == nil {
+			log.WithFields(log.Fields{
+				ScheduledWorkflow: swf.Name,
+			}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (no new event)", swf.Name)
+			result.polled = true
+			return result, nil
+		}
+	}
+
+	if swf.IsAtMaxConcurrency(int64(len(active))) {
+		switch swf.ConcurrencyPolicy() {
+		case swfapi.ForbidConcurrent:
+			log.WithFields(log.Fields{
+				ScheduledWorkflow: swf.Name,
+			}).Infof("Submitting workflow for ScheduledWorkflow (%v): skipped by concurrency policy (scheduled at: %v)",
+				swf.Name, commonutil.FormatTimeForLogging(nextScheduledEpoch))
+			result.skipped = true
+			return result, nil
+		case swfapi.ReplaceConcurrent:
+			if err := c.terminateWorkflows(ctx, swf, swf.WorkflowsToReplace(active)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	submitted, workflowName, err := c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, nextScheduledEpoch, nowEpoch, result.event)
 	if err != nil {
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
@@ -466,19 +591,46 @@ func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.S
 			swf.Name, err)
 		// There was an error submitting a new workflow.
 		// We should attempt to handle the schedule again at a later time.
-		return false, nextScheduledEpoch, err
+		return nil, err
 	}
 	log.WithFields(log.Fields{
 		ScheduledWorkflow: swf.Name,
 		Workflow:          workflowName,
 	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (scheduled at: %v)",
 		swf.Name, workflowName, commonutil.FormatTimeForLogging(nextScheduledEpoch))
-	return submitted, nextScheduledEpoch, nil
+	result.submitted = submitted
+	return result, nil
+}
+
+// terminateWorkflows terminates the workflows replaced by the Replace concurrency policy.
+func (c *Controller) terminateWorkflows(ctx context.Context, swf *util.ScheduledWorkflow,
+	workflows []swfapi.WorkflowStatus) error {
+	for _, workflow := range workflows {
+		if err := c.workflowClient.Terminate(ctx, swf.Namespace, workflow.Name); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+			Workflow:          workflow.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) terminated by concurrency policy",
+			swf.Name, workflow.Name)
+	}
+	return nil
+}
+
+// listTriggerEvents returns the events of the source of an event-driven ScheduledWorkflow.
+func (c *Controller) listTriggerEvents(ctx context.Context, swf *util.ScheduledWorkflow) (
+	[]util.TriggerEvent, error) {
+	if swf.Spec.Trigger.ObjectStoreTrigger != nil {
+		return c.objectStoreClient.ListObjectEvents(ctx, swf.Namespace, swf.Spec.Trigger.ObjectStoreTrigger)
+	}
+	return c.workflowClient.ListRunCompletionEvents(swf.Namespace, swf.Name,
+		swf.Spec.Trigger.RunCompletionTrigger, swf.RunCompletionStates())
 }
 
 func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	ctx context.Context,
-	swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64) (
+	swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64, event *util.TriggerEvent) (
 	bool, string, error) {
 
 	workflowName := swf.NextResourceName()
@@ -501,8 +653,29 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	}
 
 	// If the workflow is not found, we need to create it.
-	newWorkflow, err := swf.NewWorkflow(nextScheduledEpoch, nowEpoch)
+	if swf.Spec.UseLatestPipelineVersion {
+		// The workflow is built by the API server from the latest pipeline version.
+		workflow, err := c.pipelineClient.MaterializeWorkflow(ctx, string(swf.UID))
+		if err != nil {
+			return false, "", err
+		}
+		swf = swf.WithWorkflow(workflow)
+	}
+	var newWorkflow commonutil.ExecutionSpec
+	if event != nil {
+		newWorkflow, err = swf.NewEventWorkflow(event, nowEpoch)
+	} else {
+		newWorkflow, err = swf.NewWorkflow(nextScheduledEpoch, nowEpoch, c.location)
+	}
+	if err != nil {
+		return false, "", err
+	}
 	createdWorkflow, err := c.workflowClient.Create(ctx, swf.Namespace, newWorkflow)
+	if apierrors.IsAlreadyExists(wraperror.Cause(err)) {
+		// The workflow was created by another controller since we fetched it, e.g. by
+		// the previous leader. Like above, we only need to update the status.
+		return true, workflowName, nil
+	}
 	if err != nil {
 		return false, "", err
 	}
@@ -512,16 +685,29 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 func (c *Controller) updateStatus(
 	ctx context.Context,
 	swf *util.ScheduledWorkflow,
-	submitted bool,
+	result *submitResult,
 	active []swfapi.WorkflowStatus,
 	completed []swfapi.WorkflowStatus,
-	nextScheduledEpoch int64,
 	nowEpoch int64) error {
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
 	swfCopy := util.NewScheduledWorkflow(swf.Get().DeepCopy())
-	swfCopy.UpdateStatus(nowEpoch, submitted, nextScheduledEpoch, active, completed, c.location)
+	if (result.submitted || result.skipped) && result.event != nil {
+		swfCopy.RecordTriggerEvent(result.event)
+	}
+	if result.polled {
+		swfCopy.RecordPoll(nowEpoch)
+	}
+	nextScheduledEpoch := result.nextScheduledEpoch
+	if result.skipped && result.event != nil {
+		swfCopy.RecordSkippedWorkflow(result.event.Time.Unix())
+	} else if result.skipped {
+		swfCopy.RecordSkippedWorkflow(nextScheduledEpoch)
+		// The next workflow is scheduled after the skipped one.
+		nextScheduledEpoch, _ = swfCopy.GetNextScheduledEpoch(int64(len(active)), nowEpoch, *c.location)
+	}
+	swfCopy.UpdateStatus(nowEpoch, result.submitted, nextScheduledEpoch, active, completed, c.location)
 
 	// Until #38113 is merged, we must use Update instead of UpdateStatus to
 	// update the Status block of the ScheduledWorkflow. UpdateStatus will not
@@ -529,3 +715,88 @@ func (c *Controller) updateStatus(
 	// nothing other than resource status has been updated.
 	return c.swfClient.Update(ctx, swf.Namespace, swfCopy)
 }
+
+// notifyDisabledByFailurePolicy records that the ScheduledWorkflow was disabled by its
+// failure policy, and queues a notification to its webhook, if any. The notification is
+// POSTed by the notification worker, so that the webhook does not slow down the sync.
+func (c *Controller) notifyDisabledByFailurePolicy(swf *util.ScheduledWorkflow,
+	completed []swfapi.WorkflowStatus, nowEpoch int64) {
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+	}).Infof("Syncing ScheduledWorkflow (%v): disabled after %v consecutive failed workflows.",
+		swf.Name, swf.Status.ConsecutiveFailures)
+
+	url := swf.FailureWebhookURL()
+	if url == "" {
+		return
+	}
+	notification := &client.FailureNotification{
+		Namespace:           swf.Namespace,
+		ScheduledWorkflow:   swf.Name,
+		RecurringRunID:      string(swf.UID),
+		ConsecutiveFailures: swf.Status.ConsecutiveFailures,
+		Message:             swf.FailurePolicyMessage(),
+		DisabledAt:          time.Unix(nowEpoch, 0).UTC(),
+	}
+	for _, workflow := range completed {
+		if workflow.Index == swf.Status.LastCountedIndex {
+			notification.LastFailedWorkflow = workflow.Name
+		}
+	}
+	c.notificationQueue.Add(&failureNotification{
+		swf:          swf.Get().DeepCopy(),
+		url:          url,
+		notification: notification,
+	})
+}
+
+// runNotificationWorker is a long-running function that POSTs the queued
+// failure notifications to their webhooks.
+func (c *Controller) runNotificationWorker() {
+	for c.processNextNotification() {
+	}
+}
+
+// processNextNotification POSTs the next failure notification of the queue. A
+// notification that failed is retried with an exponential backoff, unless the
+// failure is permanent or the notification was attempted webhookMaxAttempts times.
+func (c *Controller) processNextNotification() bool {
+	obj, shutdown := c.notificationQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.notificationQueue.Done(obj)
+
+	item, ok := obj.(*failureNotification)
+	if !ok {
+		c.notificationQueue.Forget(obj)
+		runtime.HandleError(fmt.Errorf("Expected a failure notification in the queue but got %#v", obj))
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	err := c.webhookClient.PostFailureNotification(ctx, item.url, item.notification)
+	if err == nil {
+		c.notificationQueue.Forget(obj)
+		return true
+	}
+
+	attempts := c.notificationQueue.NumRequeues(obj) + 1
+	if !client.IsPermanentError(err) && attempts < webhookMaxAttempts {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: item.swf.Name,
+		}).Warningf("ScheduledWorkflow (%v): can't notify the failure webhook (attempt %v of %v), retrying: %v",
+			item.swf.Name, attempts, webhookMaxAttempts, err)
+		c.notificationQueue.AddRateLimited(obj)
+		return true
+	}
+
+	c.notificationQueue.Forget(obj)
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: item.swf.Name,
+	}).Errorf("ScheduledWorkflow (%v): can't notify the failure webhook after %v attempts: %v",
+		item.swf.Name, attempts, err)
+	c.kubeClient.RecordSyncFailure(item.swf, fmt.Sprintf("Can't notify the failure webhook: %v", err.Error()))
+	return true
+}
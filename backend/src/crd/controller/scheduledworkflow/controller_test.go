@@ -0,0 +1,532 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	workflowcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	apiclient "github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/client"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	swffake "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/fake"
+	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
+	"github.com/stretchr/testify/assert"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	hour          = 3600
+	minute        = 60
+	testNamespace = "NAMESPACE"
+	swfName       = "SCHEDULE1"
+)
+
+// fakeExecutionInformer serves the workflows of the fake execution client
+// the test makes visible to the controller.
+type fakeExecutionInformer struct {
+	workflows map[string]commonutil.ExecutionSpec
+}
+
+func (f *fakeExecutionInformer) AddEventHandler(funcs cache.ResourceEventHandler) {}
+
+func (f *fakeExecutionInformer) HasSynced() func() bool {
+	return func() bool { return true }
+}
+
+func (f *fakeExecutionInformer) Get(namespace string, name string) (commonutil.ExecutionSpec, bool, error) {
+	workflow, ok := f.workflows[name]
+	if !ok {
+		return nil, true, k8errors.NewNotFound(schema.ParseGroupResource("workflows.argoproj.io"), name)
+	}
+	return workflow, false, nil
+}
+
+func (f *fakeExecutionInformer) List(selector *labels.Selector) (commonutil.ExecutionSpecList, error) {
+	result := commonutil.ExecutionSpecList{}
+	for _, workflow := range f.workflows {
+		if (*selector).Matches(labels.Set(workflow.ExecutionObjectMeta().Labels)) {
+			result = append(result, workflow)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeExecutionInformer) InformerFactoryStart(stopCh <-chan struct{}) {}
+
+// fakePipelineClient returns the workflow of recurring runs that use the latest
+// version of their pipeline.
+type fakePipelineClient struct {
+	workflow *swfapi.WorkflowResource
+	err      error
+	calls    []string
+}
+
+func (f *fakePipelineClient) MaterializeWorkflow(ctx context.Context, recurringRunId string) (
+	*swfapi.WorkflowResource, error) {
+	f.calls = append(f.calls, recurringRunId)
+	return f.workflow, f.err
+}
+
+// racingExecutionClient rejects the workflows that already exist, like the Kubernetes
+// API server does, so that controllers can race on the same ScheduledWorkflow.
+type racingExecutionClient struct {
+	*apiclient.FakeExecClient
+}
+
+func (c *racingExecutionClient) Execution(namespace string) commonutil.ExecutionInterface {
+	return &racingExecutionInterface{ExecutionInterface: c.FakeExecClient.Execution(namespace)}
+}
+
+type racingExecutionInterface struct {
+	commonutil.ExecutionInterface
+}
+
+func (i *racingExecutionInterface) Create(ctx context.Context, execution commonutil.ExecutionSpec,
+	opts metav1.CreateOptions) (commonutil.ExecutionSpec, error) {
+	if _, err := i.Get(ctx, execution.ExecutionName(), metav1.GetOptions{}); err == nil {
+		return nil, k8errors.NewAlreadyExists(schema.ParseGroupResource("workflows.argoproj.io"), execution.ExecutionName())
+	}
+	return i.ExecutionInterface.Create(ctx, execution, opts)
+}
+
+type controllerTest struct {
+	controller         *Controller
+	swfClient          *swffake.Clientset
+	swfInformerFactory swfinformers.SharedInformerFactory
+	execClient         *apiclient.FakeExecClient
+	informer           *fakeExecutionInformer
+	nowEpoch           int64
+}
+
+// newControllerTest creates a controller for an hourly schedule created at 10:00 whose
+// workflow scheduled at 11:00 is still running.
+func newControllerTest(t *testing.T, policy swfapi.ConcurrencyPolicy, noCatchup bool, nowEpoch int64) *controllerTest {
+	lastTriggered := metav1.NewTime(time.Unix(11*hour, 0).UTC())
+	swf := &swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              swfName,
+			Namespace:         testNamespace,
+			CreationTimestamp: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:           true,
+			MaxConcurrency:    commonutil.Int64Pointer(1),
+			NoCatchup:         commonutil.BoolPointer(noCatchup),
+			ConcurrencyPolicy: policy,
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{IntervalSecond: hour},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Spec: workflowapi.WorkflowSpec{Entrypoint: "main"},
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastTriggeredTime: &lastTriggered,
+				LastIndex:         commonutil.Int64Pointer(1),
+			},
+		},
+	}
+
+	// Create the ScheduledWorkflow through the typed client, which uses the same
+	// resource as the controller updates.
+	swfClient := swffake.NewSimpleClientset()
+	_, err := swfClient.ScheduledworkflowV1beta1().ScheduledWorkflows(testNamespace).Create(context.Background(), swf)
+	assert.Nil(t, err)
+	swfInformerFactory := swfinformers.NewSharedInformerFactory(swfClient, 0)
+	err = swfInformerFactory.Scheduledworkflow().V1beta1().ScheduledWorkflows().Informer().GetIndexer().Add(swf)
+	assert.Nil(t, err)
+
+	test := &controllerTest{
+		swfClient:          swfClient,
+		swfInformerFactory: swfInformerFactory,
+		execClient:         apiclient.NewFakeExecClient(),
+		informer:           &fakeExecutionInformer{workflows: map[string]commonutil.ExecutionSpec{}},
+		nowEpoch:           nowEpoch,
+	}
+	test.controller = test.newController(test.informer, nil)
+	test.addWorkflow(t, "WORKFLOW1", 11*hour, 1)
+	return test
+}
+
+// newController creates a controller for the ScheduledWorkflow of the test, which sees
+// the workflows of the informer.
+// The webhooks of the test are served on the loopback addresses.
+func (test *controllerTest) newController(informer *fakeExecutionInformer, shard *util.NamespaceShard) *Controller {
+	webhookPolicy, _ := commonutil.NewTargetPolicy([]string{"127.0.0.0/8", "::1/128"})
+	controller := NewController(k8sfake.NewSimpleClientset(), test.swfClient, &racingExecutionClient{test.execClient},
+		test.swfInformerFactory, informer, &fakePipelineClient{}, shard, webhookPolicy,
+		commonutil.NewFakeTime(time.Unix(test.nowEpoch, 0).UTC()), time.UTC)
+	controller.notificationQueue = workqueue.NewRateLimitingQueue(
+		workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond))
+	return controller
+}
+
+func (test *controllerTest) addWorkflow(t *testing.T, name string, scheduledEpoch int64, index int64) {
+	workflow := commonutil.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec:       workflowapi.WorkflowSpec{ActiveDeadlineSeconds: commonutil.Int64Pointer(3600)},
+	})
+	workflow.SetCannonicalLabels(swfName, scheduledEpoch, index)
+	_, err := test.execClient.Execution(testNamespace).Create(context.Background(), workflow, metav1.CreateOptions{})
+	assert.Nil(t, err)
+	test.informer.workflows[name] = workflow
+}
+
+func (test *controllerTest) getScheduledWorkflow(t *testing.T) *swfapi.ScheduledWorkflow {
+	swf, err := test.swfClient.ScheduledworkflowV1beta1().ScheduledWorkflows(testNamespace).Get(
+		context.Background(), swfName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	return swf
+}
+
+func TestSyncHandler_AllowConcurrent(t *testing.T) {
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+
+	syncAgain, retryOnError, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.False(t, syncAgain)
+	assert.False(t, retryOnError)
+
+	// The workflow scheduled at 12:00 waits for the active workflow to complete.
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+	terminated, err := test.execClient.IsTerminated("WORKFLOW1")
+	assert.Nil(t, err)
+	assert.False(t, terminated)
+	swf := test.getScheduledWorkflow(t)
+	assert.Equal(t, int64(12*hour), swf.Status.Trigger.NextTriggeredTime.Unix())
+	assert.Nil(t, swf.Status.Trigger.LastSkippedTime)
+}
+
+func TestSyncHandler_ForbidConcurrent(t *testing.T) {
+	test := newControllerTest(t, swfapi.ForbidConcurrent, false, 12*hour+30*minute)
+
+	syncAgain, retryOnError, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.True(t, syncAgain)
+	assert.False(t, retryOnError)
+
+	// The workflow scheduled at 12:00 is skipped.
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+	terminated, err := test.execClient.IsTerminated("WORKFLOW1")
+	assert.Nil(t, err)
+	assert.False(t, terminated)
+	swf := test.getScheduledWorkflow(t)
+	assert.Equal(t, int64(12*hour), swf.Status.Trigger.LastSkippedTime.Unix())
+	assert.Equal(t, int64(1), swf.Status.Trigger.SkippedCount)
+	assert.Equal(t, int64(11*hour), swf.Status.Trigger.LastTriggeredTime.Unix())
+	assert.Equal(t, int64(13*hour), swf.Status.Trigger.NextTriggeredTime.Unix())
+	assert.Equal(t, int64(1), *swf.Status.Trigger.LastIndex)
+}
+
+func TestSyncHandler_ReplaceConcurrent(t *testing.T) {
+	test := newControllerTest(t, swfapi.ReplaceConcurrent, false, 12*hour+30*minute)
+
+	syncAgain, retryOnError, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.True(t, syncAgain)
+	assert.False(t, retryOnError)
+
+	// The active workflow is terminated and the workflow scheduled at 12:00 is created.
+	terminated, err := test.execClient.IsTerminated("WORKFLOW1")
+	assert.Nil(t, err)
+	assert.True(t, terminated)
+	assert.Equal(t, 2, test.execClient.GetWorkflowCount())
+	swf := test.getScheduledWorkflow(t)
+	assert.Equal(t, int64(12*hour), swf.Status.Trigger.LastTriggeredTime.Unix())
+	assert.Equal(t, int64(2), *swf.Status.Trigger.LastIndex)
+	assert.Nil(t, swf.Status.Trigger.LastSkippedTime)
+}
+
+func TestSyncHandler_QueueConcurrent(t *testing.T) {
+	test := newControllerTest(t, swfapi.QueueConcurrent, true, 14*hour+30*minute)
+
+	syncAgain, retryOnError, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.False(t, syncAgain)
+	assert.False(t, retryOnError)
+
+	// The workflows scheduled at 12:00, 13:00 and 14:00 wait for the active workflow
+	// to complete.
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+	swf := test.getScheduledWorkflow(t)
+	assert.Equal(t, int64(12*hour), swf.Status.Trigger.NextTriggeredTime.Unix())
+
+	// Once the active workflow completes, they are created one at a time despite NoCatchup.
+	test.informer.workflows["WORKFLOW1"].SetLabels(workflowcommon.LabelKeyCompleted, "true")
+	syncAgain, _, _, err = test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.True(t, syncAgain)
+	assert.Equal(t, 2, test.execClient.GetWorkflowCount())
+	swf = test.getScheduledWorkflow(t)
+	assert.Equal(t, int64(12*hour), swf.Status.Trigger.LastTriggeredTime.Unix())
+	assert.Equal(t, int64(13*hour), swf.Status.Trigger.NextTriggeredTime.Unix())
+}
+
+func TestSyncHandler_FailurePolicy(t *testing.T) {
+	var notifications []client.FailureNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notification client.FailureNotification
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&notification))
+		notifications = append(notifications, notification)
+	}))
+	defer server.Close()
+
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	cached, err := test.controller.swfClient.Get(testNamespace, swfName)
+	assert.Nil(t, err)
+	cached.Spec.FailurePolicy = &swfapi.FailurePolicy{MaxConsecutiveFailures: 1, WebhookURL: server.URL}
+
+	// The workflow scheduled at 11:00 fails.
+	workflow := test.informer.workflows["WORKFLOW1"].(*commonutil.Workflow)
+	workflow.SetLabels(workflowcommon.LabelKeyCompleted, "true")
+	workflow.Status.Phase = workflowapi.WorkflowFailed
+
+	syncAgain, retryOnError, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.False(t, syncAgain)
+	assert.False(t, retryOnError)
+
+	// The schedule is disabled instead of creating the workflow scheduled at 12:00.
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+	swf := test.getScheduledWorkflow(t)
+	assert.False(t, swf.Spec.Enabled)
+	assert.Equal(t, int64(1), swf.Status.ConsecutiveFailures)
+	assert.Equal(t, swfapi.ScheduledWorkflowDisabled, swf.Status.Conditions[0].Type)
+	assert.Equal(t, util.DisabledByFailurePolicyReason, swf.Status.Conditions[0].Reason)
+
+	// The notification is POSTed by the notification worker, not by the sync.
+	assert.Equal(t, 0, len(notifications))
+	assert.Equal(t, 1, test.controller.notificationQueue.Len())
+	assert.True(t, test.controller.processNextNotification())
+	assert.Equal(t, 1, len(notifications))
+	assert.Equal(t, swfName, notifications[0].ScheduledWorkflow)
+	assert.Equal(t, testNamespace, notifications[0].Namespace)
+	assert.Equal(t, int64(1), notifications[0].ConsecutiveFailures)
+	assert.Equal(t, "WORKFLOW1", notifications[0].LastFailedWorkflow)
+	assert.False(t, notifications[0].DisabledAt.IsZero())
+}
+
+func TestProcessNextNotification_Retry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	swf, err := test.controller.swfClient.Get(testNamespace, swfName)
+	assert.Nil(t, err)
+	test.controller.notificationQueue.Add(&failureNotification{
+		swf: swf.Get(), url: server.URL, notification: &client.FailureNotification{}})
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, test.controller.processNextNotification())
+	}
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 0, test.controller.notificationQueue.Len())
+}
+
+func TestProcessNextNotification_GiveUp(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	swf, err := test.controller.swfClient.Get(testNamespace, swfName)
+	assert.Nil(t, err)
+	test.controller.notificationQueue.Add(&failureNotification{
+		swf: swf.Get(), url: server.URL, notification: &client.FailureNotification{}})
+
+	for i := 0; i < webhookMaxAttempts; i++ {
+		assert.True(t, test.controller.processNextNotification())
+	}
+	assert.Equal(t, webhookMaxAttempts, requests)
+	assert.Equal(t, 0, test.controller.notificationQueue.Len())
+
+	// A webhook which rejects the notification is not retried.
+	requests = 0
+	notFound := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer notFound.Close()
+	test.controller.notificationQueue.Add(&failureNotification{
+		swf: swf.Get(), url: notFound.URL, notification: &client.FailureNotification{}})
+	assert.True(t, test.controller.processNextNotification())
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, 0, test.controller.notificationQueue.Len())
+}
+
+func TestSyncHandler_UseLatestPipelineVersion(t *testing.T) {
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	cached, err := test.controller.swfClient.Get(testNamespace, swfName)
+	assert.Nil(t, err)
+	cached.UID = "RECURRING_RUN1"
+	cached.Spec.UseLatestPipelineVersion = true
+	latest := commonutil.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{commonutil.LabelKeyWorkflowPipelineVersionId: "VERSION2"},
+		},
+		Spec: workflowapi.WorkflowSpec{Entrypoint: "latest"},
+	})
+	pipelineClient := &fakePipelineClient{workflow: &swfapi.WorkflowResource{Spec: latest.ToStringForSchedule()}}
+	test.controller.pipelineClient = pipelineClient
+
+	// The workflow scheduled at 11:00 succeeds.
+	workflow := test.informer.workflows["WORKFLOW1"].(*commonutil.Workflow)
+	workflow.SetLabels(workflowcommon.LabelKeyCompleted, "true")
+	workflow.Status.Phase = workflowapi.WorkflowSucceeded
+
+	_, _, _, err = test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+
+	// The workflow scheduled at 12:00 is created from the latest pipeline version.
+	assert.Equal(t, []string{"RECURRING_RUN1"}, pipelineClient.calls)
+	assert.Equal(t, 2, test.execClient.GetWorkflowCount())
+	var created *workflowapi.Workflow
+	for name := range test.execClient.GetWorkflowKeys() {
+		if name == "WORKFLOW1" {
+			continue
+		}
+		execSpec, err := test.execClient.Execution(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		assert.Nil(t, err)
+		created = execSpec.(*commonutil.Workflow).Workflow
+	}
+	assert.NotNil(t, created)
+	assert.Equal(t, "latest", created.Spec.Entrypoint)
+	assert.Equal(t, "VERSION2", created.Labels[commonutil.LabelKeyWorkflowPipelineVersionId])
+	assert.Equal(t, swfName, created.Labels[commonutil.LabelKeyWorkflowScheduledWorkflowName])
+
+	// The spec of the ScheduledWorkflow is unchanged.
+	swf := test.getScheduledWorkflow(t)
+	assert.Equal(t, workflowapi.WorkflowSpec{Entrypoint: "main"}, swf.Spec.Workflow.Spec)
+}
+
+func TestSyncHandler_UseLatestPipelineVersion_Error(t *testing.T) {
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	cached, err := test.controller.swfClient.Get(testNamespace, swfName)
+	assert.Nil(t, err)
+	cached.Spec.UseLatestPipelineVersion = true
+	test.controller.pipelineClient = &fakePipelineClient{err: errors.New("unavailable")}
+
+	workflow := test.informer.workflows["WORKFLOW1"].(*commonutil.Workflow)
+	workflow.SetLabels(workflowcommon.LabelKeyCompleted, "true")
+	workflow.Status.Phase = workflowapi.WorkflowSucceeded
+
+	_, retryOnError, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.NotNil(t, err)
+	assert.True(t, retryOnError)
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+}
+
+func TestSyncHandler_InvalidRunCompletionTrigger(t *testing.T) {
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	cached, err := test.controller.swfClient.Get(testNamespace, swfName)
+	assert.Nil(t, err)
+	cached.Spec.Trigger = swfapi.Trigger{
+		RunCompletionTrigger: &swfapi.RunCompletionTrigger{ExperimentID: "not a label value"},
+	}
+
+	syncAgain, retryOnError, swf, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid trigger")
+	assert.False(t, syncAgain)
+	assert.False(t, retryOnError)
+	assert.NotNil(t, swf)
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+}
+
+func TestSyncHandler_RacingControllers(t *testing.T) {
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	test.informer.workflows["WORKFLOW1"].SetLabels(workflowcommon.LabelKeyCompleted, "true")
+
+	// Another controller, e.g. a previous leader, whose cache does not see the workflows
+	// created by the test controller.
+	staleInformer := &fakeExecutionInformer{workflows: map[string]commonutil.ExecutionSpec{}}
+	for name, workflow := range test.informer.workflows {
+		staleInformer.workflows[name] = workflow
+	}
+	racingController := test.newController(staleInformer, nil)
+
+	syncAgain, _, _, err := test.controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.True(t, syncAgain)
+	assert.Equal(t, 2, test.execClient.GetWorkflowCount())
+
+	// The racing controller submits the same workflow, which is not created twice.
+	syncAgain, retryOnError, _, err := racingController.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.True(t, syncAgain)
+	assert.False(t, retryOnError)
+	assert.Equal(t, 2, test.execClient.GetWorkflowCount())
+	swf := test.getScheduledWorkflow(t)
+	assert.Equal(t, int64(12*hour), swf.Status.Trigger.LastTriggeredTime.Unix())
+	assert.Equal(t, int64(2), *swf.Status.Trigger.LastIndex)
+}
+
+func TestSyncHandler_NamespaceShard(t *testing.T) {
+	test := newControllerTest(t, swfapi.AllowConcurrent, false, 12*hour+30*minute)
+	test.informer.workflows["WORKFLOW1"].SetLabels(workflowcommon.LabelKeyCompleted, "true")
+
+	var owner, other *util.NamespaceShard
+	for i := 0; i < 2; i++ {
+		shard, err := util.NewNamespaceShard(i, 2)
+		assert.Nil(t, err)
+		if shard.Owns(testNamespace) {
+			owner = shard
+		} else {
+			other = shard
+		}
+	}
+
+	// The controller of another shard ignores the ScheduledWorkflow.
+	controller := test.newController(test.informer, other)
+	controller.enqueueScheduledWorkflow(test.getScheduledWorkflow(t))
+	assert.Equal(t, 0, controller.workqueue.Len())
+	syncAgain, retryOnError, _, err := controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.False(t, syncAgain)
+	assert.False(t, retryOnError)
+	assert.Equal(t, 1, test.execClient.GetWorkflowCount())
+
+	controller = test.newController(test.informer, owner)
+	syncAgain, _, _, err = controller.syncHandler(context.Background(), testNamespace+"/"+swfName)
+	assert.Nil(t, err)
+	assert.True(t, syncAgain)
+	assert.Equal(t, 2, test.execClient.GetWorkflowCount())
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// runWithLeaderElection calls run once this replica holds the Lease, so that a single
+// replica handles the ScheduledWorkflows at a time. The process exits when the Lease is
+// lost, since the workers of the controller cannot be stopped safely.
+func runWithLeaderElection(ctx context.Context, kubeClient kubernetes.Interface, leaseNamespace string,
+	leaseName string, identity string, run func(ctx context.Context)) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      leaseName,
+			Namespace: leaseNamespace,
+		},
+		Client: kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: run,
+			OnStoppedLeading: func() {
+				select {
+				case <-ctx.Done():
+					log.Infof("Released the lease (%s/%s)", leaseNamespace, leaseName)
+				default:
+					log.Fatalf("Lost the lease (%s/%s)", leaseNamespace, leaseName)
+				}
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					log.Infof("Waiting for the lease (%s/%s) held by %s", leaseNamespace, leaseName, leader)
+				}
+			},
+		},
+	})
+}
@@ -15,11 +15,15 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/client"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
@@ -32,12 +36,21 @@ import (
 )
 
 var (
-	masterURL   string
-	kubeconfig  string
-	namespace   string
-	location    *time.Location
-	clientQPS   float64
-	clientBurst int
+	masterURL                 string
+	kubeconfig                string
+	namespace                 string
+	location                  *time.Location
+	clientQPS                 float64
+	clientBurst               int
+	mlPipelineAPIServerName   string
+	mlPipelineServiceGRPCPort string
+	apiServerTimeout          time.Duration
+	leaderElect               bool
+	leaderElectionNamespace   string
+	leaderElectionName        string
+	shardIndex                int
+	shardCount                int
+	webhookAllowedNetworks    string
 )
 
 func main() {
@@ -74,21 +87,78 @@ func main() {
 		scheduleInformerFactory = swfinformers.NewFilteredSharedInformerFactory(scheduleClient, time.Second*30, namespace, nil)
 	}
 
+	pipelineClient, err := client.NewPipelineClient(
+		apiServerTimeout,
+		client.SaTokenFile,
+		mlPipelineAPIServerName,
+		mlPipelineServiceGRPCPort)
+	if err != nil {
+		log.Fatalf("Error creating ML pipeline API Server client: %v", err)
+	}
+
+	shard, err := util.NewNamespaceShard(shardIndex, shardCount)
+	if err != nil {
+		log.Fatalf("Error creating namespace shard: %v", err)
+	}
+
+	var allowedNetworks []string
+	for _, network := range strings.Split(webhookAllowedNetworks, ",") {
+		if network = strings.TrimSpace(network); network != "" {
+			allowedNetworks = append(allowedNetworks, network)
+		}
+	}
+	webhookPolicy, err := commonutil.NewTargetPolicy(allowedNetworks)
+	if err != nil {
+		log.Fatalf("Error parsing the networks allowed for failure webhooks: %v", err)
+	}
+
 	controller := NewController(
 		kubeClient,
 		scheduleClient,
 		execClient,
 		scheduleInformerFactory,
 		execInformer,
+		pipelineClient,
+		shard,
+		webhookPolicy,
 		commonutil.NewRealTime(),
 		location)
 
 	go scheduleInformerFactory.Start(stopCh)
 	go execInformer.InformerFactoryStart(stopCh)
 
-	if err = controller.Run(2, stopCh); err != nil {
-		log.Fatalf("Error running controller: %s", err.Error())
+	run := func(ctx context.Context) {
+		if err := controller.Run(2, ctx.Done()); err != nil {
+			log.Fatalf("Error running controller: %s", err.Error())
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+	if !leaderElect {
+		run(ctx)
+		return
+	}
+
+	identity, err := os.Hostname()
+	if err != nil {
+		log.Fatalf("Error getting the hostname for leader election: %v", err)
+	}
+	leaseNamespace := leaderElectionNamespace
+	if leaseNamespace == "" {
+		leaseNamespace = viper.GetString("NAMESPACE")
+	}
+	if leaseNamespace == "" {
+		log.Fatalf("Error running leader election: the namespace of the Lease is unknown. Set --leaderElectionNamespace or disable --leaderElect")
+	}
+	leaseName := leaderElectionName
+	if shardCount > 1 {
+		// The replicas of each shard elect their own leader.
+		leaseName = fmt.Sprintf("%s-shard-%d", leaderElectionName, shardIndex)
 	}
+	runWithLeaderElection(ctx, kubeClient, leaseNamespace, leaseName, identity, run)
 }
 
 func initEnv() {
@@ -109,6 +179,15 @@ func init() {
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientQPS, "clientQPS", 5, "The maximum QPS to the master from this client.")
 	flag.IntVar(&clientBurst, "clientBurst", 10, "Maximum burst for throttle from this client.")
+	flag.StringVar(&mlPipelineAPIServerName, "mlPipelineAPIServerName", "ml-pipeline", "Name of the ML pipeline API server.")
+	flag.StringVar(&mlPipelineServiceGRPCPort, "mlPipelineServiceGRPCPort", "8887", "GRPC Port of the ML pipeline API server.")
+	flag.DurationVar(&apiServerTimeout, "apiServerTimeout", time.Minute, "Timeout of the calls to the ML pipeline API server.")
+	flag.BoolVar(&leaderElect, "leaderElect", true, "Whether replicas elect a leader, which alone handles the ScheduledWorkflows.")
+	flag.StringVar(&leaderElectionNamespace, "leaderElectionNamespace", "", "The namespace of the leader election Lease. Defaults to the NAMESPACE environment variable.")
+	flag.StringVar(&leaderElectionName, "leaderElectionName", "ml-pipeline-scheduledworkflow", "The name of the leader election Lease. Each shard uses its own Lease.")
+	flag.IntVar(&shardIndex, "shardIndex", 0, "The index of the shard of namespaces handled by this controller.")
+	flag.IntVar(&shardCount, "shardCount", 1, "The number of shards the namespaces are split into. Each shard is handled by its own controller.")
+	flag.StringVar(&webhookAllowedNetworks, "webhookAllowedNetworks", "", "Comma-separated networks, in CIDR notation, of the internal addresses to which failure notifications may be posted. Other internal addresses are blocked.")
 	var err error
 	location, err = util.GetLocation()
 	if err != nil {
@@ -0,0 +1,79 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	wraperror "github.com/pkg/errors"
+)
+
+// BackfillEpochs returns up to limit epochs at which the schedule creates workflows
+// between startEpoch and endEpoch inclusive. Only cron and periodic schedules can be
+// backfilled. The epochs of periodic schedules are on the grid of their intervals
+// from their start time, or from their creation time if they have none.
+func (s *ScheduledWorkflow) BackfillEpochs(startEpoch int64, endEpoch int64,
+	location *time.Location, limit int) ([]int64, error) {
+	if s.Spec.Trigger.PeriodicSchedule != nil {
+		schedule := NewPeriodicSchedule(s.Spec.Trigger.PeriodicSchedule)
+		return schedule.GetScheduledEpochsInWindow(startEpoch, endEpoch, s.creationEpoch(), limit), nil
+	}
+	if s.Spec.Trigger.CronSchedule != nil {
+		schedule := NewCronSchedule(s.Spec.Trigger.CronSchedule)
+		times := schedule.GetScheduledTimesInWindow(
+			time.Unix(startEpoch, 0), time.Unix(endEpoch, 0), location, limit)
+		result := make([]int64, 0, len(times))
+		for _, t := range times {
+			result = append(result, t.Unix())
+		}
+		return result, nil
+	}
+	return nil, wraperror.Errorf(
+		"ScheduledWorkflow (%v) has neither a cron nor a periodic schedule to backfill", s.Name)
+}
+
+// ReserveBackfillIndexes advances the last index of the schedule past count indexes
+// for the workflows of a backfill, and returns the first of them. The status must be
+// updated before the workflows are created, so that neither the controller nor
+// another backfill reuses the indexes.
+func (s *ScheduledWorkflow) ReserveBackfillIndexes(count int) int64 {
+	firstIndex := s.nextIndex()
+	s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.lastIndex() + int64(count))
+	return firstIndex
+}
+
+// NewBackfillWorkflows creates the workflows scheduled at the given epochs by a backfill.
+// Like the workflows created by the controller, they are labelled with the schedule and
+// with the indexes reserved from firstIndex, so that they show in its history. Their
+// names derive from their scheduled time, so that a time is backfilled at most once.
+func (s *ScheduledWorkflow) NewBackfillWorkflows(scheduledEpochs []int64, firstIndex int64,
+	nowEpoch int64, location *time.Location) (
+	[]commonutil.ExecutionSpec, error) {
+	result := make([]commonutil.ExecutionSpec, 0, len(scheduledEpochs))
+	for i, scheduledEpoch := range scheduledEpochs {
+		workflow, err := s.newWorkflow(s.backfillResourceName(scheduledEpoch), firstIndex+int64(i),
+			scheduledEpoch, s.windowStartEpoch(scheduledEpoch, location), nowEpoch, nil)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, workflow)
+	}
+	return result, nil
+}
+
+func (s *ScheduledWorkflow) backfillResourceName(scheduledEpoch int64) string {
+	return resourceName(s.Name + "-backfill-" + commonutil.FormatInt64ForLabel(scheduledEpoch))
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestScheduledWorkflow_BackfillEpochs(t *testing.T) {
+	// The start and end times of the schedules are ignored.
+	startTime := metav1.NewTime(time.Unix(100*hour, 0).UTC())
+	endTime := metav1.NewTime(time.Unix(200*hour, 0).UTC())
+	tests := []struct {
+		name     string
+		trigger  swfapi.Trigger
+		limit    int
+		expected []int64
+	}{
+		{
+			name: "periodic",
+			trigger: swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{
+				IntervalSecond: 2 * hour, StartTime: &startTime, EndTime: &endTime,
+			}},
+			limit:    10,
+			expected: []int64{10 * hour, 12 * hour, 14 * hour},
+		},
+		{
+			name: "cron",
+			trigger: swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+				Cron: "0 0 * * * *", StartTime: &startTime, EndTime: &endTime,
+			}},
+			limit:    10,
+			expected: []int64{10 * hour, 11 * hour, 12 * hour, 13 * hour, 14 * hour},
+		},
+		{
+			name: "cron with time zone",
+			trigger: swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+				// 11:00 and 13:00 UTC.
+				Cron: "0 0 12,14 * * *", TimeZone: "Europe/Paris",
+			}},
+			limit:    10,
+			expected: []int64{11 * hour, 13 * hour},
+		},
+		{
+			name: "limit",
+			trigger: swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+				Cron: "0 0 * * * *",
+			}},
+			limit:    2,
+			expected: []int64{10 * hour, 11 * hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+				Spec: swfapi.ScheduledWorkflowSpec{Trigger: tt.trigger},
+			})
+			epochs, err := schedule.BackfillEpochs(10*hour, 14*hour, time.UTC, tt.limit)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, epochs)
+		})
+	}
+}
+
+func TestScheduledWorkflow_BackfillEpochs_EventDriven(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "SCHEDULE1"},
+		Spec: swfapi.ScheduledWorkflowSpec{Trigger: swfapi.Trigger{
+			ObjectStoreTrigger: &swfapi.ObjectStoreTrigger{URI: "s3://bucket/data/"},
+		}},
+	})
+	_, err := schedule.BackfillEpochs(10*hour, 14*hour, time.UTC, 10)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "neither a cron nor a periodic schedule")
+}
+
+func TestScheduledWorkflow_NewBackfillWorkflows(t *testing.T) {
+	spec, err := json.Marshal(workflowapi.WorkflowSpec{
+		Arguments: workflowapi.Arguments{
+			Parameters: []workflowapi.Parameter{
+				{Name: "PARAM1", Value: workflowapi.AnyStringPtr("VALUE1")},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	schedule := ScheduledWorkflow{&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "SCHEDULE1"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Workflow: &swfapi.WorkflowResource{
+				Parameters: []swfapi.Parameter{
+					{Name: "PARAM1", Value: "[[ScheduledTime.2006-01-02]]-[[Index]]"},
+				},
+				Spec: string(spec),
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{LastIndex: commonutil.Int64Pointer(5)},
+		},
+	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
+
+	firstIndex := schedule.ReserveBackfillIndexes(2)
+	assert.Equal(t, int64(6), firstIndex)
+	assert.Equal(t, int64(7), *schedule.Status.Trigger.LastIndex)
+	assert.Equal(t, int64(8), schedule.nextIndex())
+
+	workflows, err := schedule.NewBackfillWorkflows([]int64{24 * hour, 48 * hour}, firstIndex, 1000*hour, time.UTC)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(workflows))
+	for i, scheduledEpoch := range []int64{24 * hour, 48 * hour} {
+		workflow := workflows[i]
+		labels := workflow.ExecutionObjectMeta().Labels
+		assert.Equal(t, "SCHEDULE1", labels[commonutil.LabelKeyWorkflowScheduledWorkflowName])
+		assert.Equal(t, commonutil.FormatInt64ForLabel(scheduledEpoch), labels[commonutil.LabelKeyWorkflowEpoch])
+		assert.Equal(t, commonutil.FormatInt64ForLabel(int64(6+i)), labels[commonutil.LabelKeyWorkflowIndex])
+		assert.Equal(t, schedule.backfillResourceName(scheduledEpoch), workflow.ExecutionName())
+		assert.Equal(t, "SCHEDULE1", workflow.ExecutionObjectMeta().OwnerReferences[0].Name)
+	}
+	assert.Equal(t, "1970-01-02-6", *workflows[0].SpecParameters()[0].Value)
+	assert.Equal(t, "1970-01-03-7", *workflows[1].SpecParameters()[0].Value)
+
+	// The names are deterministic and distinct from the names of the scheduled workflows.
+	assert.Contains(t, workflows[0].ExecutionName(), "SCHEDULE1-backfill-86400-")
+	assert.NotEqual(t, schedule.NextResourceName(), workflows[0].ExecutionName())
+}
+
+func TestScheduledWorkflow_ReserveBackfillIndexes_NoLastIndex(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{})
+	assert.Equal(t, int64(1), schedule.ReserveBackfillIndexes(3))
+	assert.Equal(t, int64(3), *schedule.Status.Trigger.LastIndex)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"sort"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConcurrencyPolicy returns the concurrency policy of the ScheduledWorkflow.
+func (s *ScheduledWorkflow) ConcurrencyPolicy() swfapi.ConcurrencyPolicy {
+	switch s.Spec.ConcurrencyPolicy {
+	case swfapi.ForbidConcurrent, swfapi.ReplaceConcurrent, swfapi.QueueConcurrent:
+		return s.Spec.ConcurrencyPolicy
+	default:
+		return swfapi.AllowConcurrent
+	}
+}
+
+// IsAtMaxConcurrency returns true if no more workflow can run concurrently.
+func (s *ScheduledWorkflow) IsAtMaxConcurrency(activeWorkflowCount int64) bool {
+	return activeWorkflowCount >= s.maxConcurrency()
+}
+
+// waitsForConcurrency returns true if a workflow due while MaxConcurrency
+// workflows are running waits for one of them to complete.
+func (s *ScheduledWorkflow) waitsForConcurrency() bool {
+	policy := s.ConcurrencyPolicy()
+	return policy == swfapi.AllowConcurrent || policy == swfapi.QueueConcurrent
+}
+
+// catchup returns true if all the workflows missed in the past should be created.
+func (s *ScheduledWorkflow) catchup() bool {
+	if s.ConcurrencyPolicy() == swfapi.QueueConcurrent || s.Spec.NoCatchup == nil {
+		return true
+	}
+	return !*s.Spec.NoCatchup
+}
+
+// lastScheduledTime returns the scheduled time of the last workflow created or
+// skipped, or the time at which the schedule changed if later, from which the
+// next workflow is scheduled.
+func (s *ScheduledWorkflow) lastScheduledTime() *metav1.Time {
+	lastTriggered := s.Status.Trigger.LastTriggeredTime
+	lastSkipped := s.Status.Trigger.LastSkippedTime
+	if lastSkipped != nil && (lastTriggered == nil || lastSkipped.After(lastTriggered.Time)) {
+		lastTriggered = lastSkipped
+	}
+	// A changed schedule starts after the time of the change.
+	scheduleStart := s.Status.Trigger.ScheduleStartTime
+	if scheduleStart != nil && (lastTriggered == nil || scheduleStart.After(lastTriggered.Time)) {
+		return scheduleStart
+	}
+	return lastTriggered
+}
+
+// RecordSkippedWorkflow records that the workflow scheduled at scheduledEpoch was
+// skipped by the Forbid concurrency policy.
+func (s *ScheduledWorkflow) RecordSkippedWorkflow(scheduledEpoch int64) {
+	s.Status.Trigger.LastSkippedTime = commonutil.Metav1TimePointer(
+		metav1.NewTime(time.Unix(scheduledEpoch, 0).UTC()))
+	s.Status.Trigger.SkippedCount++
+}
+
+// WorkflowsToReplace returns the oldest active workflows to terminate so that a
+// new workflow can run without exceeding MaxConcurrency.
+func (s *ScheduledWorkflow) WorkflowsToReplace(active []swfapi.WorkflowStatus) []swfapi.WorkflowStatus {
+	count := int64(len(active)) - s.maxConcurrency() + 1
+	if count <= 0 {
+		return nil
+	}
+	oldest := make([]swfapi.WorkflowStatus, len(active))
+	copy(oldest, active)
+	sort.SliceStable(oldest, func(i, j int) bool {
+		return oldest[i].ScheduledAt.Before(&oldest[j].ScheduledAt)
+	})
+	return oldest[:count]
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPeriodicSchedule(policy swfapi.ConcurrencyPolicy, noCatchup bool) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:           true,
+			MaxConcurrency:    commonutil.Int64Pointer(1),
+			NoCatchup:         commonutil.BoolPointer(noCatchup),
+			ConcurrencyPolicy: policy,
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{IntervalSecond: int64(hour)},
+			},
+		},
+	})
+}
+
+func TestScheduledWorkflow_ConcurrencyPolicy(t *testing.T) {
+	tests := []struct {
+		policy   swfapi.ConcurrencyPolicy
+		expected swfapi.ConcurrencyPolicy
+	}{
+		{"", swfapi.AllowConcurrent},
+		{"Unknown", swfapi.AllowConcurrent},
+		{swfapi.AllowConcurrent, swfapi.AllowConcurrent},
+		{swfapi.ForbidConcurrent, swfapi.ForbidConcurrent},
+		{swfapi.ReplaceConcurrent, swfapi.ReplaceConcurrent},
+		{swfapi.QueueConcurrent, swfapi.QueueConcurrent},
+	}
+	for _, tt := range tests {
+		schedule := newPeriodicSchedule(tt.policy, false)
+		assert.Equal(t, tt.expected, schedule.ConcurrencyPolicy())
+	}
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_ConcurrencyPolicy(t *testing.T) {
+	nowEpoch := int64(11*hour + 30*minute)
+	tests := []struct {
+		policy       swfapi.ConcurrencyPolicy
+		shouldRunNow bool
+	}{
+		// Wait for the active workflow to complete.
+		{swfapi.AllowConcurrent, false},
+		{swfapi.QueueConcurrent, false},
+		// The controller skips or replaces the workflow.
+		{swfapi.ForbidConcurrent, true},
+		{swfapi.ReplaceConcurrent, true},
+	}
+	for _, tt := range tests {
+		schedule := newPeriodicSchedule(tt.policy, false)
+		nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(1, nowEpoch, *time.UTC)
+		assert.Equal(t, int64(11*hour), nextScheduledEpoch, tt.policy)
+		assert.Equal(t, tt.shouldRunNow, shouldRunNow, tt.policy)
+	}
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_QueueIgnoresNoCatchup(t *testing.T) {
+	nowEpoch := int64(14*hour + 30*minute)
+	lastTriggered := metav1.NewTime(time.Unix(11*hour, 0).UTC())
+
+	// The workflows deferred while the active workflow ran are created one by one.
+	schedule := newPeriodicSchedule(swfapi.QueueConcurrent, true)
+	schedule.Status.Trigger.LastTriggeredTime = &lastTriggered
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, nowEpoch, *time.UTC)
+	assert.Equal(t, int64(12*hour), nextScheduledEpoch)
+	assert.True(t, shouldRunNow)
+
+	// Allow skips the missed workflows and creates a single one now.
+	schedule = newPeriodicSchedule(swfapi.AllowConcurrent, true)
+	schedule.Status.Trigger.LastTriggeredTime = &lastTriggered
+	nextScheduledEpoch, shouldRunNow = schedule.GetNextScheduledEpoch(0, nowEpoch, *time.UTC)
+	assert.Equal(t, nowEpoch, nextScheduledEpoch)
+	assert.True(t, shouldRunNow)
+}
+
+func TestScheduledWorkflow_RecordSkippedWorkflow(t *testing.T) {
+	schedule := newPeriodicSchedule(swfapi.ForbidConcurrent, false)
+	lastTriggered := metav1.NewTime(time.Unix(11*hour, 0).UTC())
+	schedule.Status.Trigger.LastTriggeredTime = &lastTriggered
+
+	schedule.RecordSkippedWorkflow(12 * hour)
+	schedule.RecordSkippedWorkflow(13 * hour)
+	assert.Equal(t, int64(13*hour), schedule.Status.Trigger.LastSkippedTime.Unix())
+	assert.Equal(t, int64(2), schedule.Status.Trigger.SkippedCount)
+
+	// The next workflow is scheduled after the last skipped one.
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 13*hour+30*minute, *time.UTC)
+	assert.Equal(t, int64(14*hour), nextScheduledEpoch)
+	assert.False(t, shouldRunNow)
+}
+
+func TestScheduledWorkflow_WorkflowsToReplace(t *testing.T) {
+	schedule := newPeriodicSchedule(swfapi.ReplaceConcurrent, false)
+	schedule.Spec.MaxConcurrency = commonutil.Int64Pointer(2)
+	active := []swfapi.WorkflowStatus{
+		{Name: "WORKFLOW3", ScheduledAt: metav1.NewTime(time.Unix(13*hour, 0).UTC())},
+		{Name: "WORKFLOW1", ScheduledAt: metav1.NewTime(time.Unix(11*hour, 0).UTC())},
+		{Name: "WORKFLOW2", ScheduledAt: metav1.NewTime(time.Unix(12*hour, 0).UTC())},
+	}
+
+	replaced := schedule.WorkflowsToReplace(active)
+	assert.Equal(t, []string{"WORKFLOW1", "WORKFLOW2"}, []string{replaced[0].Name, replaced[1].Name})
+	assert.Equal(t, "WORKFLOW3", active[0].Name)
+
+	assert.Empty(t, schedule.WorkflowsToReplace(active[:1]))
+}
@@ -15,6 +15,7 @@
 package util
 
 import (
+	"math"
 	"time"
 
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
@@ -28,22 +29,50 @@ import (
 // For more information check: https://stackoverflow.com/questions/25065055/what-is-the-maximum-time-time-in-go
 var maxTime = time.Unix(1<<63-62135596801, 999999999)
 
+// The maximum time to look back for the previous time of a cron schedule.
+const maxLookback = 2 * 366 * 24 * time.Hour
+
 // CronSchedule is a type to help manipulate CronSchedule objects.
 type CronSchedule struct {
 	*swfapi.CronSchedule
+	// The time zone of the schedule, nil to use the default location.
+	location *time.Location
 }
 
-// NewCronSchedule creates a CronSchedule.
+// NewCronSchedule creates a CronSchedule. An invalid time zone is logged and
+// the default location is used instead.
 func NewCronSchedule(cronSchedule *swfapi.CronSchedule) *CronSchedule {
 	if cronSchedule == nil {
 		log.Fatalf("The cronSchedule should never be nil")
 	}
 
+	var location *time.Location
+	if cronSchedule.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(cronSchedule.TimeZone)
+		if err != nil {
+			// This should never happen, validation should have caught this at resource creation.
+			log.Errorf("%+v", wraperror.Errorf(
+				"Found invalid time zone (%v): %v", cronSchedule.TimeZone, err))
+			location = nil
+		}
+	}
+
 	return &CronSchedule{
-		cronSchedule,
+		CronSchedule: cronSchedule,
+		location:     location,
 	}
 }
 
+// getLocation returns the time zone of the schedule, or the default location
+// if the schedule has none.
+func (s *CronSchedule) getLocation(defaultLocation *time.Location) *time.Location {
+	if s.location != nil {
+		return s.location
+	}
+	return defaultLocation
+}
+
 // GetNextScheduledTime returns the next epoch at which a workflow must be
 // scheduled.
 func (s *CronSchedule) GetNextScheduledTime(lastJobTime *v1.Time,
@@ -81,6 +110,7 @@ func (s *CronSchedule) getNextScheduledTime(lastJobTime time.Time, location *tim
 }
 
 func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bool, nowTime time.Time, location *time.Location) time.Time {
+	location = s.getLocation(location)
 	schedule, err := cron.Parse(s.Cron)
 	if err != nil {
 		// This should never happen, validation should have caught this at resource creation.
@@ -94,7 +124,7 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 		startTime = s.StartTime.Time
 	}
 
-	result := schedule.Next(startTime.In(location))
+	result := nextInLocation(schedule, startTime, location)
 	var endTime time.Time = maxTime
 	if s.EndTime != nil {
 		endTime = s.EndTime.Time
@@ -114,7 +144,7 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 	next := result
 	var nextNext time.Time
 	for {
-		nextNext = schedule.Next(next)
+		nextNext = nextInLocation(schedule, next, location)
 		if !nextNext.After(nowTime) && !nextNext.After(endTime) {
 			next = nextNext
 		} else {
@@ -123,3 +153,88 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 	}
 	return next
 }
+
+// GetScheduledTimesInWindow returns up to limit times matching the cron expression
+// between startTime and endTime inclusive, regardless of the start and end times
+// of the schedule.
+func (s *CronSchedule) GetScheduledTimesInWindow(startTime time.Time, endTime time.Time,
+	location *time.Location, limit int) []time.Time {
+	location = s.getLocation(location)
+	schedule, err := cron.Parse(s.Cron)
+	if err != nil {
+		// This should never happen, validation should have caught this at resource creation.
+		log.Errorf("%+v", wraperror.Errorf(
+			"Found invalid schedule (%v): %v", s.Cron, err))
+		return nil
+	}
+
+	result := make([]time.Time, 0)
+	next := nextInLocation(schedule, startTime.Add(-time.Second), location)
+	for !next.After(endTime) && len(result) < limit {
+		result = append(result, next)
+		next = nextInLocation(schedule, next, location)
+	}
+	return result
+}
+
+// GetPreviousScheduledTime returns the last time matching the cron expression before t,
+// looking back at most maxLookback.
+func (s *CronSchedule) GetPreviousScheduledTime(t time.Time, location *time.Location) (time.Time, bool) {
+	for lookback := time.Minute; lookback <= maxLookback; lookback *= 2 {
+		times := s.GetScheduledTimesInWindow(t.Add(-lookback), t.Add(-time.Second), location, math.MaxInt32)
+		if len(times) > 0 {
+			return times[len(times)-1], true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextInLocation returns the first time after t matching the schedule in the
+// wall clock of the location. The schedule is evaluated on the wall clock so
+// that daylight-saving transitions neither skip nor repeat a run: a time
+// skipped when clocks move forward runs right after the transition, and a
+// time repeated when clocks move back runs once.
+func nextInLocation(schedule cron.Schedule, t time.Time, location *time.Location) time.Time {
+	wallClock := toWallClock(t.In(location))
+	for {
+		wallClock = schedule.Next(wallClock)
+		if wallClock.IsZero() {
+			// No matching time was found.
+			return maxTime.In(location)
+		}
+		result := time.Date(wallClock.Year(), wallClock.Month(), wallClock.Day(),
+			wallClock.Hour(), wallClock.Minute(), wallClock.Second(), 0, location)
+		// The wall clock does not exist when clocks move forward, and
+		// time.Date may return a time before the transition.
+		if toWallClock(result).Before(wallClock) {
+			result = transitionAfter(result, wallClock, location)
+		}
+		if result.After(t) {
+			return result
+		}
+	}
+}
+
+// transitionAfter returns the instant the clocks of the location move forward
+// past the wall clock, given a time t before it. Transitions happen on whole
+// seconds.
+func transitionAfter(t time.Time, wallClock time.Time, location *time.Location) time.Time {
+	// The wall clock of t is behind by at most the shift of the transition.
+	before, after := t.Unix(), t.Add(wallClock.Sub(toWallClock(t))).Unix()
+	for after-before > 1 {
+		middle := before + (after-before)/2
+		if toWallClock(time.Unix(middle, 0).In(location)).Before(wallClock) {
+			before = middle
+		} else {
+			after = middle
+		}
+	}
+	return time.Unix(after, 0).In(location)
+}
+
+// toWallClock returns the wall clock of t as a UTC time, which has no
+// daylight-saving transitions.
+func toWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
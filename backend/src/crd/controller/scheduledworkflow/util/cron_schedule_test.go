@@ -198,3 +198,97 @@ func TestCronSchedule_GetNextScheduledTimeNoCatchup(t *testing.T) {
 	assert.Equal(t, time.Unix(10*hour+15*minute+minute, 0).UTC(),
 		schedule.GetNextScheduledTimeNoCatchup(nil, defaultStartTime, time.Unix(0, 0), location))
 }
+
+func TestCronSchedule_GetNextScheduledTime_TimeZone(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.Nil(t, err)
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 2 * * *", // 02:00 every day
+		TimeZone: "Europe/Paris",
+	})
+
+	// The time zone of the schedule takes precedence over the default location.
+	lastJobTime := v1.NewTime(time.Date(2023, 1, 10, 2, 0, 0, 0, paris))
+	assert.Equal(t, time.Date(2023, 1, 11, 2, 0, 0, 0, paris),
+		schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC))
+	assert.Equal(t, time.Date(2023, 1, 11, 1, 0, 0, 0, time.UTC),
+		schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC).UTC())
+
+	// An invalid time zone falls back on the default location.
+	schedule = NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 2 * * *",
+		TimeZone: "Mars/Olympus_Mons",
+	})
+	lastJobTime = v1.NewTime(time.Date(2023, 1, 10, 2, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2023, 1, 11, 2, 0, 0, 0, time.UTC),
+		schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC))
+}
+
+func TestCronSchedule_GetNextScheduledTime_DaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+
+	// Runs at 02:00 local time, before and after the time changes.
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 2 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobTime := v1.NewTime(time.Date(2023, 3, 10, 2, 0, 0, 0, newYork))
+	next := schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 3, 11, 7, 0, 0, 0, time.UTC), next.UTC())
+
+	// 02:00 does not exist on March 12th, the run happens right after the
+	// clocks move forward.
+	lastJobTime = v1.NewTime(next)
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 3, 12, 3, 0, 0, 0, newYork), next)
+
+	lastJobTime = v1.NewTime(next)
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 3, 13, 6, 0, 0, 0, time.UTC), next.UTC())
+
+	// 02:30 does not exist on March 12th either, the run happens at 03:00
+	// when the clocks move forward, not at 03:30.
+	schedule = NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 30 2 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobTime = v1.NewTime(time.Date(2023, 3, 11, 2, 30, 0, 0, newYork))
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 3, 12, 7, 0, 0, 0, time.UTC), next.UTC())
+	assert.Equal(t, time.Date(2023, 3, 12, 3, 0, 0, 0, newYork), next)
+
+	lastJobTime = v1.NewTime(next)
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 3, 13, 6, 30, 0, 0, time.UTC), next.UTC())
+
+	// 01:30 happens twice on November 5th, the run happens once.
+	schedule = NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 30 1 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobTime = v1.NewTime(time.Date(2023, 11, 4, 1, 30, 0, 0, newYork))
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, 5, next.Day())
+	assert.Equal(t, 1, next.Hour())
+	assert.Equal(t, 30, next.Minute())
+
+	lastJobTime = v1.NewTime(next)
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 11, 6, 6, 30, 0, 0, time.UTC), next.UTC())
+}
+
+func TestCronSchedule_GetNextScheduledTimeNoCatchup_DaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 2 * * *",
+		TimeZone: "America/New_York",
+	})
+
+	// Missed runs around the time change are skipped, the last one is run.
+	lastJobTime := v1.NewTime(time.Date(2023, 3, 10, 2, 0, 0, 0, newYork))
+	nowTime := time.Date(2023, 3, 13, 12, 0, 0, 0, newYork)
+	assert.Equal(t, time.Date(2023, 3, 13, 2, 0, 0, 0, newYork),
+		schedule.GetNextScheduledTimeNoCatchup(&lastJobTime, time.Unix(0, 0), nowTime, time.UTC))
+}
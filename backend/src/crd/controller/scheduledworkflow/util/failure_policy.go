@@ -0,0 +1,110 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kubeflow/pipelines/backend/src/common"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+)
+
+// Reason of the Disabled condition of a ScheduledWorkflow disabled by its failure policy.
+const DisabledByFailurePolicyReason = "ConsecutiveFailures"
+
+func (s *ScheduledWorkflow) maxConsecutiveFailures() int64 {
+	if s.Spec.FailurePolicy == nil || s.Spec.FailurePolicy.MaxConsecutiveFailures < 0 {
+		return 0
+	}
+	return s.Spec.FailurePolicy.MaxConsecutiveFailures
+}
+
+// FailureWebhookURL returns the URL to notify when the ScheduledWorkflow is
+// disabled by its failure policy, or an empty string.
+func (s *ScheduledWorkflow) FailureWebhookURL() string {
+	if s.Spec.FailurePolicy == nil {
+		return ""
+	}
+	return s.Spec.FailurePolicy.WebhookURL
+}
+
+// IsDisabledByFailurePolicy returns true if the ScheduledWorkflow is disabled
+// because too many consecutive workflows failed.
+func (s *ScheduledWorkflow) IsDisabledByFailurePolicy() bool {
+	max := s.maxConsecutiveFailures()
+	return !s.enabled() && max > 0 && s.Status.ConsecutiveFailures >= max
+}
+
+// FailurePolicyMessage returns the message explaining why the ScheduledWorkflow
+// was disabled by its failure policy.
+func (s *ScheduledWorkflow) FailurePolicyMessage() string {
+	return fmt.Sprintf("The schedule was disabled after %v consecutive failed workflows.",
+		s.Status.ConsecutiveFailures)
+}
+
+func isFailedPhase(phase common.ExecutionPhase) bool {
+	return phase == common.ExecutionFailed || phase == common.ExecutionError
+}
+
+// RecordCompletedWorkflows updates the number of consecutive failed workflows
+// with the workflows completed since the last call, in the order of their index.
+// A completed workflow is only counted once all the workflows created before it
+// have completed. If the failure policy of the ScheduledWorkflow is exceeded, the
+// ScheduledWorkflow is disabled and RecordCompletedWorkflows returns true.
+func (s *ScheduledWorkflow) RecordCompletedWorkflows(active []swfapi.WorkflowStatus,
+	completed []swfapi.WorkflowStatus) (disabled bool) {
+	minActiveIndex := int64(-1)
+	for _, workflow := range active {
+		if minActiveIndex < 0 || workflow.Index < minActiveIndex {
+			minActiveIndex = workflow.Index
+		}
+	}
+
+	newlyCompleted := make([]swfapi.WorkflowStatus, 0)
+	for _, workflow := range completed {
+		if workflow.Index <= s.Status.LastCountedIndex {
+			continue
+		}
+		if minActiveIndex >= 0 && workflow.Index > minActiveIndex {
+			continue
+		}
+		newlyCompleted = append(newlyCompleted, workflow)
+	}
+	sort.Slice(newlyCompleted, func(i, j int) bool {
+		return newlyCompleted[i].Index < newlyCompleted[j].Index
+	})
+
+	newFailure := false
+	for _, workflow := range newlyCompleted {
+		if isFailedPhase(workflow.Phase) {
+			s.Status.ConsecutiveFailures++
+			newFailure = true
+		} else {
+			s.Status.ConsecutiveFailures = 0
+			newFailure = false
+		}
+		s.Status.LastCountedIndex = workflow.Index
+	}
+
+	// A schedule enabled again after being disabled by its failure policy is only
+	// disabled again by a new failure.
+	max := s.maxConsecutiveFailures()
+	if newFailure && s.enabled() && max > 0 && s.Status.ConsecutiveFailures >= max {
+		s.Spec.Enabled = false
+		return true
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFailurePolicySchedule(maxConsecutiveFailures int64) *ScheduledWorkflow {
+	schedule := newPeriodicSchedule(swfapi.AllowConcurrent, false)
+	schedule.Spec.FailurePolicy = &swfapi.FailurePolicy{MaxConsecutiveFailures: maxConsecutiveFailures}
+	return schedule
+}
+
+func workflowStatus(index int64, phase common.ExecutionPhase) swfapi.WorkflowStatus {
+	return swfapi.WorkflowStatus{Index: index, Phase: phase}
+}
+
+func TestScheduledWorkflow_RecordCompletedWorkflows(t *testing.T) {
+	schedule := newFailurePolicySchedule(3)
+
+	disabled := schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(2, common.ExecutionFailed),
+		workflowStatus(1, common.ExecutionSucceeded),
+	})
+	assert.False(t, disabled)
+	assert.Equal(t, int64(1), schedule.Status.ConsecutiveFailures)
+	assert.Equal(t, int64(2), schedule.Status.LastCountedIndex)
+
+	// The workflows already counted are ignored.
+	disabled = schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(3, common.ExecutionError),
+		workflowStatus(2, common.ExecutionFailed),
+		workflowStatus(1, common.ExecutionSucceeded),
+	})
+	assert.False(t, disabled)
+	assert.Equal(t, int64(2), schedule.Status.ConsecutiveFailures)
+
+	// A succeeded workflow resets the streak.
+	disabled = schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(5, common.ExecutionFailed),
+		workflowStatus(4, common.ExecutionSucceeded),
+	})
+	assert.False(t, disabled)
+	assert.Equal(t, int64(1), schedule.Status.ConsecutiveFailures)
+	assert.True(t, schedule.Spec.Enabled)
+}
+
+func TestScheduledWorkflow_RecordCompletedWorkflows_Disable(t *testing.T) {
+	schedule := newFailurePolicySchedule(2)
+
+	disabled := schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(1, common.ExecutionFailed),
+		workflowStatus(2, common.ExecutionFailed),
+	})
+	assert.True(t, disabled)
+	assert.False(t, schedule.Spec.Enabled)
+	assert.True(t, schedule.IsDisabledByFailurePolicy())
+
+	schedule.UpdateStatus(11*hour, false, 12*hour, nil, nil, nil)
+	assert.Equal(t, swfapi.ScheduledWorkflowDisabled, schedule.Status.Conditions[0].Type)
+	assert.Equal(t, DisabledByFailurePolicyReason, schedule.Status.Conditions[0].Reason)
+	assert.Equal(t, "The schedule was disabled after 2 consecutive failed workflows.",
+		schedule.Status.Conditions[0].Message)
+
+	// Once enabled again, the schedule is only disabled by a new failure.
+	schedule.Spec.Enabled = true
+	assert.False(t, schedule.IsDisabledByFailurePolicy())
+	assert.False(t, schedule.RecordCompletedWorkflows(nil, nil))
+	assert.True(t, schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(3, common.ExecutionFailed),
+	}))
+	assert.Equal(t, int64(3), schedule.Status.ConsecutiveFailures)
+}
+
+func TestScheduledWorkflow_RecordCompletedWorkflows_WaitsForActiveWorkflows(t *testing.T) {
+	schedule := newFailurePolicySchedule(2)
+
+	// The workflow 2 is not counted until the workflow 1 completes.
+	disabled := schedule.RecordCompletedWorkflows(
+		[]swfapi.WorkflowStatus{workflowStatus(1, common.ExecutionRunning)},
+		[]swfapi.WorkflowStatus{workflowStatus(2, common.ExecutionFailed)})
+	assert.False(t, disabled)
+	assert.Equal(t, int64(0), schedule.Status.ConsecutiveFailures)
+	assert.Equal(t, int64(0), schedule.Status.LastCountedIndex)
+
+	disabled = schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(2, common.ExecutionFailed),
+		workflowStatus(1, common.ExecutionFailed),
+	})
+	assert.True(t, disabled)
+	assert.Equal(t, int64(2), schedule.Status.ConsecutiveFailures)
+}
+
+func TestScheduledWorkflow_RecordCompletedWorkflows_NoFailurePolicy(t *testing.T) {
+	schedule := newPeriodicSchedule(swfapi.AllowConcurrent, false)
+
+	disabled := schedule.RecordCompletedWorkflows(nil, []swfapi.WorkflowStatus{
+		workflowStatus(1, common.ExecutionFailed),
+		workflowStatus(2, common.ExecutionFailed),
+	})
+	assert.False(t, disabled)
+	assert.True(t, schedule.Spec.Enabled)
+	assert.Equal(t, int64(2), schedule.Status.ConsecutiveFailures)
+	assert.False(t, schedule.IsDisabledByFailurePolicy())
+}
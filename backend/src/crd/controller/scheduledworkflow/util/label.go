@@ -43,3 +43,17 @@ func GetRequirementForMinIndexOrFatal(minIndex int64) *labels.Requirement {
 	}
 	return req
 }
+
+// GetRequirementForExperiment returns a label requirement for the workflows
+// of the runs of a specific experiment. The experiment ID comes from the user,
+// so an ID which is not a valid label value is returned as an error.
+func GetRequirementForExperiment(experimentId string) (*labels.Requirement, error) {
+	return labels.NewRequirement(commonutil.LabelKeyWorkflowExperimentId, selection.Equals, []string{experimentId})
+}
+
+// GetRequirementForPipeline returns a label requirement for the workflows
+// of the runs of a specific pipeline. The pipeline ID comes from the user,
+// so an ID which is not a valid label value is returned as an error.
+func GetRequirementForPipeline(pipelineId string) (*labels.Requirement, error) {
+	return labels.NewRequirement(commonutil.LabelKeyWorkflowPipelineId, selection.Equals, []string{pipelineId})
+}
@@ -75,6 +75,33 @@ func (s *PeriodicSchedule) getInterval() int64 {
 	return interval
 }
 
+// GetScheduledEpochsInWindow returns up to limit epochs from startEpoch until
+// endEpoch inclusive, on the grid of the intervals of the schedule from its start
+// time, or from defaultStartEpoch if it has none, regardless of the end time of
+// the schedule.
+func (s *PeriodicSchedule) GetScheduledEpochsInWindow(startEpoch int64, endEpoch int64,
+	defaultStartEpoch int64, limit int) []int64 {
+	anchorEpoch := defaultStartEpoch
+	if s.StartTime != nil {
+		anchorEpoch = s.StartTime.Unix()
+	}
+	interval := s.getInterval()
+	// The first epoch of the grid at or after startEpoch.
+	offset := (startEpoch - anchorEpoch) % interval
+	if offset < 0 {
+		offset += interval
+	}
+	firstEpoch := startEpoch
+	if offset > 0 {
+		firstEpoch += interval - offset
+	}
+	result := make([]int64, 0)
+	for epoch := firstEpoch; epoch <= endEpoch && len(result) < limit; epoch += interval {
+		result = append(result, epoch)
+	}
+	return result
+}
+
 func (s *PeriodicSchedule) GetNextScheduledEpochNoCatchup(
 	lastJobEpoch *int64, defaultStartEpoch int64, nowEpoch int64) int64 {
 
@@ -145,6 +145,75 @@ func TestPeriodicSchedule_GetNextScheduledEpochNoCatchup(t *testing.T) {
 		schedule.GetNextScheduledEpochNoCatchup(nil, defaultStartEpoch, 0))
 }
 
+func TestPeriodicSchedule_GetScheduledEpochsInWindow(t *testing.T) {
+	startTime := v1.NewTime(time.Unix(hour+30*minute, 0).UTC())
+	tests := []struct {
+		name              string
+		schedule          *swfapi.PeriodicSchedule
+		startEpoch        int64
+		endEpoch          int64
+		defaultStartEpoch int64
+		limit             int
+		expected          []int64
+	}{
+		{
+			name:       "window start on the grid",
+			schedule:   &swfapi.PeriodicSchedule{IntervalSecond: 2 * hour},
+			startEpoch: 10 * hour,
+			endEpoch:   14 * hour,
+			limit:      10,
+			expected:   []int64{10 * hour, 12 * hour, 14 * hour},
+		},
+		{
+			name:       "window start between intervals",
+			schedule:   &swfapi.PeriodicSchedule{IntervalSecond: 2 * hour},
+			startEpoch: 11 * hour,
+			endEpoch:   15 * hour,
+			limit:      10,
+			expected:   []int64{12 * hour, 14 * hour},
+		},
+		{
+			name:       "grid from the start time",
+			schedule:   &swfapi.PeriodicSchedule{IntervalSecond: 2 * hour, StartTime: &startTime},
+			startEpoch: 10 * hour,
+			endEpoch:   14 * hour,
+			limit:      10,
+			expected:   []int64{11*hour + 30*minute, 13*hour + 30*minute},
+		},
+		{
+			name:              "grid from the default start time",
+			schedule:          &swfapi.PeriodicSchedule{IntervalSecond: 2 * hour},
+			startEpoch:        10 * hour,
+			endEpoch:          14 * hour,
+			defaultStartEpoch: hour + 30*minute,
+			limit:             10,
+			expected:          []int64{11*hour + 30*minute, 13*hour + 30*minute},
+		},
+		{
+			name:       "window before the start time",
+			schedule:   &swfapi.PeriodicSchedule{IntervalSecond: 2 * hour, StartTime: &startTime},
+			startEpoch: 0,
+			endEpoch:   hour + 30*minute,
+			limit:      10,
+			expected:   []int64{hour + 30*minute},
+		},
+		{
+			name:       "limit",
+			schedule:   &swfapi.PeriodicSchedule{IntervalSecond: hour},
+			startEpoch: 10*hour + minute,
+			endEpoch:   20 * hour,
+			limit:      2,
+			expected:   []int64{11 * hour, 12 * hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := NewPeriodicSchedule(tt.schedule)
+			assert.Equal(t, tt.expected, schedule.GetScheduledEpochsInWindow(tt.startEpoch, tt.endEpoch, tt.defaultStartEpoch, tt.limit))
+		})
+	}
+}
+
 func TestPeriodicSchedule_TestStarttime(t *testing.T) {
 	// First job.
 
@@ -0,0 +1,54 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"math"
+	"time"
+
+	wraperror "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreviewScheduledEpochs returns up to count epochs at which the schedule creates
+// workflows from nowEpoch on, assuming each workflow is created on time. It applies the
+// start and end times, the catchup setting and the time zone of the schedule: with
+// catchup, the epochs missed before nowEpoch come first. Only cron and periodic
+// schedules can be previewed.
+func (s *ScheduledWorkflow) PreviewScheduledEpochs(nowEpoch int64, location time.Location,
+	count int) ([]int64, error) {
+	if s.Spec.Trigger.CronSchedule == nil && s.Spec.Trigger.PeriodicSchedule == nil {
+		return nil, wraperror.Errorf(
+			"ScheduledWorkflow (%v) has neither a cron nor a periodic schedule to preview", s.Name)
+	}
+
+	// Record the workflows on a copy, so that the status of the schedule is unchanged.
+	schedule := &ScheduledWorkflow{s.ScheduledWorkflow.DeepCopy(), s.uuid}
+	result := make([]int64, 0, count)
+	for len(result) < count {
+		nextScheduledEpoch := schedule.getNextScheduledEpoch(nowEpoch, location)
+		if nextScheduledEpoch == math.MaxInt64 || nextScheduledEpoch >= maxTime.Unix() {
+			// The schedule has ended.
+			break
+		}
+		if len(result) > 0 && nextScheduledEpoch <= result[len(result)-1] {
+			break
+		}
+		result = append(result, nextScheduledEpoch)
+		lastTriggered := metav1.NewTime(time.Unix(nextScheduledEpoch, 0).UTC())
+		schedule.Status.Trigger.LastTriggeredTime = &lastTriggered
+	}
+	return result, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestScheduledWorkflow_PreviewScheduledEpochs(t *testing.T) {
+	startTime := metav1.NewTime(time.Unix(10*hour, 0).UTC())
+	endTime := metav1.NewTime(time.Unix(12*hour, 0).UTC())
+	tests := []struct {
+		name      string
+		trigger   swfapi.Trigger
+		noCatchup bool
+		nowEpoch  int64
+		expected  []int64
+	}{
+		{
+			name: "periodic with catchup",
+			trigger: swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{
+				IntervalSecond: hour,
+			}},
+			nowEpoch: 12*hour + 30*minute,
+			expected: []int64{11 * hour, 12 * hour, 13 * hour},
+		},
+		{
+			name: "periodic without catchup",
+			trigger: swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{
+				IntervalSecond: hour,
+			}},
+			noCatchup: true,
+			nowEpoch:  12*hour + 30*minute,
+			expected:  []int64{12*hour + 30*minute, 13*hour + 30*minute, 14*hour + 30*minute},
+		},
+		{
+			name: "cron with start and end times",
+			trigger: swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+				Cron: "0 0 * * * *", StartTime: &startTime, EndTime: &endTime,
+			}},
+			nowEpoch: 9 * hour,
+			expected: []int64{11 * hour, 12 * hour},
+		},
+		{
+			name: "cron with time zone",
+			trigger: swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+				// 11:00 UTC.
+				Cron: "0 0 12 * * *", TimeZone: "Europe/Paris",
+			}},
+			nowEpoch: 10 * hour,
+			expected: []int64{11 * hour, 35 * hour, 59 * hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+				},
+				Spec: swfapi.ScheduledWorkflowSpec{
+					Enabled:   true,
+					NoCatchup: commonutil.BoolPointer(tt.noCatchup),
+					Trigger:   tt.trigger,
+				},
+			})
+			epochs, err := schedule.PreviewScheduledEpochs(tt.nowEpoch, *time.UTC, 3)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, epochs)
+			// The status of the schedule is unchanged.
+			assert.Nil(t, schedule.Status.Trigger.LastTriggeredTime)
+		})
+	}
+}
+
+func TestScheduledWorkflow_PreviewScheduledEpochs_FromLastTriggeredTime(t *testing.T) {
+	schedule := newPeriodicSchedule(swfapi.AllowConcurrent, false)
+	lastTriggered := metav1.NewTime(time.Unix(20*hour, 0).UTC())
+	schedule.Status.Trigger.LastTriggeredTime = &lastTriggered
+
+	epochs, err := schedule.PreviewScheduledEpochs(20*hour+30*minute, *time.UTC, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{21 * hour, 22 * hour}, epochs)
+}
+
+func TestScheduledWorkflow_PreviewScheduledEpochs_EventDriven(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "SCHEDULE1"},
+		Spec: swfapi.ScheduledWorkflowSpec{Trigger: swfapi.Trigger{
+			ObjectStoreTrigger: &swfapi.ObjectStoreTrigger{URI: "s3://bucket/data/"},
+		}},
+	})
+	_, err := schedule.PreviewScheduledEpochs(10*hour, *time.UTC, 10)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "neither a cron nor a periodic schedule")
+}
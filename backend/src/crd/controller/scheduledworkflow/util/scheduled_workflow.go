@@ -55,6 +55,14 @@ func (s *ScheduledWorkflow) Get() *swfapi.ScheduledWorkflow {
 	return s.ScheduledWorkflow
 }
 
+// WithWorkflow returns a copy of the ScheduledWorkflow which creates the given
+// workflow instead of the workflow of its spec.
+func (s *ScheduledWorkflow) WithWorkflow(workflow *swfapi.WorkflowResource) *ScheduledWorkflow {
+	swf := s.ScheduledWorkflow.DeepCopy()
+	swf.Spec.Workflow = workflow
+	return &ScheduledWorkflow{swf, s.uuid}
+}
+
 func (s *ScheduledWorkflow) creationEpoch() int64 {
 	return s.CreationTimestamp.Unix()
 }
@@ -123,7 +131,8 @@ func (s *ScheduledWorkflow) MinIndex() int64 {
 
 func (s *ScheduledWorkflow) isOneOffRun() bool {
 	return s.Spec.Trigger.CronSchedule == nil &&
-		s.Spec.Trigger.PeriodicSchedule == nil
+		s.Spec.Trigger.PeriodicSchedule == nil &&
+		!s.IsEventDriven()
 }
 
 func (s *ScheduledWorkflow) nextResourceID() string {
@@ -132,10 +141,13 @@ func (s *ScheduledWorkflow) nextResourceID() string {
 
 // NextResourceName creates a deterministic resource name for the next resource.
 func (s *ScheduledWorkflow) NextResourceName() string {
-	nextResourceID := s.nextResourceID()
+	return resourceName(s.nextResourceID())
+}
+
+func resourceName(resourceID string) string {
 	h := fnv.New32a()
-	_, _ = h.Write([]byte(nextResourceID))
-	return fmt.Sprintf("%s-%v", nextResourceID, h.Sum32())
+	_, _ = h.Write([]byte(resourceID))
+	return fmt.Sprintf("%s-%v", resourceID, h.Sum32())
 }
 
 func (s *ScheduledWorkflow) getWorkflowParametersAsMap() map[string]string {
@@ -151,7 +163,43 @@ func (s *ScheduledWorkflow) getWorkflowParametersAsMap() map[string]string {
 // the appropriate OwnerReferences on the resource so handleObject can discover
 // the Schedule resource that 'owns' it.
 func (s *ScheduledWorkflow) NewWorkflow(
-	nextScheduledEpoch int64, nowEpoch int64) (commonutil.ExecutionSpec, error) {
+	nextScheduledEpoch int64, nowEpoch int64, location *time.Location) (commonutil.ExecutionSpec, error) {
+	return s.newWorkflow(s.NextResourceName(), s.nextIndex(), nextScheduledEpoch,
+		s.windowStartEpoch(nextScheduledEpoch, location), nowEpoch, nil)
+}
+
+// NewEventWorkflow creates a workflow for an event of an event-driven schedule.
+// The scheduled time of the workflow is the time of the event, and the details
+// of the event are available to the workflow parameters.
+func (s *ScheduledWorkflow) NewEventWorkflow(
+	event *TriggerEvent, nowEpoch int64) (commonutil.ExecutionSpec, error) {
+	return s.newWorkflow(s.NextResourceName(), s.nextIndex(), event.Time.Unix(),
+		s.windowStartEpoch(event.Time.Unix(), nil), nowEpoch, event.Details)
+}
+
+// windowStartEpoch returns the start of the window ending at scheduledEpoch, which is
+// the previous time of the schedule. For event-driven and one-off schedules, the
+// window starts at the last scheduled time, or at the creation of the schedule.
+func (s *ScheduledWorkflow) windowStartEpoch(scheduledEpoch int64, location *time.Location) int64 {
+	if s.Spec.Trigger.PeriodicSchedule != nil {
+		return scheduledEpoch - NewPeriodicSchedule(s.Spec.Trigger.PeriodicSchedule).getInterval()
+	}
+	if s.Spec.Trigger.CronSchedule != nil {
+		schedule := NewCronSchedule(s.Spec.Trigger.CronSchedule)
+		if previous, ok := schedule.GetPreviousScheduledTime(time.Unix(scheduledEpoch, 0), location); ok {
+			return previous.Unix()
+		}
+		return s.creationEpoch()
+	}
+	if lastScheduledTime := s.lastScheduledTime(); lastScheduledTime != nil &&
+		lastScheduledTime.Unix() < scheduledEpoch {
+		return lastScheduledTime.Unix()
+	}
+	return s.creationEpoch()
+}
+
+func (s *ScheduledWorkflow) newWorkflow(name string, index int64, nextScheduledEpoch int64,
+	windowStartEpoch int64, nowEpoch int64, eventDetails map[string]string) (commonutil.ExecutionSpec, error) {
 
 	// Creating the workflow.
 	execSpec, err := commonutil.ScheduleSpecToExecutionSpec(commonutil.ArgoWorkflow, s.Spec.Workflow)
@@ -165,16 +213,24 @@ func (s *ScheduledWorkflow) NewWorkflow(
 	}
 
 	// Set the name of the workflow.
-	execSpec.SetExecutionName(s.NextResourceName())
+	execSpec.SetExecutionName(name)
 
 	// Get the workflow parameters and format them.
-	formatter := commonutil.NewSWFParameterFormatter(uuid.String(), nextScheduledEpoch, nowEpoch, s.nextIndex())
+	formatter := commonutil.NewSWFEventParameterFormatter(uuid.String(), nextScheduledEpoch, nowEpoch, index, eventDetails)
+	formatter.SetWindowStart(windowStartEpoch)
+	if s.Spec.Trigger.CronSchedule != nil {
+		formatter.SetLocation(NewCronSchedule(s.Spec.Trigger.CronSchedule).location)
+	}
 	formattedParams := formatter.FormatWorkflowParameters(s.getWorkflowParametersAsMap())
 
 	// Set the parameters.
 	execSpec.OverrideParameters(formattedParams)
+	// Format the runtime config of v2 pipelines, which is not part of the parameters.
+	if err := execSpec.FormatRuntimeConfig(formatter); err != nil {
+		return nil, err
+	}
 
-	execSpec.SetCannonicalLabels(s.Name, nextScheduledEpoch, s.nextIndex())
+	execSpec.SetCannonicalLabels(s.Name, nextScheduledEpoch, index)
 	execSpec.SetLabels(commonutil.LabelKeyWorkflowRunId, uuid.String())
 	// Pod pipeline/runid label is used by v2 compatible mode.
 	execSpec.SetPodMetadataLabels(commonutil.LabelKeyWorkflowRunId, uuid.String())
@@ -202,8 +258,9 @@ func (s *ScheduledWorkflow) GetNextScheduledEpoch(activeWorkflowCount int64, now
 		return nextScheduledEpoch, false
 	}
 
-	// If the maxConcurrency is exceeded, return.
-	if activeWorkflowCount >= s.maxConcurrency() {
+	// If the maxConcurrency is exceeded, return, unless the concurrency policy
+	// skips or replaces workflows.
+	if s.IsAtMaxConcurrency(activeWorkflowCount) && s.waitsForConcurrency() {
 		return nextScheduledEpoch, false
 	}
 
@@ -216,35 +273,37 @@ func (s *ScheduledWorkflow) GetNextScheduledEpoch(activeWorkflowCount int64, now
 }
 
 func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64, location time.Location) int64 {
-	catchup := true
-	if s.Spec.NoCatchup != nil {
-		catchup = !*s.Spec.NoCatchup
-	}
+	catchup := s.catchup()
 	// Periodic schedule
 	if s.Spec.Trigger.PeriodicSchedule != nil {
 		schedule := NewPeriodicSchedule(s.Spec.Trigger.PeriodicSchedule)
 		if catchup {
 			return schedule.GetNextScheduledEpoch(
-				commonutil.ToInt64Pointer(s.Status.Trigger.LastTriggeredTime),
+				commonutil.ToInt64Pointer(s.lastScheduledTime()),
 				s.creationEpoch())
 		}
 		return schedule.GetNextScheduledEpochNoCatchup(
-			commonutil.ToInt64Pointer(s.Status.Trigger.LastTriggeredTime),
+			commonutil.ToInt64Pointer(s.lastScheduledTime()),
 			s.creationEpoch(), nowEpoch)
 
 	}
 
+	// Event-driven triggers
+	if s.IsEventDriven() {
+		return s.getNextPollEpoch()
+	}
+
 	// Cron schedule
 	if s.Spec.Trigger.CronSchedule != nil {
 		nowTime := time.Unix(nowEpoch, 0)
 		schedule := NewCronSchedule(s.Spec.Trigger.CronSchedule)
 		if catchup {
 			return schedule.GetNextScheduledTime(
-				s.Status.Trigger.LastTriggeredTime,
+				s.lastScheduledTime(),
 				time.Unix(s.creationEpoch(), 0).In(&location), &location).Unix()
 		}
 		return schedule.GetNextScheduledTimeNoCatchup(
-			s.Status.Trigger.LastTriggeredTime,
+			s.lastScheduledTime(),
 			time.Unix(s.creationEpoch(), 0).In(&location), nowTime, &location).Unix()
 	}
 
@@ -274,13 +333,18 @@ func (s *ScheduledWorkflow) UpdateStatus(updatedEpoch int64, submitted bool,
 	updatedTime := metav1.NewTime(time.Unix(updatedEpoch, 0).UTC())
 
 	conditionType, status, message := s.getStatusAndMessage(len(active))
+	reason := string(conditionType)
+	if s.IsDisabledByFailurePolicy() {
+		reason = DisabledByFailurePolicyReason
+		message = s.FailurePolicyMessage()
+	}
 
 	condition := swfapi.ScheduledWorkflowCondition{
 		Type:               conditionType,
 		Status:             status,
 		LastProbeTime:      updatedTime,
 		LastTransitionTime: updatedTime,
-		Reason:             string(conditionType),
+		Reason:             reason,
 		Message:            message,
 	}
 
@@ -307,11 +371,20 @@ func (s *ScheduledWorkflow) UpdateStatus(updatedEpoch int64, submitted bool,
 		s.enabled()))
 	s.setLabel(commonutil.LabelKeyScheduledWorkflowStatus, string(conditionType))
 
-	if submitted {
+	if submitted && s.IsEventDriven() {
+		// The scheduled time is the next poll, the workflow was triggered now.
+		s.updateLastTriggeredTime(updatedEpoch)
+		s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+		s.updateNextTriggeredTime(s.getNextPollEpoch())
+	} else if submitted {
 		s.updateLastTriggeredTime(scheduledEpoch)
 		s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
 		nextTriggerTime := s.getNextScheduledEpoch(0, *location)
 		s.updateNextTriggeredTime(nextTriggerTime)
+	} else if s.IsEventDriven() {
+		// LastTriggeredTime and LastIndex are unchanged, the next poll may
+		// have been delayed by the last one.
+		s.updateNextTriggeredTime(s.getNextPollEpoch())
 	} else {
 		// LastTriggeredTime is unchanged.
 		s.updateNextTriggeredTime(scheduledEpoch)
@@ -333,6 +406,23 @@ func (s *ScheduledWorkflow) updateNextTriggeredTime(epoch int64) {
 	}
 }
 
+// ResetTriggerStatus clears the status computed from the previous trigger of the
+// ScheduledWorkflow after its trigger changed. The new schedule starts after nowEpoch,
+// or at its start time if later, without catching up on the times of the previous
+// schedule, and a new event-driven trigger ignores the events before nowEpoch.
+// The index of the last workflow is kept so that new workflows have new names.
+func (s *ScheduledWorkflow) ResetTriggerStatus(nowEpoch int64) {
+	now := commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(nowEpoch, 0).UTC()))
+	s.Status.Trigger.NextTriggeredTime = nil
+	s.Status.Trigger.LastPolledTime = nil
+	s.Status.Trigger.LastEventTime = nil
+	s.Status.Trigger.ProcessedEvents = nil
+	s.Status.Trigger.ScheduleStartTime = now
+	if s.IsEventDriven() {
+		s.Status.Trigger.LastEventTime = now
+	}
+}
+
 func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int) (
 	conditionType swfapi.ScheduledWorkflowConditionType,
 	status core.ConditionStatus, message string) {
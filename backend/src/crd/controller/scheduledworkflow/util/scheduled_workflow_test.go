@@ -380,6 +380,34 @@ func TestScheduledWorkflow_GetNextScheduledEpoch_CronScheduleTimeZoneEndTime(t *
 	assert.Equal(t, nextRun.Unix(), nextScheduledEpoch)
 }
 
+func TestScheduledWorkflow_GetNextScheduledEpoch_CronSchedulePerScheduleTimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.Nil(t, err)
+	nowTime := time.Date(2006, 1, 3, 14, 4, 5, 0, tokyo)
+	creationTimestamp := metav1.NewTime(time.Date(2006, 1, 1, 16, 4, 5, 0, tokyo))
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: creationTimestamp,
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron:     "0 0 15 * * *", // trigger 15:00 every day
+					TimeZone: "Asia/Tokyo",
+				},
+			},
+		},
+	})
+
+	// The time zone of the schedule is used rather than the default location.
+	nextScheduledEpoch, mustRunNow := schedule.GetNextScheduledEpoch(
+		int64(0) /* active workflow count */, nowTime.Unix(), *time.UTC)
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, time.Date(2006, 1, 2, 15, 0, 0, 0, tokyo).Unix(), nextScheduledEpoch)
+}
+
 func TestScheduledWorkflow_GetNextScheduledEpoch_CronSchedule(t *testing.T) {
 
 	// Must run now
@@ -735,7 +763,7 @@ func TestScheduledWorkflow_NewWorkflow(t *testing.T) {
 				},
 			}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
 
-			result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch)
+			result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch, time.UTC)
 			assert.Nil(t, err)
 
 			expected := &workflowapi.Workflow{
@@ -849,7 +877,7 @@ func TestScheduledWorkflow_NewWorkflow_Parameterized(t *testing.T) {
 				},
 			}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
 
-			result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch)
+			result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch, time.UTC)
 			assert.Nil(t, err)
 			expected := &workflowapi.Workflow{
 				TypeMeta: metav1.TypeMeta{
@@ -891,3 +919,128 @@ func TestScheduledWorkflow_NewWorkflow_Parameterized(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduledWorkflow_NewWorkflow_ParameterMacros(t *testing.T) {
+	spec, err := json.Marshal(workflowapi.WorkflowSpec{
+		Arguments: workflowapi.Arguments{
+			Parameters: []workflowapi.Parameter{
+				{Name: "WINDOW", Value: workflowapi.AnyStringPtr("VALUE")},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		trigger  swfapi.Trigger
+		expected string
+	}{
+		{
+			"periodic schedule",
+			swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{IntervalSecond: 2 * hour}},
+			"08-10 1",
+		},
+		{
+			"cron schedule",
+			swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{Cron: "0 0 */3 * * *"}},
+			"09-10 1",
+		},
+		{
+			// The times are formatted in the time zone of the schedule.
+			"cron schedule in a time zone",
+			swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{Cron: "0 0 */3 * * *", TimeZone: "Asia/Tokyo"}},
+			"18-19 1",
+		},
+		{
+			"one-off schedule",
+			swfapi.Trigger{},
+			"05-10 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := ScheduledWorkflow{&swfapi.ScheduledWorkflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "SCHEDULE1",
+					CreationTimestamp: metav1.NewTime(time.Unix(5*hour, 0).UTC()),
+				},
+				Spec: swfapi.ScheduledWorkflowSpec{
+					Enabled: true,
+					Trigger: test.trigger,
+					Workflow: &swfapi.WorkflowResource{
+						Parameters: []swfapi.Parameter{{
+							Name:  "WINDOW",
+							Value: `{{window_start | date "15"}}-{{window_end | date "15"}} {{index}}`,
+						}},
+						Spec: string(spec),
+					},
+				},
+			}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
+
+			result, err := schedule.NewWorkflow(10*hour, 11*hour, time.UTC)
+			assert.Nil(t, err)
+			assert.Equal(t, map[string]string{"WINDOW": test.expected},
+				result.(*commonutil.Workflow).GetWorkflowParametersAsMap())
+		})
+	}
+}
+
+func TestScheduledWorkflow_ResetTriggerStatus(t *testing.T) {
+	lastTriggered := metav1.NewTime(time.Unix(10*hour, 0).UTC())
+	nextTriggered := metav1.NewTime(time.Unix(11*hour, 0).UTC())
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{Cron: "0 30 * * * *"},
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastTriggeredTime: &lastTriggered,
+				NextTriggeredTime: &nextTriggered,
+				LastIndex:         commonutil.Int64Pointer(3),
+			},
+		},
+	})
+
+	// The new schedule starts after the update.
+	schedule.ResetTriggerStatus(10*hour + 10*minute)
+	assert.Nil(t, schedule.Status.Trigger.NextTriggeredTime)
+	assert.Equal(t, &lastTriggered, schedule.Status.Trigger.LastTriggeredTime)
+	assert.Equal(t, int64(3), *schedule.Status.Trigger.LastIndex)
+	nextEpoch, _ := schedule.GetNextScheduledEpoch(0, 10*hour+10*minute, *time.UTC)
+	assert.Equal(t, int64(10*hour+30*minute), nextEpoch)
+
+	// A schedule changed long after the last workflow does not catch up on the
+	// times in between.
+	schedule.Spec.Trigger = swfapi.Trigger{
+		CronSchedule: &swfapi.CronSchedule{Cron: "0 45 * * * *"},
+	}
+	schedule.ResetTriggerStatus(15*hour + 50*minute)
+	assert.Equal(t, &lastTriggered, schedule.Status.Trigger.LastTriggeredTime)
+	nextEpoch, _ = schedule.GetNextScheduledEpoch(0, 15*hour+50*minute, *time.UTC)
+	assert.Equal(t, int64(16*hour+45*minute), nextEpoch)
+
+	// A changed schedule with a later start time starts at its start time.
+	startTime := metav1.NewTime(time.Unix(20*hour, 0).UTC())
+	schedule.Spec.Trigger = swfapi.Trigger{
+		PeriodicSchedule: &swfapi.PeriodicSchedule{IntervalSecond: hour, StartTime: &startTime},
+	}
+	schedule.ResetTriggerStatus(16 * hour)
+	nextEpoch, _ = schedule.GetNextScheduledEpoch(0, 16*hour, *time.UTC)
+	assert.Equal(t, int64(20*hour), nextEpoch)
+
+	// A new event-driven trigger ignores the events before the update.
+	schedule.Spec.Trigger = swfapi.Trigger{
+		ObjectStoreTrigger: &swfapi.ObjectStoreTrigger{URI: "s3://bucket/data/"},
+	}
+	schedule.ResetTriggerStatus(12 * hour)
+	assert.Equal(t, int64(12*hour), schedule.Status.Trigger.LastEventTime.Unix())
+	assert.Nil(t, schedule.Status.Trigger.LastPolledTime)
+	assert.Equal(t, int64(3), *schedule.Status.Trigger.LastIndex)
+}
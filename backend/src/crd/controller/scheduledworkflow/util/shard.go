@@ -0,0 +1,57 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// NamespaceShard selects the namespaces whose ScheduledWorkflows are handled by one of
+// several controllers. Each namespace is handled by exactly one shard.
+type NamespaceShard struct {
+	index int
+	count int
+}
+
+// NewNamespaceShard creates the shard with the given index out of count shards.
+func NewNamespaceShard(index int, count int) (*NamespaceShard, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("The number of shards must be at least 1. Received %v", count)
+	}
+	if index < 0 || index >= count {
+		return nil, fmt.Errorf("The shard index must be between 0 and %v. Received %v", count-1, index)
+	}
+	return &NamespaceShard{index: index, count: count}, nil
+}
+
+// Owns returns whether the namespace is handled by the shard. A nil shard owns every
+// namespace.
+func (s *NamespaceShard) Owns(namespace string) bool {
+	if s == nil || s.count == 1 {
+		return true
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(namespace))
+	return int(hash.Sum32()%uint32(s.count)) == s.index
+}
+
+// String returns a description of the shard for logging.
+func (s *NamespaceShard) String() string {
+	if s == nil {
+		return "all namespaces"
+	}
+	return fmt.Sprintf("shard %v of %v", s.index, s.count)
+}
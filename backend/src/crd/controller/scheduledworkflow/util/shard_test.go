@@ -0,0 +1,61 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNamespaceShard(t *testing.T) {
+	_, err := NewNamespaceShard(0, 1)
+	assert.Nil(t, err)
+	_, err = NewNamespaceShard(0, 0)
+	assert.NotNil(t, err)
+	_, err = NewNamespaceShard(3, 3)
+	assert.NotNil(t, err)
+	_, err = NewNamespaceShard(-1, 3)
+	assert.NotNil(t, err)
+}
+
+func TestNamespaceShard_Owns(t *testing.T) {
+	var nilShard *NamespaceShard
+	assert.True(t, nilShard.Owns("ns1"))
+	single, _ := NewNamespaceShard(0, 1)
+	assert.True(t, single.Owns("ns1"))
+
+	// Each namespace is owned by exactly one shard.
+	shards := make([]*NamespaceShard, 3)
+	for i := range shards {
+		shards[i], _ = NewNamespaceShard(i, 3)
+	}
+	owned := make([]int, 3)
+	for n := 0; n < 100; n++ {
+		namespace := fmt.Sprintf("ns%v", n)
+		owners := 0
+		for i, shard := range shards {
+			if shard.Owns(namespace) {
+				owners++
+				owned[i]++
+			}
+		}
+		assert.Equal(t, 1, owners, namespace)
+	}
+	for i := range shards {
+		assert.NotZero(t, owned[i])
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"sort"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	wraperror "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultPollIntervalSecond = int64(60)
+	// Maximum number of event IDs kept in the status to deduplicate events.
+	maxProcessedEvents = 100
+
+	// Final states of the runs accepted by a RunCompletionTrigger.
+	RunStateSucceeded = "SUCCEEDED"
+	RunStateFailed    = "FAILED"
+)
+
+// TriggerEvent is an event which creates a workflow for an event-driven
+// ScheduledWorkflow, e.g. a new object in a bucket or the completion of a run.
+type TriggerEvent struct {
+	// ID of the event, unique for the source of the events.
+	ID string
+	// Time at which the event happened.
+	Time time.Time
+	// Details of the event, substituted in the workflow parameters by
+	// [[TriggerEvent.<key>]].
+	Details map[string]string
+}
+
+// IsEventDriven returns true if the workflows are created by events rather than
+// by a schedule.
+func (s *ScheduledWorkflow) IsEventDriven() bool {
+	return s.Spec.Trigger.ObjectStoreTrigger != nil ||
+		s.Spec.Trigger.RunCompletionTrigger != nil
+}
+
+func (s *ScheduledWorkflow) pollIntervalSecond() int64 {
+	if s.Spec.Trigger.ObjectStoreTrigger != nil && s.Spec.Trigger.ObjectStoreTrigger.PollIntervalSecond > 0 {
+		return s.Spec.Trigger.ObjectStoreTrigger.PollIntervalSecond
+	}
+	return defaultPollIntervalSecond
+}
+
+// getNextPollEpoch returns the next epoch at which the source of the events
+// should be polled. Pending events are drained without waiting, since the
+// poll time is only recorded when a poll finds no new event.
+func (s *ScheduledWorkflow) getNextPollEpoch() int64 {
+	if s.Status.Trigger.LastPolledTime == nil {
+		return s.creationEpoch()
+	}
+	return s.Status.Trigger.LastPolledTime.Unix() + s.pollIntervalSecond()
+}
+
+// ValidateTrigger returns an error if the trigger can never select any event,
+// e.g. a RunCompletionTrigger whose IDs are not valid label values. Retrying
+// does not help: the ScheduledWorkflow stays invalid until its spec changes.
+func (s *ScheduledWorkflow) ValidateTrigger() error {
+	trigger := s.Spec.Trigger.RunCompletionTrigger
+	if trigger == nil {
+		return nil
+	}
+	if _, err := GetRequirementForExperiment(trigger.ExperimentID); err != nil {
+		return wraperror.Wrapf(err, "Invalid experiment ID (%v) in run completion trigger: %v",
+			trigger.ExperimentID, err)
+	}
+	if trigger.PipelineID == "" {
+		return nil
+	}
+	if _, err := GetRequirementForPipeline(trigger.PipelineID); err != nil {
+		return wraperror.Wrapf(err, "Invalid pipeline ID (%v) in run completion trigger: %v",
+			trigger.PipelineID, err)
+	}
+	return nil
+}
+
+// RunCompletionStates returns the final run states which create a workflow.
+func (s *ScheduledWorkflow) RunCompletionStates() []string {
+	if s.Spec.Trigger.RunCompletionTrigger == nil || len(s.Spec.Trigger.RunCompletionTrigger.States) == 0 {
+		return []string{RunStateSucceeded}
+	}
+	return s.Spec.Trigger.RunCompletionTrigger.States
+}
+
+// eventWatermarkEpoch returns the time of the most recent event processed.
+// Events older than the ScheduledWorkflow are ignored.
+func (s *ScheduledWorkflow) eventWatermarkEpoch() int64 {
+	if s.Status.Trigger.LastEventTime == nil {
+		return s.creationEpoch()
+	}
+	return s.Status.Trigger.LastEventTime.Unix()
+}
+
+func (s *ScheduledWorkflow) isEventProcessed(id string) bool {
+	for _, processed := range s.Status.Trigger.ProcessedEvents {
+		if processed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// NextTriggerEvent returns the oldest event which has not created a workflow yet,
+// or nil if there is none.
+func (s *ScheduledWorkflow) NextTriggerEvent(events []TriggerEvent) *TriggerEvent {
+	watermark := s.eventWatermarkEpoch()
+	pending := make([]TriggerEvent, 0)
+	for _, event := range events {
+		// The status only keeps the time of the events to the second.
+		epoch := event.Time.Unix()
+		if epoch < watermark || (epoch == watermark && s.isEventProcessed(event.ID)) {
+			continue
+		}
+		pending = append(pending, event)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].Time.Unix() != pending[j].Time.Unix() {
+			return pending[i].Time.Unix() < pending[j].Time.Unix()
+		}
+		return pending[i].ID < pending[j].ID
+	})
+	return &pending[0]
+}
+
+// RecordTriggerEvent records that an event created a workflow, so that it does
+// not create another one.
+func (s *ScheduledWorkflow) RecordTriggerEvent(event *TriggerEvent) {
+	epoch := event.Time.Unix()
+	if s.Status.Trigger.LastEventTime == nil || s.Status.Trigger.LastEventTime.Unix() != epoch {
+		s.Status.Trigger.ProcessedEvents = nil
+	}
+	s.Status.Trigger.LastEventTime = commonutil.Metav1TimePointer(
+		metav1.NewTime(time.Unix(epoch, 0).UTC()))
+	processed := append(s.Status.Trigger.ProcessedEvents, event.ID)
+	if len(processed) > maxProcessedEvents {
+		processed = processed[len(processed)-maxProcessedEvents:]
+	}
+	s.Status.Trigger.ProcessedEvents = processed
+}
+
+// RecordPoll records that the source of the events was polled without finding
+// any new event.
+func (s *ScheduledWorkflow) RecordPoll(nowEpoch int64) {
+	s.Status.Trigger.LastPolledTime = commonutil.Metav1TimePointer(
+		metav1.NewTime(time.Unix(nowEpoch, 0).UTC()))
+}
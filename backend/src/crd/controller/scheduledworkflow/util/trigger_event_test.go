@@ -0,0 +1,211 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newObjectStoreSchedule(status swfapi.TriggerStatus) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "SCHEDULE1",
+			CreationTimestamp: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				ObjectStoreTrigger: &swfapi.ObjectStoreTrigger{
+					URI:                "s3://bucket/data/",
+					PollIntervalSecond: 300,
+				},
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{Trigger: status},
+	})
+}
+
+func newTriggerEvent(id string, epoch int64) TriggerEvent {
+	return TriggerEvent{ID: id, Time: time.Unix(epoch, 0).UTC()}
+}
+
+func TestScheduledWorkflow_IsEventDriven(t *testing.T) {
+	schedule := newObjectStoreSchedule(swfapi.TriggerStatus{})
+	assert.True(t, schedule.IsEventDriven())
+	assert.False(t, schedule.isOneOffRun())
+
+	schedule = NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Trigger: swfapi.Trigger{
+				RunCompletionTrigger: &swfapi.RunCompletionTrigger{ExperimentID: "EXPERIMENT1"},
+			},
+		},
+	})
+	assert.True(t, schedule.IsEventDriven())
+	assert.Equal(t, []string{RunStateSucceeded}, schedule.RunCompletionStates())
+
+	schedule = NewScheduledWorkflow(&swfapi.ScheduledWorkflow{})
+	assert.False(t, schedule.IsEventDriven())
+}
+
+func TestScheduledWorkflow_ValidateTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *swfapi.RunCompletionTrigger
+		wantErr string
+	}{
+		{name: "no run completion trigger"},
+		{name: "valid", trigger: &swfapi.RunCompletionTrigger{ExperimentID: "EXPERIMENT1", PipelineID: "PIPELINE1"}},
+		{name: "invalid experiment", trigger: &swfapi.RunCompletionTrigger{ExperimentID: "EXPERIMENT 1"},
+			wantErr: "Invalid experiment ID"},
+		{name: "invalid pipeline", trigger: &swfapi.RunCompletionTrigger{ExperimentID: "EXPERIMENT1", PipelineID: "PIPELINE/1"},
+			wantErr: "Invalid pipeline ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+				Spec: swfapi.ScheduledWorkflowSpec{
+					Trigger: swfapi.Trigger{RunCompletionTrigger: tt.trigger},
+				},
+			})
+			err := schedule.ValidateTrigger()
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_EventDriven(t *testing.T) {
+	// Never polled: poll at creation.
+	schedule := newObjectStoreSchedule(swfapi.TriggerStatus{})
+	nextEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 11*hour, *time.UTC)
+	assert.Equal(t, int64(10*hour), nextEpoch)
+	assert.True(t, shouldRunNow)
+
+	// Polled recently: wait for the poll interval.
+	lastPolled := metav1.NewTime(time.Unix(11*hour, 0).UTC())
+	schedule = newObjectStoreSchedule(swfapi.TriggerStatus{LastPolledTime: &lastPolled})
+	nextEpoch, shouldRunNow = schedule.GetNextScheduledEpoch(0, 11*hour+100, *time.UTC)
+	assert.Equal(t, int64(11*hour+300), nextEpoch)
+	assert.False(t, shouldRunNow)
+
+	// Too many active workflows.
+	nextEpoch, shouldRunNow = schedule.GetNextScheduledEpoch(1, 12*hour, *time.UTC)
+	assert.Equal(t, int64(11*hour+300), nextEpoch)
+	assert.False(t, shouldRunNow)
+}
+
+func TestScheduledWorkflow_NextTriggerEvent(t *testing.T) {
+	// Events older than the schedule are ignored, the oldest new event comes first.
+	schedule := newObjectStoreSchedule(swfapi.TriggerStatus{})
+	events := []TriggerEvent{
+		newTriggerEvent("data/c.csv", 10*hour+20),
+		newTriggerEvent("data/old.csv", 9*hour),
+		newTriggerEvent("data/b.csv", 10*hour+10),
+		newTriggerEvent("data/a.csv", 10*hour+10),
+	}
+	assert.Equal(t, newTriggerEvent("data/a.csv", 10*hour+10), *schedule.NextTriggerEvent(events))
+
+	// Processed events are skipped, even if they share their time with a new event.
+	schedule.RecordTriggerEvent(schedule.NextTriggerEvent(events))
+	assert.Equal(t, newTriggerEvent("data/b.csv", 10*hour+10), *schedule.NextTriggerEvent(events))
+	schedule.RecordTriggerEvent(schedule.NextTriggerEvent(events))
+	assert.Equal(t, []string{"data/a.csv", "data/b.csv"}, schedule.Status.Trigger.ProcessedEvents)
+
+	schedule.RecordTriggerEvent(schedule.NextTriggerEvent(events))
+	assert.Equal(t, int64(10*hour+20), schedule.Status.Trigger.LastEventTime.Unix())
+	assert.Equal(t, []string{"data/c.csv"}, schedule.Status.Trigger.ProcessedEvents)
+	assert.Nil(t, schedule.NextTriggerEvent(events))
+}
+
+func TestScheduledWorkflow_RecordTriggerEvent_MaxProcessedEvents(t *testing.T) {
+	schedule := newObjectStoreSchedule(swfapi.TriggerStatus{})
+	for i := 0; i < maxProcessedEvents+5; i++ {
+		event := newTriggerEvent("data/"+strconv.Itoa(i), 11*hour)
+		schedule.RecordTriggerEvent(&event)
+	}
+	assert.Len(t, schedule.Status.Trigger.ProcessedEvents, maxProcessedEvents)
+	assert.Equal(t, "data/5", schedule.Status.Trigger.ProcessedEvents[0])
+}
+
+func TestScheduledWorkflow_UpdateStatus_EventDriven(t *testing.T) {
+	location := time.UTC
+
+	// A poll without new event delays the next poll.
+	schedule := newObjectStoreSchedule(swfapi.TriggerStatus{})
+	schedule.RecordPoll(11 * hour)
+	schedule.UpdateStatus(11*hour, false, 10*hour, nil, nil, location)
+	assert.Equal(t, int64(11*hour+300), schedule.Status.Trigger.NextTriggeredTime.Unix())
+	assert.Nil(t, schedule.Status.Trigger.LastTriggeredTime)
+	assert.Equal(t, swfapi.ScheduledWorkflowEnabled, schedule.Status.Conditions[0].Type)
+
+	// A workflow was triggered by an event.
+	event := newTriggerEvent("data/a.csv", 11*hour+10)
+	schedule.RecordTriggerEvent(&event)
+	schedule.UpdateStatus(11*hour+60, true, 11*hour+300, nil, nil, location)
+	assert.Equal(t, int64(11*hour+60), schedule.Status.Trigger.LastTriggeredTime.Unix())
+	assert.Equal(t, int64(1), *schedule.Status.Trigger.LastIndex)
+	assert.Equal(t, int64(11*hour+10), schedule.Status.Trigger.LastEventTime.Unix())
+	assert.Equal(t, []string{"data/a.csv"}, schedule.Status.Trigger.ProcessedEvents)
+}
+
+func TestScheduledWorkflow_NewEventWorkflow(t *testing.T) {
+	spec, err := json.Marshal(workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{
+			Arguments: workflowapi.Arguments{
+				Parameters: []workflowapi.Parameter{
+					{Name: "PARAM1", Value: workflowapi.AnyStringPtr("VALUE1")},
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	schedule := newObjectStoreSchedule(swfapi.TriggerStatus{})
+	schedule.uuid = commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)
+	schedule.Spec.Workflow = &swfapi.WorkflowResource{
+		Parameters: []swfapi.Parameter{
+			{Name: "PARAM1", Value: "[[TriggerEvent.uri]]@[[ScheduledTime]]"},
+		},
+		Spec: string(spec),
+	}
+
+	event := &TriggerEvent{
+		ID:      "data/a.csv",
+		Time:    time.Unix(11*hour, 0).UTC(),
+		Details: map[string]string{"uri": "s3://bucket/data/a.csv"},
+	}
+	result, err := schedule.NewEventWorkflow(event, 12*hour)
+	assert.Nil(t, err)
+
+	workflow := result.(*commonutil.Workflow).Get()
+	assert.Equal(t, []workflowapi.Parameter{
+		{Name: "PARAM1", Value: workflowapi.AnyStringPtr("s3://bucket/data/a.csv@19700101110000")},
+	}, workflow.Spec.Arguments.Parameters)
+	assert.Equal(t, strconv.Itoa(11*hour), workflow.Labels[commonutil.LabelKeyWorkflowEpoch])
+}
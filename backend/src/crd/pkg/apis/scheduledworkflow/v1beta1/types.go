@@ -62,6 +62,12 @@ type ScheduledWorkflowSpec struct {
 	// +optional
 	NoCatchup *bool `json:"noCatchup,omitempty"`
 
+	// Specifies what to do when a workflow is due while MaxConcurrency
+	// workflows are running.
+	// ConcurrencyPolicy defaults to Allow if not specified.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
 	// Max number of completed workflows to keep track of.
 	// If MaxHistory is not specified, MaxHistory is 10.
 	// MaxHistory cannot be smaller than 0.
@@ -72,14 +78,59 @@ type ScheduledWorkflowSpec struct {
 	// Trigger describes when to create a new workflow.
 	Trigger `json:"trigger,omitempty"`
 
+	// Specifies when the schedule is disabled because its workflows keep
+	// failing.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
 	// Specification of the workflow to schedule.
 	// +optional
 	Workflow *WorkflowResource `json:"workflow,omitempty"`
 
+	// If UseLatestPipelineVersion is true, the controller asks the API server
+	// for the workflow of each run, so that the run uses the latest version of
+	// the pipeline instead of Workflow.
+	// +optional
+	UseLatestPipelineVersion bool `json:"useLatestPipelineVersion,omitempty"`
+
 	// TODO: support additional resource types: K8 jobs, etc.
 
 }
 
+// ConcurrencyPolicy describes how the workflows of a ScheduledWorkflow are
+// handled when MaxConcurrency workflows are running.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent waits for a running workflow to complete. If NoCatchup is
+	// true, only the latest workflow due in the meantime is created.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent skips the workflow and records it in the status.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+
+	// ReplaceConcurrent terminates the oldest running workflow and creates the
+	// new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+
+	// QueueConcurrent defers the workflow until a running workflow completes.
+	// The deferred workflows are created one after the other, even if NoCatchup
+	// is true.
+	QueueConcurrent ConcurrencyPolicy = "Queue"
+)
+
+// FailurePolicy disables a ScheduledWorkflow after consecutive failed workflows.
+type FailurePolicy struct {
+	// Number of consecutive failed workflows after which the schedule is
+	// disabled. If MaxConsecutiveFailures is 0, the schedule is never disabled.
+	// +optional
+	MaxConsecutiveFailures int64 `json:"maxConsecutiveFailures,omitempty"`
+
+	// URL to which a notification is POSTed when the schedule is disabled.
+	// +optional
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
+
 type WorkflowResource struct {
 	// List of parameters to substitute in the workflow template.
 	// The parameter values may include special strings that the controller will substitute:
@@ -88,6 +139,9 @@ type WorkflowResource struct {
 	// [[Index]] is substituted by the index of the workflow (e.g. 3 means that it was the 3rd workflow created)
 	// [[ScheduledTime.15-04-05]] is substituted by the sheduled time (custom format specified as a Go time format: https://golang.org/pkg/time/#Parse)
 	// [[CurrentTime.15-04-05]] is substituted by the current time (custom format specified as a Go time format: https://golang.org/pkg/time/#Parse)
+	// The parameter values may also include parameter macros with offsets and formats, such as
+	// {{scheduled_time - 24h | date "2006-01-02"}}, {{window_start | unix}} or {{index}}.
+	// See backend/src/common/util/parameter_macro.go for the full syntax.
 
 	Parameters []Parameter `json:"parameters,omitempty"`
 
@@ -116,6 +170,12 @@ type Trigger struct {
 
 	// Create workflows periodically.
 	PeriodicSchedule *PeriodicSchedule `json:"periodicSchedule,omitempty"`
+
+	// Create a workflow for each new object stored under a prefix.
+	ObjectStoreTrigger *ObjectStoreTrigger `json:"objectStoreTrigger,omitempty"`
+
+	// Create a workflow for each completed run of another pipeline.
+	RunCompletionTrigger *RunCompletionTrigger `json:"runCompletionTrigger,omitempty"`
 }
 
 type CronSchedule struct {
@@ -133,6 +193,12 @@ type CronSchedule struct {
 	// time interval defined by StartTime and EndTime.
 	// +optional
 	Cron string `json:"cron,omitempty"`
+
+	// IANA time zone (e.g. "Europe/Paris") in which the cron string is
+	// evaluated. If no time zone is specified, the time zone of the
+	// controller (CRON_SCHEDULE_TIMEZONE) is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type PeriodicSchedule struct {
@@ -152,6 +218,35 @@ type PeriodicSchedule struct {
 	IntervalSecond int64 `json:"intervalSecond,omitempty"`
 }
 
+type ObjectStoreTrigger struct {
+	// URI of the prefix to watch, e.g. s3://bucket/path/to/data/.
+	// The credentials of the bucket are read from a Secret of the namespace,
+	// configured in the kfp-launcher ConfigMap of the namespace. The namespace
+	// must grant the controller get access to the Secret with a Role.
+	URI string `json:"uri,omitempty"`
+
+	// Number of seconds between two listings of the prefix.
+	// If PollIntervalSecond is not specified, PollIntervalSecond is 60.
+	// +optional
+	PollIntervalSecond int64 `json:"pollIntervalSecond,omitempty"`
+}
+
+type RunCompletionTrigger struct {
+	// ID of the experiment whose runs are watched.
+	ExperimentID string `json:"experimentId,omitempty"`
+
+	// ID of the pipeline whose runs are watched.
+	// If no pipeline is specified, the runs of any pipeline in the experiment
+	// create a workflow.
+	// +optional
+	PipelineID string `json:"pipelineId,omitempty"`
+
+	// Final states of the runs which create a workflow, e.g. SUCCEEDED or FAILED.
+	// If no state is specified, only SUCCEEDED runs create a workflow.
+	// +optional
+	States []string `json:"states,omitempty"`
+}
+
 // ScheduledWorkflowStatus is the status for a ScheduledWorkflow resource.
 type ScheduledWorkflowStatus struct {
 
@@ -164,6 +259,15 @@ type ScheduledWorkflowStatus struct {
 
 	// Status of workflow resources.
 	WorkflowHistory *WorkflowHistory `json:"workflowHistory,omitempty"`
+
+	// Number of consecutive failed workflows. Reset to 0 when a workflow
+	// succeeds.
+	// +optional
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty"`
+
+	// Index of the last completed workflow counted in ConsecutiveFailures.
+	// +optional
+	LastCountedIndex int64 `json:"lastCountedIndex,omitempty"`
 }
 
 type ScheduledWorkflowConditionType string
@@ -205,6 +309,34 @@ type TriggerStatus struct {
 
 	// Index of the last workflow created.
 	LastIndex *int64 `json:"lastWorkflowIndex,omitempty"`
+
+	// Time of the last poll of the source of an event-driven trigger.
+	// +optional
+	LastPolledTime *metav1.Time `json:"lastPolledTime,omitempty"`
+
+	// Time of the last event which created a workflow. Events older than
+	// LastEventTime are ignored.
+	// +optional
+	LastEventTime *metav1.Time `json:"lastEventTime,omitempty"`
+
+	// IDs of the most recent events which created a workflow, used to avoid
+	// creating two workflows for events which share the same time.
+	// +optional
+	ProcessedEvents []string `json:"processedEvents,omitempty"`
+
+	// Scheduled time of the last workflow skipped by the Forbid concurrency
+	// policy.
+	// +optional
+	LastSkippedTime *metav1.Time `json:"lastSkippedTime,omitempty"`
+
+	// Number of workflows skipped by the Forbid concurrency policy.
+	// +optional
+	SkippedCount int64 `json:"skippedCount,omitempty"`
+
+	// Time at which the schedule last changed. The changed schedule starts
+	// after this time, without catching up on the times before it.
+	// +optional
+	ScheduleStartTime *metav1.Time `json:"scheduleStartTime,omitempty"`
 }
 
 type WorkflowHistory struct {
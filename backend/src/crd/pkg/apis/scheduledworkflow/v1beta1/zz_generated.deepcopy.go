@@ -46,6 +46,38 @@ func (in *CronSchedule) DeepCopy() *CronSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicy.
+func (in *FailurePolicy) DeepCopy() *FailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreTrigger) DeepCopyInto(out *ObjectStoreTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreTrigger.
+func (in *ObjectStoreTrigger) DeepCopy() *ObjectStoreTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
@@ -86,6 +118,27 @@ func (in *PeriodicSchedule) DeepCopy() *PeriodicSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunCompletionTrigger) DeepCopyInto(out *RunCompletionTrigger) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunCompletionTrigger.
+func (in *RunCompletionTrigger) DeepCopy() *RunCompletionTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RunCompletionTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledWorkflow) DeepCopyInto(out *ScheduledWorkflow) {
 	*out = *in
@@ -184,6 +237,11 @@ func (in *ScheduledWorkflowSpec) DeepCopyInto(out *ScheduledWorkflowSpec) {
 		**out = **in
 	}
 	in.Trigger.DeepCopyInto(&out.Trigger)
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		**out = **in
+	}
 	if in.Workflow != nil {
 		in, out := &in.Workflow, &out.Workflow
 		*out = new(WorkflowResource)
@@ -244,6 +302,16 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(PeriodicSchedule)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ObjectStoreTrigger != nil {
+		in, out := &in.ObjectStoreTrigger, &out.ObjectStoreTrigger
+		*out = new(ObjectStoreTrigger)
+		**out = **in
+	}
+	if in.RunCompletionTrigger != nil {
+		in, out := &in.RunCompletionTrigger, &out.RunCompletionTrigger
+		*out = new(RunCompletionTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -273,6 +341,27 @@ func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.LastPolledTime != nil {
+		in, out := &in.LastPolledTime, &out.LastPolledTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastEventTime != nil {
+		in, out := &in.LastEventTime, &out.LastEventTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ProcessedEvents != nil {
+		in, out := &in.ProcessedEvents, &out.ProcessedEvents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSkippedTime != nil {
+		in, out := &in.LastSkippedTime, &out.LastSkippedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ScheduleStartTime != nil {
+		in, out := &in.ScheduleStartTime, &out.ScheduleStartTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/v2/component"
 	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 )
 
 // TODO: use https://github.com/spf13/cobra as a framework to create more complex CLI tools with subcommands.
@@ -41,6 +42,15 @@ var (
 	podUID            = flag.String("pod_uid", "", "Kubernetes Pod UID.")
 	mlmdServerAddress = flag.String("mlmd_server_address", "", "The MLMD gRPC server address.")
 	mlmdServerPort    = flag.String("mlmd_server_port", "8080", "The MLMD gRPC server port.")
+
+	// Artifact transfer flags.
+	transferParallelism    = flag.Int("transfer_parallelism", objectstore.DefaultTransferOptions().Parallelism, "Maximum number of files uploaded or downloaded concurrently.")
+	transferMaxRetries     = flag.Int("transfer_max_retries", objectstore.DefaultTransferOptions().MaxRetries, "Number of retries for a failed file transfer.")
+	transferInitialBackoff = flag.Duration("transfer_initial_backoff", objectstore.DefaultTransferOptions().InitialBackoff, "Wait time before the first retry of a failed file transfer.")
+	transferMaxBackoff     = flag.Duration("transfer_max_backoff", objectstore.DefaultTransferOptions().MaxBackoff, "Maximum wait time between retries of a failed file transfer.")
+	transferPartSize       = flag.Int("transfer_part_size", objectstore.DefaultTransferOptions().PartSize, "Chunk size in bytes for multipart uploads, 0 uses the object store default.")
+	transferVerifyChecksum = flag.Bool("transfer_verify_checksum", objectstore.DefaultTransferOptions().VerifyChecksum, "Verify MD5/CRC32C checksums of transferred files.")
+	transferResume         = flag.Bool("transfer_resume", objectstore.DefaultTransferOptions().Resume, "Skip files already present at the destination with a matching checksum.")
 )
 
 func main() {
@@ -72,6 +82,16 @@ func run() error {
 		MLMDServerPort:    *mlmdServerPort,
 		PipelineName:      *pipelineName,
 		RunID:             *runID,
+		TransferOptions: &objectstore.TransferOptions{
+			Parallelism:      *transferParallelism,
+			MaxRetries:       *transferMaxRetries,
+			InitialBackoff:   *transferInitialBackoff,
+			MaxBackoff:       *transferMaxBackoff,
+			PartSize:         *transferPartSize,
+			VerifyChecksum:   *transferVerifyChecksum,
+			Resume:           *transferResume,
+			ProgressInterval: objectstore.DefaultTransferOptions().ProgressInterval,
+		},
 	}
 
 	switch *executorType {
@@ -34,6 +34,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
 
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/config"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
@@ -52,6 +53,9 @@ type LauncherV2Options struct {
 	MLMDServerPort,
 	PipelineName,
 	RunID string
+	// TransferOptions configures artifact uploads and downloads. Defaults are
+	// used when nil.
+	TransferOptions *objectstore.TransferOptions
 }
 
 type LauncherV2 struct {
@@ -156,7 +160,20 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 		return err
 	}
 	fingerPrint := execution.FingerPrint()
-	bucketConfig, err := objectstore.ParseBucketConfig(execution.GetPipeline().GetPipelineRoot())
+	pipelineRoot := execution.GetPipeline().GetPipelineRoot()
+	bucketConfig, err := objectstore.ParseBucketConfig(pipelineRoot)
+	if err != nil {
+		return err
+	}
+	launcherConfig, err := config.FromConfigMap(ctx, l.k8sClient, l.options.Namespace)
+	if err != nil {
+		return err
+	}
+	providers, err := launcherConfig.BucketProviders()
+	if err != nil {
+		return err
+	}
+	bucketConfig.Settings, err = providers.SettingsFor(pipelineRoot)
 	if err != nil {
 		return err
 	}
@@ -167,7 +184,7 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 	if err = prepareOutputFolders(l.executorInput); err != nil {
 		return err
 	}
-	executorOutput, outputArtifacts, err = executeV2(ctx, l.executorInput, l.component, l.command, l.args, bucket, bucketConfig, l.metadataClient, l.options.Namespace, l.k8sClient)
+	executorOutput, outputArtifacts, err = executeV2(ctx, l.executorInput, l.component, l.command, l.args, bucket, bucketConfig, providers, l.metadataClient, l.options.Namespace, l.k8sClient, l.options.transferOptions())
 	if err != nil {
 		return err
 	}
@@ -223,9 +240,21 @@ func (o *LauncherV2Options) validate() error {
 	if empty(o.MLMDServerPort) {
 		return err("MLMDServerPort")
 	}
+	if o.TransferOptions != nil {
+		if e := o.TransferOptions.Validate(); e != nil {
+			return fmt.Errorf("invalid launcher options: %w", e)
+		}
+	}
 	return nil
 }
 
+func (o *LauncherV2Options) transferOptions() *objectstore.TransferOptions {
+	if o.TransferOptions == nil {
+		return objectstore.DefaultTransferOptions()
+	}
+	return o.TransferOptions
+}
+
 // publish pod info to MLMD, before running user command
 func (l *LauncherV2) prePublish(ctx context.Context) (execution *metadata.Execution, err error) {
 	defer func() {
@@ -274,9 +303,11 @@ func executeV2(
 	args []string,
 	bucket *blob.Bucket,
 	bucketConfig *objectstore.Config,
+	providers objectstore.ProvidersConfig,
 	metadataClient metadata.ClientInterface,
 	namespace string,
 	k8sClient kubernetes.Interface,
+	transferOpts *objectstore.TransferOptions,
 ) (*pipelinespec.ExecutorOutput, []*metadata.OutputArtifact, error) {
 
 	// Add parameter default values to executorInput, if there is not already a user input.
@@ -303,7 +334,7 @@ func executeV2(
 		args[i] = arg
 	}
 
-	executorOutput, err := execute(ctx, executorInput, cmd, args, bucket, bucketConfig, namespace, k8sClient)
+	executorOutput, err := execute(ctx, executorInput, cmd, args, bucket, bucketConfig, providers, namespace, k8sClient, transferOpts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -319,6 +350,7 @@ func executeV2(
 		bucketConfig:   bucketConfig,
 		bucket:         bucket,
 		metadataClient: metadataClient,
+		transferOpts:   transferOpts,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -381,10 +413,12 @@ func execute(
 	args []string,
 	bucket *blob.Bucket,
 	bucketConfig *objectstore.Config,
+	providers objectstore.ProvidersConfig,
 	namespace string,
 	k8sClient kubernetes.Interface,
+	transferOpts *objectstore.TransferOptions,
 ) (*pipelinespec.ExecutorOutput, error) {
-	if err := downloadArtifacts(ctx, executorInput, bucket, bucketConfig, namespace, k8sClient); err != nil {
+	if err := downloadArtifacts(ctx, executorInput, bucket, bucketConfig, providers, namespace, k8sClient, transferOpts); err != nil {
 		return nil, err
 	}
 	if err := prepareOutputFolders(executorInput); err != nil {
@@ -409,6 +443,7 @@ type uploadOutputArtifactsOptions struct {
 	bucketConfig   *objectstore.Config
 	bucket         *blob.Bucket
 	metadataClient metadata.ClientInterface
+	transferOpts   *objectstore.TransferOptions
 }
 
 func uploadOutputArtifacts(ctx context.Context, executorInput *pipelinespec.ExecutorInput, executorOutput *pipelinespec.ExecutorOutput, opts uploadOutputArtifactsOptions) ([]*metadata.OutputArtifact, error) {
@@ -435,7 +470,7 @@ func uploadOutputArtifacts(ctx context.Context, executorInput *pipelinespec.Exec
 			if err != nil {
 				return nil, fmt.Errorf("failed to upload output artifact %q: %w", name, err)
 			}
-			if err := objectstore.UploadBlob(ctx, opts.bucket, localDir, blobKey); err != nil {
+			if err := objectstore.UploadBlobWithOptions(ctx, opts.bucket, localDir, blobKey, opts.transferOpts); err != nil {
 				//  We allow components to not produce output files
 				if errors.Is(err, os.ErrNotExist) {
 					glog.Warningf("Local filepath %q does not exist", localDir)
@@ -463,13 +498,13 @@ func uploadOutputArtifacts(ctx context.Context, executorInput *pipelinespec.Exec
 	return outputArtifacts, nil
 }
 
-func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.ExecutorInput, defaultBucket *blob.Bucket, defaultBucketConfig *objectstore.Config, namespace string, k8sClient kubernetes.Interface) error {
+func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.ExecutorInput, defaultBucket *blob.Bucket, defaultBucketConfig *objectstore.Config, providers objectstore.ProvidersConfig, namespace string, k8sClient kubernetes.Interface, transferOpts *objectstore.TransferOptions) error {
 	// Read input artifact metadata.
-	nonDefaultBuckets, err := fetchNonDefaultBuckets(ctx, executorInput.GetInputs().GetArtifacts(), defaultBucketConfig, namespace, k8sClient)
-	closeNonDefaultBuckets := func(buckets map[string]*blob.Bucket) {
-		for name, bucket := range nonDefaultBuckets {
-			if closeBucketErr := bucket.Close(); closeBucketErr != nil {
-				glog.Warningf("failed to close bucket %q: %q", name, err.Error())
+	nonDefaultBuckets, err := fetchNonDefaultBuckets(ctx, executorInput.GetInputs().GetArtifacts(), defaultBucketConfig, providers, namespace, k8sClient)
+	closeNonDefaultBuckets := func(buckets map[string]*nonDefaultBucket) {
+		for name, bucket := range buckets {
+			if closeBucketErr := bucket.bucket.Close(); closeBucketErr != nil {
+				glog.Warningf("failed to close bucket of input artifact %q: %q", name, closeBucketErr.Error())
 			}
 		}
 	}
@@ -497,22 +532,18 @@ func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.Executor
 		bucket := defaultBucket
 		bucketConfig := defaultBucketConfig
 		if !strings.HasPrefix(inputArtifact.Uri, defaultBucketConfig.PrefixedBucket()) {
-			nonDefaultBucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(inputArtifact.Uri)
-			if err != nil {
-				return fmt.Errorf("failed to parse bucketConfig for output artifact %q with uri %q: %w", name, inputArtifact.GetUri(), err)
-			}
-			nonDefaultBucket, ok := nonDefaultBuckets[nonDefaultBucketConfig.PrefixedBucket()]
+			nonDefaultBucket, ok := nonDefaultBuckets[name]
 			if !ok {
-				return fmt.Errorf("failed to get bucket when downloading input artifact %s with bucket key %s: %w", name, nonDefaultBucketConfig.PrefixedBucket(), err)
+				return fmt.Errorf("failed to get bucket when downloading input artifact %s with uri %s", name, inputArtifact.Uri)
 			}
-			bucket = nonDefaultBucket
-			bucketConfig = nonDefaultBucketConfig
+			bucket = nonDefaultBucket.bucket
+			bucketConfig = nonDefaultBucket.config
 		}
 		blobKey, err := bucketConfig.KeyFromURI(inputArtifact.Uri)
 		if err != nil {
 			return copyErr(err)
 		}
-		if err := objectstore.DownloadBlob(ctx, bucket, localPath, blobKey); err != nil {
+		if err := objectstore.DownloadBlobWithOptions(ctx, bucket, localPath, blobKey, transferOpts); err != nil {
 			return copyErr(err)
 		}
 
@@ -520,14 +551,24 @@ func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.Executor
 	return nil
 }
 
+// nonDefaultBucket is a bucket opened for an input artifact outside of the default bucket.
+type nonDefaultBucket struct {
+	bucket *blob.Bucket
+	config *objectstore.Config
+}
+
+// fetchNonDefaultBuckets opens the buckets of the input artifacts outside of the
+// default bucket, by input name. The settings of a bucket depend on the key prefix
+// of the artifact, so each artifact opens the bucket with its own settings.
 func fetchNonDefaultBuckets(
 	ctx context.Context,
 	artifacts map[string]*pipelinespec.ArtifactList,
 	defaultBucketConfig *objectstore.Config,
+	providers objectstore.ProvidersConfig,
 	namespace string,
 	k8sClient kubernetes.Interface,
-) (buckets map[string]*blob.Bucket, err error) {
-	nonDefaultBuckets := make(map[string]*blob.Bucket)
+) (buckets map[string]*nonDefaultBucket, err error) {
+	nonDefaultBuckets := make(map[string]*nonDefaultBucket)
 	for name, artifactList := range artifacts {
 		if len(artifactList.Artifacts) == 0 {
 			continue
@@ -539,11 +580,15 @@ func fetchNonDefaultBuckets(
 			if err != nil {
 				return nonDefaultBuckets, fmt.Errorf("failed to parse bucketConfig for output artifact %q with uri %q: %w", name, artifact.GetUri(), err)
 			}
-			nonDefaultBucket, err := objectstore.OpenBucket(ctx, k8sClient, namespace, nonDefaultBucketConfig)
+			nonDefaultBucketConfig.Settings, err = providers.SettingsFor(artifact.Uri)
+			if err != nil {
+				return nonDefaultBuckets, fmt.Errorf("failed to get object store settings for output artifact %q with uri %q: %w", name, artifact.GetUri(), err)
+			}
+			bucket, err := objectstore.OpenBucket(ctx, k8sClient, namespace, nonDefaultBucketConfig)
 			if err != nil {
 				return nonDefaultBuckets, fmt.Errorf("failed to open bucket for output artifact %q with uri %q: %w", name, artifact.GetUri(), err)
 			}
-			nonDefaultBuckets[nonDefaultBucketConfig.PrefixedBucket()] = nonDefaultBucket
+			nonDefaultBuckets[name] = &nonDefaultBucket{bucket: bucket, config: nonDefaultBucketConfig}
 		}
 
 	}
@@ -698,17 +743,27 @@ func getExecutorOutputFile(path string) (*pipelinespec.ExecutorOutput, error) {
 	return executorOutput, nil
 }
 
+// localRootForScheme is the local directory artifacts of a storage scheme are
+// staged in. Other schemes supported by objectstore use "/<scheme>/", e.g.
+// file:///mnt/pvc/a is staged in /file/mnt/pvc/a and azblob://container/a in
+// /azblob/container/a.
+var localRootForScheme = map[string]string{
+	"gs":    "/gcs/",
+	"minio": "/minio/",
+	"s3":    "/s3/",
+}
+
 func localPathForURI(uri string) (string, error) {
-	if strings.HasPrefix(uri, "gs://") {
-		return "/gcs/" + strings.TrimPrefix(uri, "gs://"), nil
-	}
-	if strings.HasPrefix(uri, "minio://") {
-		return "/minio/" + strings.TrimPrefix(uri, "minio://"), nil
+	scheme := objectstore.SchemeName(uri)
+	if !objectstore.IsSupportedScheme(scheme) {
+		return "", fmt.Errorf("failed to generate local path for URI %s: unsupported storage scheme", uri)
 	}
-	if strings.HasPrefix(uri, "s3://") {
-		return "/s3/" + strings.TrimPrefix(uri, "s3://"), nil
+	root, ok := localRootForScheme[scheme]
+	if !ok {
+		root = "/" + scheme + "/"
 	}
-	return "", fmt.Errorf("failed to generate local path for URI %s: unsupported storage scheme", uri)
+	// Strip the extra slash of absolute paths like file:///mnt/pvc.
+	return root + strings.TrimLeft(strings.TrimPrefix(uri, scheme+"://"), "/"), nil
 }
 
 func prepareOutputFolders(executorInput *pipelinespec.ExecutorInput) error {
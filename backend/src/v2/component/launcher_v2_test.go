@@ -15,14 +15,23 @@ package component
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gocloud.dev/blob"
 	"google.golang.org/protobuf/types/known/structpb"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -79,7 +88,7 @@ func Test_executeV2_Parameters(t *testing.T) {
 			assert.Nil(t, err)
 			bucketConfig, err := objectstore.ParseBucketConfig("gs://test-bucket/pipeline-root/")
 			assert.Nil(t, err)
-			_, _, err = executeV2(context.Background(), test.executorInput, addNumbersComponent, "sh", test.executorArgs, bucket, bucketConfig, fakeMetadataClient, "namespace", fakeKubernetesClientset)
+			_, _, err = executeV2(context.Background(), test.executorInput, addNumbersComponent, "sh", test.executorArgs, bucket, bucketConfig, nil, fakeMetadataClient, "namespace", fakeKubernetesClientset, objectstore.DefaultTransferOptions())
 
 			if test.wantErr {
 				assert.NotNil(t, err)
@@ -90,3 +99,110 @@ func Test_executeV2_Parameters(t *testing.T) {
 		})
 	}
 }
+
+func Test_localPathForURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{uri: "gs://my-bucket/root/run/output", want: "/gcs/my-bucket/root/run/output"},
+		{uri: "minio://mlpipeline/v2/artifacts/a", want: "/minio/mlpipeline/v2/artifacts/a"},
+		{uri: "s3://my-bucket/a/b", want: "/s3/my-bucket/a/b"},
+		{uri: "file:///mnt/pvc/root/a", want: "/file/mnt/pvc/root/a"},
+		{uri: "azblob://my-container/a/b", want: "/azblob/my-container/a/b"},
+		{uri: "hdfs://namenode/a", wantErr: true},
+		{uri: "/mnt/pvc/root/a", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.uri, func(t *testing.T) {
+			got, err := localPathForURI(test.uri)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+// Tests that artifacts under different key prefixes of the same bucket are read
+// with the settings of their own prefix.
+func Test_fetchNonDefaultBuckets_KeyPrefixes(t *testing.T) {
+	// The fake S3 server only serves each prefix to the access key of its team.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, team := range []string{"team-a", "team-b"} {
+			if strings.HasPrefix(r.URL.Path, "/shared/"+team+"/") {
+				if !strings.Contains(r.Header.Get("Authorization"), "Credential="+team+"-key/") {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				fmt.Fprint(w, team)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.Nil(t, err)
+
+	providers, err := objectstore.ParseProvidersConfig(fmt.Sprintf(`
+s3:
+  default:
+    region: us-east-1
+    endpoint: %[1]s
+    disableSSL: true
+    forcePathStyle: true
+  overrides:
+  - bucketName: shared
+    keyPrefix: team-a/
+    endpoint: %[1]s
+    disableSSL: true
+    forcePathStyle: true
+    credentials:
+      secretRef:
+        secretName: team-a-s3
+  - bucketName: shared
+    keyPrefix: team-b/
+    endpoint: %[1]s
+    disableSSL: true
+    forcePathStyle: true
+    credentials:
+      secretRef:
+        secretName: team-b-s3
+`, serverURL.Host))
+	require.Nil(t, err)
+	var secrets []runtime.Object
+	for _, team := range []string{"team-a", "team-b"} {
+		secrets = append(secrets, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: team + "-s3", Namespace: "namespace"},
+			Data: map[string][]byte{
+				"accesskey": []byte(team + "-key"),
+				"secretkey": []byte(team + "-secret"),
+			},
+		})
+	}
+	defaultBucketConfig, err := objectstore.ParseBucketConfig("gs://test-bucket/pipeline-root/")
+	require.Nil(t, err)
+	artifacts := map[string]*pipelinespec.ArtifactList{
+		"a": {Artifacts: []*pipelinespec.RuntimeArtifact{{Uri: "s3://shared/team-a/model"}}},
+		"b": {Artifacts: []*pipelinespec.RuntimeArtifact{{Uri: "s3://shared/team-b/model"}}},
+	}
+
+	ctx := context.Background()
+	buckets, err := fetchNonDefaultBuckets(ctx, artifacts, defaultBucketConfig, providers, "namespace", fake.NewSimpleClientset(secrets...))
+	require.Nil(t, err)
+	require.Len(t, buckets, 2)
+	for name, team := range map[string]string{"a": "team-a", "b": "team-b"} {
+		bucket := buckets[name]
+		require.NotNil(t, bucket, name)
+		key, err := bucket.config.KeyFromURI(artifacts[name].Artifacts[0].Uri)
+		require.Nil(t, err)
+		data, err := bucket.bucket.ReadAll(ctx, key)
+		assert.Nil(t, err, name)
+		assert.Equal(t, team, string(data))
+		assert.Nil(t, bucket.bucket.Close())
+	}
+}
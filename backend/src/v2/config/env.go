@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -32,6 +33,7 @@ const (
 	configMapName                = "kfp-launcher"
 	defaultPipelineRoot          = "minio://mlpipeline/v2/artifacts"
 	configKeyDefaultPipelineRoot = "defaultPipelineRoot"
+	configKeyProviders           = "providers"
 )
 
 // Config is the KFP runtime configuration.
@@ -62,6 +64,16 @@ func (c *Config) DefaultPipelineRoot() string {
 	return c.data[configKeyDefaultPipelineRoot]
 }
 
+// Config.BucketProviders gets the configured object store providers, which
+// control the endpoint and credentials used to access each pipeline root.
+func (c *Config) BucketProviders() (objectstore.ProvidersConfig, error) {
+	// The key providers is optional in launcher config.
+	if c == nil || c.data[configKeyProviders] == "" {
+		return nil, nil
+	}
+	return objectstore.ParseProvidersConfig(c.data[configKeyProviders])
+}
+
 // InPodNamespace gets current namespace from inside a Kubernetes Pod.
 func InPodNamespace() (string, error) {
 	// The path is available in Pods.
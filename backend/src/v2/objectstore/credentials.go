@@ -0,0 +1,390 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/gcsblob"
+	"gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcp"
+	"golang.org/x/oauth2/google"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// ProvidersConfig configures how buckets are accessed, keyed by storage scheme
+// without "://" (e.g. "s3", "minio", "gs"). It is read from the "providers" key
+// of the kfp-launcher ConfigMap, for example:
+//
+//	s3:
+//	  default:
+//	    region: us-east-1
+//	  overrides:
+//	  - bucketName: team-a
+//	    keyPrefix: pipelines/
+//	    endpoint: s3.internal.example.com
+//	    forcePathStyle: true
+//	    credentials:
+//	      secretRef:
+//	        secretName: team-a-s3
+//	  - bucketName: team-b
+//	    credentials:
+//	      webIdentity:
+//	        roleARN: arn:aws:iam::123456789012:role/team-b
+type ProvidersConfig map[string]*ProviderConfig
+
+// ProviderConfig holds the settings of one storage scheme.
+type ProviderConfig struct {
+	// Default applies to every bucket of the scheme without a matching override.
+	Default *BucketSettings `json:"default,omitempty"`
+	// Overrides apply to a bucket, optionally restricted to a key prefix. The
+	// override with the longest matching key prefix wins.
+	Overrides []BucketOverride `json:"overrides,omitempty"`
+}
+
+// BucketSettings describes how to connect and authenticate to a bucket.
+type BucketSettings struct {
+	// Endpoint of an S3 compatible object store, e.g. "minio-service.kubeflow:9000".
+	Endpoint string `json:"endpoint,omitempty"`
+	Region   string `json:"region,omitempty"`
+	// DisableSSL uses plain HTTP to talk to Endpoint.
+	DisableSSL *bool `json:"disableSSL,omitempty"`
+	// ForcePathStyle addresses buckets as endpoint/bucket instead of bucket.endpoint.
+	ForcePathStyle *bool              `json:"forcePathStyle,omitempty"`
+	Credentials    *CredentialsConfig `json:"credentials,omitempty"`
+}
+
+// BucketOverride are settings for a specific bucket and key prefix.
+type BucketOverride struct {
+	BucketName string `json:"bucketName"`
+	KeyPrefix  string `json:"keyPrefix,omitempty"`
+	BucketSettings
+}
+
+// CredentialsConfig selects a credential provider. At most one of the fields may be set,
+// when none is set the ambient credentials of the Pod are used.
+type CredentialsConfig struct {
+	// FromEnv uses the default credential chain of the SDK (environment
+	// variables, workload identity, instance metadata).
+	FromEnv bool `json:"fromEnv,omitempty"`
+	// SecretRef reads static credentials from a Secret in the run's namespace.
+	SecretRef *SecretRef `json:"secretRef,omitempty"`
+	// WebIdentity assumes an AWS IAM role with a projected service account token (IRSA).
+	WebIdentity *WebIdentityConfig `json:"webIdentity,omitempty"`
+}
+
+// SecretRef points at a Secret holding object store credentials.
+type SecretRef struct {
+	SecretName string `json:"secretName"`
+	// AccessKeyKey and SecretKeyKey are the keys of the S3 access key pair,
+	// they default to "accesskey" and "secretkey".
+	AccessKeyKey string `json:"accessKeyKey,omitempty"`
+	SecretKeyKey string `json:"secretKeyKey,omitempty"`
+	// ServiceAccountKeyKey is the key of a GCP service account JSON key, used for gs:// buckets.
+	ServiceAccountKeyKey string `json:"serviceAccountKeyKey,omitempty"`
+}
+
+// WebIdentityConfig configures AWS STS AssumeRoleWithWebIdentity.
+type WebIdentityConfig struct {
+	RoleARN string `json:"roleARN"`
+	// TokenFile defaults to the AWS_WEB_IDENTITY_TOKEN_FILE env var.
+	TokenFile   string `json:"tokenFile,omitempty"`
+	SessionName string `json:"sessionName,omitempty"`
+}
+
+const (
+	defaultAccessKeyKey           = "accesskey"
+	defaultSecretKeyKey           = "secretkey"
+	defaultWebIdentitySessionName = "kfp-launcher"
+)
+
+// ParseProvidersConfig parses the YAML or JSON providers configuration.
+func ParseProvidersConfig(data string) (ProvidersConfig, error) {
+	providers := ProvidersConfig{}
+	if strings.TrimSpace(data) == "" {
+		return providers, nil
+	}
+	if err := yaml.UnmarshalStrict([]byte(data), &providers); err != nil {
+		return nil, fmt.Errorf("failed to parse object store providers config: %w", err)
+	}
+	for scheme, provider := range providers {
+		if provider == nil {
+			continue
+		}
+		if err := provider.Default.validate(); err != nil {
+			return nil, fmt.Errorf("invalid object store providers config for %q default: %w", scheme, err)
+		}
+		for i := range provider.Overrides {
+			override := &provider.Overrides[i]
+			if override.BucketName == "" {
+				return nil, fmt.Errorf("invalid object store providers config for %q: override %d must specify bucketName", scheme, i)
+			}
+			if err := override.BucketSettings.validate(); err != nil {
+				return nil, fmt.Errorf("invalid object store providers config for %q bucket %q: %w", scheme, override.BucketName, err)
+			}
+		}
+	}
+	return providers, nil
+}
+
+func (s *BucketSettings) validate() error {
+	if s == nil || s.Credentials == nil {
+		return nil
+	}
+	c := s.Credentials
+	set := 0
+	if c.FromEnv {
+		set++
+	}
+	if c.SecretRef != nil {
+		set++
+		if c.SecretRef.SecretName == "" {
+			return fmt.Errorf("credentials.secretRef must specify secretName")
+		}
+	}
+	if c.WebIdentity != nil {
+		set++
+		if c.WebIdentity.RoleARN == "" {
+			return fmt.Errorf("credentials.webIdentity must specify roleARN")
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("only one of credentials.fromEnv, credentials.secretRef and credentials.webIdentity may be set")
+	}
+	return nil
+}
+
+// SettingsFor returns the bucket settings which apply to the given URI, or nil
+// when nothing is configured for it.
+func (p ProvidersConfig) SettingsFor(uri string) (*BucketSettings, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	ms := bucketPattern.FindStringSubmatch(uri)
+	if ms == nil || len(ms) != 5 {
+		return nil, fmt.Errorf("failed to find object store settings: unrecognized uri format: %q", uri)
+	}
+	scheme := strings.SplitN(ms[1], ":", 2)[0]
+	provider := p[scheme]
+	if provider == nil {
+		return nil, nil
+	}
+	bucketName := ms[2]
+	key := strings.TrimPrefix(ms[3], "/")
+
+	var match *BucketOverride
+	for i := range provider.Overrides {
+		override := &provider.Overrides[i]
+		if override.BucketName != bucketName || !strings.HasPrefix(key, override.KeyPrefix) {
+			continue
+		}
+		if match == nil || len(override.KeyPrefix) > len(match.KeyPrefix) {
+			match = override
+		}
+	}
+	if match == nil {
+		if provider.Default == nil {
+			return nil, nil
+		}
+		settings := *provider.Default
+		return &settings, nil
+	}
+	return provider.Default.merge(&match.BucketSettings), nil
+}
+
+// merge returns a copy of s with the fields set in override replaced.
+func (s *BucketSettings) merge(override *BucketSettings) *BucketSettings {
+	merged := BucketSettings{}
+	if s != nil {
+		merged = *s
+	}
+	if override.Endpoint != "" {
+		merged.Endpoint = override.Endpoint
+	}
+	if override.Region != "" {
+		merged.Region = override.Region
+	}
+	if override.DisableSSL != nil {
+		merged.DisableSSL = override.DisableSSL
+	}
+	if override.ForcePathStyle != nil {
+		merged.ForcePathStyle = override.ForcePathStyle
+	}
+	if override.Credentials != nil {
+		merged.Credentials = override.Credentials
+	}
+	return &merged
+}
+
+func openBucketWithSettings(ctx context.Context, k8sClient kubernetes.Interface, namespace string, config *Config) (*blob.Bucket, error) {
+	settings := config.Settings
+	switch config.Scheme {
+	case "minio://", "s3://":
+		sess, err := newS3Session(ctx, k8sClient, namespace, config.Scheme, settings)
+		if err != nil {
+			return nil, err
+		}
+		bucket, err := s3blob.OpenBucket(ctx, sess, config.BucketName, nil)
+		if err != nil {
+			return nil, err
+		}
+		// Directly calling s3blob.OpenBucket does not allow overriding prefix via bucketConfig.BucketURL().
+		// Therefore, we need to explicitly configure the prefixed bucket.
+		return blob.PrefixedBucket(bucket, config.Prefix), nil
+	case "gs://":
+		if settings.Endpoint != "" || settings.Region != "" || settings.DisableSSL != nil || settings.ForcePathStyle != nil {
+			return nil, fmt.Errorf("endpoint, region, disableSSL and forcePathStyle are not supported for gs:// buckets")
+		}
+		c := settings.Credentials
+		if c == nil || c.FromEnv {
+			return blob.OpenBucket(ctx, config.bucketURL())
+		}
+		if c.WebIdentity != nil {
+			return nil, fmt.Errorf("webIdentity credentials are not supported for gs:// buckets")
+		}
+		keyName := c.SecretRef.ServiceAccountKeyKey
+		if keyName == "" {
+			return nil, fmt.Errorf("credentials.secretRef.serviceAccountKeyKey must be set for gs:// buckets")
+		}
+		data, err := getSecretData(ctx, k8sClient, namespace, c.SecretRef.SecretName, keyName)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := google.CredentialsFromJSON(ctx, data, storage.ScopeReadWrite)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse GCP service account key from secret %q: %w", c.SecretRef.SecretName, err)
+		}
+		client, err := gcp.NewHTTPClient(gcp.DefaultTransport(), gcp.CredentialsTokenSource(creds))
+		if err != nil {
+			return nil, err
+		}
+		bucket, err := gcsblob.OpenBucket(ctx, client, config.BucketName, nil)
+		if err != nil {
+			return nil, err
+		}
+		return blob.PrefixedBucket(bucket, config.Prefix), nil
+	default:
+		return nil, fmt.Errorf("object store settings are not supported for scheme %q", config.Scheme)
+	}
+}
+
+// newS3Session creates an AWS session for S3 compatible stores. minio:// buckets
+// default to the in-cluster MinIO service and the mlpipeline-minio-artifact secret.
+func newS3Session(ctx context.Context, k8sClient kubernetes.Interface, namespace, scheme string, settings *BucketSettings) (*session.Session, error) {
+	awsConfig := &aws.Config{}
+	if scheme == "minio://" {
+		awsConfig.Region = aws.String("minio")
+		awsConfig.Endpoint = aws.String(MinioDefaultEndpoint())
+		awsConfig.DisableSSL = aws.Bool(true)
+		awsConfig.S3ForcePathStyle = aws.Bool(true)
+	}
+	if settings.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(settings.Endpoint)
+	}
+	if settings.Region != "" {
+		awsConfig.Region = aws.String(settings.Region)
+	}
+	if settings.DisableSSL != nil {
+		awsConfig.DisableSSL = settings.DisableSSL
+	}
+	if settings.ForcePathStyle != nil {
+		awsConfig.S3ForcePathStyle = settings.ForcePathStyle
+	}
+
+	cred, err := getS3Credential(ctx, k8sClient, namespace, scheme, settings.Credentials, awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	awsConfig.Credentials = cred
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create session to access %s: %w", scheme, err)
+	}
+	return sess, nil
+}
+
+// getS3Credential resolves the credential provider for an S3 compatible store.
+// A nil result lets the AWS SDK use its default credential chain.
+func getS3Credential(ctx context.Context, k8sClient kubernetes.Interface, namespace, scheme string, c *CredentialsConfig, awsConfig *aws.Config) (*credentials.Credentials, error) {
+	switch {
+	case c == nil:
+		if scheme == "minio://" {
+			return getMinioCredential(ctx, k8sClient, namespace)
+		}
+		return nil, nil
+	case c.FromEnv:
+		return nil, nil
+	case c.SecretRef != nil:
+		accessKeyKey := c.SecretRef.AccessKeyKey
+		if accessKeyKey == "" {
+			accessKeyKey = defaultAccessKeyKey
+		}
+		secretKeyKey := c.SecretRef.SecretKeyKey
+		if secretKeyKey == "" {
+			secretKeyKey = defaultSecretKeyKey
+		}
+		accessKey, err := getSecretData(ctx, k8sClient, namespace, c.SecretRef.SecretName, accessKeyKey)
+		if err != nil {
+			return nil, err
+		}
+		secretKey, err := getSecretData(ctx, k8sClient, namespace, c.SecretRef.SecretName, secretKeyKey)
+		if err != nil {
+			return nil, err
+		}
+		return credentials.NewStaticCredentials(string(accessKey), string(secretKey), ""), nil
+	case c.WebIdentity != nil:
+		tokenFile := c.WebIdentity.TokenFile
+		if tokenFile == "" {
+			tokenFile = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+		}
+		if tokenFile == "" {
+			return nil, fmt.Errorf("credentials.webIdentity.tokenFile is not set and AWS_WEB_IDENTITY_TOKEN_FILE env var is empty")
+		}
+		sessionName := c.WebIdentity.SessionName
+		if sessionName == "" {
+			sessionName = defaultWebIdentitySessionName
+		}
+		// STS is called with the region of the bucket, but never through the custom endpoint.
+		stsSess, err := session.NewSession(&aws.Config{Region: awsConfig.Region})
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create session to access STS: %w", err)
+		}
+		return stscreds.NewWebIdentityCredentials(stsSess, c.WebIdentity.RoleARN, sessionName, tokenFile), nil
+	}
+	return nil, fmt.Errorf("unsupported credentials config")
+}
+
+func getSecretData(ctx context.Context, clientSet kubernetes.Interface, namespace, secretName, key string) ([]byte, error) {
+	secret, err := clientSet.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get secret name=%q namespace=%q: %w", secretName, namespace, err)
+	}
+	data, ok := secret.Data[key]
+	if !ok || len(data) == 0 {
+		return nil, fmt.Errorf("secret name=%q namespace=%q does not have key %q", secretName, namespace, key)
+	}
+	return data, nil
+}
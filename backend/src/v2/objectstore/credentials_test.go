@@ -0,0 +1,234 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testProvidersConfig = `
+minio:
+  default:
+    endpoint: minio.example.com:9000
+    credentials:
+      secretRef:
+        secretName: shared-minio
+s3:
+  default:
+    region: us-east-1
+  overrides:
+  - bucketName: team-a
+    endpoint: s3.internal.example.com
+    forcePathStyle: true
+    credentials:
+      secretRef:
+        secretName: team-a-s3
+        accessKeyKey: AWS_ACCESS_KEY_ID
+        secretKeyKey: AWS_SECRET_ACCESS_KEY
+  - bucketName: team-a
+    keyPrefix: restricted/
+    credentials:
+      webIdentity:
+        roleARN: arn:aws:iam::123456789012:role/restricted
+        tokenFile: /var/run/token
+`
+
+func Test_ParseProvidersConfig(t *testing.T) {
+	providers, err := ParseProvidersConfig(testProvidersConfig)
+	require.Nil(t, err)
+	require.Contains(t, providers, "s3")
+	assert.Equal(t, "us-east-1", providers["s3"].Default.Region)
+	assert.Len(t, providers["s3"].Overrides, 2)
+	assert.Equal(t, "team-a-s3", providers["s3"].Overrides[0].Credentials.SecretRef.SecretName)
+
+	empty, err := ParseProvidersConfig("")
+	assert.Nil(t, err)
+	assert.Empty(t, empty)
+}
+
+func Test_ParseProvidersConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{name: "unknown field", config: "s3:\n  default:\n    endpiont: foo\n"},
+		{name: "override without bucket", config: "s3:\n  overrides:\n  - keyPrefix: a/\n"},
+		{name: "secret without name", config: "s3:\n  default:\n    credentials:\n      secretRef: {}\n"},
+		{name: "web identity without role", config: "s3:\n  default:\n    credentials:\n      webIdentity: {}\n"},
+		{name: "multiple credentials", config: "s3:\n  default:\n    credentials:\n      fromEnv: true\n      secretRef:\n        secretName: foo\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseProvidersConfig(tt.config)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func Test_ProvidersConfig_SettingsFor(t *testing.T) {
+	providers, err := ParseProvidersConfig(testProvidersConfig)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name           string
+		uri            string
+		wantNil        bool
+		wantEndpoint   string
+		wantRegion     string
+		wantSecret     string
+		wantRoleARN    string
+		wantPathStyled bool
+	}{
+		{name: "scheme without config", uri: "gs://bucket/root", wantNil: true},
+		{name: "default for scheme", uri: "minio://mlpipeline/v2/artifacts", wantEndpoint: "minio.example.com:9000", wantSecret: "shared-minio"},
+		{name: "other bucket uses default", uri: "s3://team-b/root", wantRegion: "us-east-1"},
+		{name: "bucket override", uri: "s3://team-a/pipelines/root", wantEndpoint: "s3.internal.example.com", wantRegion: "us-east-1", wantSecret: "team-a-s3", wantPathStyled: true},
+		{name: "longest key prefix wins", uri: "s3://team-a/restricted/root", wantRegion: "us-east-1", wantRoleARN: "arn:aws:iam::123456789012:role/restricted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := providers.SettingsFor(tt.uri)
+			require.Nil(t, err)
+			if tt.wantNil {
+				assert.Nil(t, settings)
+				return
+			}
+			require.NotNil(t, settings)
+			assert.Equal(t, tt.wantEndpoint, settings.Endpoint)
+			assert.Equal(t, tt.wantRegion, settings.Region)
+			assert.Equal(t, tt.wantPathStyled, aws.BoolValue(settings.ForcePathStyle))
+			if tt.wantSecret != "" {
+				assert.Equal(t, tt.wantSecret, settings.Credentials.SecretRef.SecretName)
+			}
+			if tt.wantRoleARN != "" {
+				assert.Equal(t, tt.wantRoleARN, settings.Credentials.WebIdentity.RoleARN)
+			}
+		})
+	}
+
+	var noProviders ProvidersConfig
+	settings, err := noProviders.SettingsFor("s3://team-a/root")
+	assert.Nil(t, err)
+	assert.Nil(t, settings)
+}
+
+func Test_getS3Credential(t *testing.T) {
+	ctx := context.Background()
+	clientSet := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-s3", Namespace: "team-a"},
+			Data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: minioArtifactSecretName, Namespace: "team-a"},
+			Data: map[string][]byte{
+				"accesskey": []byte("minio-access"),
+				"secretkey": []byte("minio-secret"),
+			},
+		},
+	)
+
+	t.Run("secret with custom keys", func(t *testing.T) {
+		cred, err := getS3Credential(ctx, clientSet, "team-a", "s3://", &CredentialsConfig{
+			SecretRef: &SecretRef{SecretName: "team-a-s3", AccessKeyKey: "AWS_ACCESS_KEY_ID", SecretKeyKey: "AWS_SECRET_ACCESS_KEY"},
+		}, &aws.Config{})
+		require.Nil(t, err)
+		value, err := cred.Get()
+		require.Nil(t, err)
+		assert.Equal(t, "access", value.AccessKeyID)
+		assert.Equal(t, "secret", value.SecretAccessKey)
+	})
+	t.Run("secret in another namespace is not visible", func(t *testing.T) {
+		_, err := getS3Credential(ctx, clientSet, "team-b", "s3://", &CredentialsConfig{
+			SecretRef: &SecretRef{SecretName: "team-a-s3"},
+		}, &aws.Config{})
+		assert.NotNil(t, err)
+	})
+	t.Run("secret without default keys", func(t *testing.T) {
+		_, err := getS3Credential(ctx, clientSet, "team-a", "s3://", &CredentialsConfig{
+			SecretRef: &SecretRef{SecretName: "team-a-s3"},
+		}, &aws.Config{})
+		assert.NotNil(t, err)
+	})
+	t.Run("minio falls back to the default secret", func(t *testing.T) {
+		cred, err := getS3Credential(ctx, clientSet, "team-a", "minio://", nil, &aws.Config{})
+		require.Nil(t, err)
+		value, err := cred.Get()
+		require.Nil(t, err)
+		assert.Equal(t, "minio-access", value.AccessKeyID)
+	})
+	t.Run("s3 without credentials uses the SDK chain", func(t *testing.T) {
+		cred, err := getS3Credential(ctx, clientSet, "team-a", "s3://", nil, &aws.Config{})
+		assert.Nil(t, err)
+		assert.Nil(t, cred)
+	})
+	t.Run("web identity", func(t *testing.T) {
+		cred, err := getS3Credential(ctx, clientSet, "team-a", "s3://", &CredentialsConfig{
+			WebIdentity: &WebIdentityConfig{RoleARN: "arn:aws:iam::123456789012:role/team-a", TokenFile: "/var/run/token"},
+		}, &aws.Config{Region: aws.String("us-east-1")})
+		assert.Nil(t, err)
+		assert.NotNil(t, cred)
+	})
+	t.Run("web identity without token file", func(t *testing.T) {
+		t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "")
+		_, err := getS3Credential(ctx, clientSet, "team-a", "s3://", &CredentialsConfig{
+			WebIdentity: &WebIdentityConfig{RoleARN: "arn:aws:iam::123456789012:role/team-a"},
+		}, &aws.Config{})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_OpenBucket_WithSettings(t *testing.T) {
+	ctx := context.Background()
+	clientSet := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a-s3", Namespace: "team-a"},
+		Data: map[string][]byte{
+			"accesskey": []byte("access"),
+			"secretkey": []byte("secret"),
+		},
+	})
+	config, err := ParseBucketConfig("s3://team-a/pipelines")
+	require.Nil(t, err)
+	config.Settings = &BucketSettings{
+		Endpoint:       "s3.internal.example.com",
+		Region:         "eu-west-1",
+		ForcePathStyle: aws.Bool(true),
+		Credentials:    &CredentialsConfig{SecretRef: &SecretRef{SecretName: "team-a-s3"}},
+	}
+	bucket, err := OpenBucket(ctx, clientSet, "team-a", config)
+	require.Nil(t, err)
+	assert.Nil(t, bucket.Close())
+
+	config.Settings.Credentials.SecretRef.SecretName = "missing"
+	_, err = OpenBucket(ctx, clientSet, "team-a", config)
+	assert.NotNil(t, err)
+
+	gcsConfig, err := ParseBucketConfig("gs://team-a/pipelines")
+	require.Nil(t, err)
+	gcsConfig.Settings = &BucketSettings{Endpoint: "storage.example.com"}
+	_, err = OpenBucket(ctx, clientSet, "team-a", gcsConfig)
+	assert.NotNil(t, err)
+}
@@ -16,10 +16,10 @@
 package objectstore
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -31,6 +31,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/golang/glog"
 	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/azureblob"
+	_ "gocloud.dev/blob/fileblob"
 	_ "gocloud.dev/blob/gcsblob"
 	"gocloud.dev/blob/s3blob"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +44,9 @@ type Config struct {
 	BucketName  string
 	Prefix      string
 	QueryString string
+	// Settings are the connection and credential settings configured for the
+	// bucket, see ProvidersConfig. Nil uses the built-in defaults of the scheme.
+	Settings *BucketSettings
 }
 
 func OpenBucket(ctx context.Context, k8sClient kubernetes.Interface, namespace string, config *Config) (bucket *blob.Bucket, err error) {
@@ -50,6 +55,9 @@ func OpenBucket(ctx context.Context, k8sClient kubernetes.Interface, namespace s
 			err = fmt.Errorf("Failed to open bucket %q: %w", config.BucketName, err)
 		}
 	}()
+	if config.Settings != nil {
+		return openBucketWithSettings(ctx, k8sClient, namespace, config)
+	}
 	if config.Scheme == "minio://" {
 		cred, err := getMinioCredential(ctx, k8sClient, namespace)
 		if err != nil {
@@ -115,42 +123,48 @@ func (b *Config) UriFromKey(blobKey string) string {
 	return b.Scheme + path.Join(b.BucketName, b.Prefix, blobKey)
 }
 
+// UploadBlob uploads a local file or directory to blobPath using the default transfer options.
 func UploadBlob(ctx context.Context, bucket *blob.Bucket, localPath, blobPath string) error {
+	return UploadBlobWithOptions(ctx, bucket, localPath, blobPath, DefaultTransferOptions())
+}
+
+// UploadBlobWithOptions uploads a local file or directory to blobPath. Files in a
+// directory are uploaded concurrently according to opts.
+func UploadBlobWithOptions(ctx context.Context, bucket *blob.Bucket, localPath, blobPath string, opts *TransferOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
 	fileInfo, err := os.Stat(localPath)
 	if err != nil {
 		return fmt.Errorf("unable to stat local filepath %q: %w", localPath, err)
 	}
 
 	if !fileInfo.IsDir() {
-		return uploadFile(ctx, bucket, localPath, blobPath)
+		return uploadFile(ctx, bucket, localPath, blobPath, opts)
 	}
 
 	// localPath is a directory.
-	files, err := ioutil.ReadDir(localPath)
+	files, err := listLocalFiles(localPath, blobPath)
 	if err != nil {
-		return fmt.Errorf("unable to list local directory %q: %w", localPath, err)
-	}
-
-	for _, f := range files {
-		if f.IsDir() {
-			err = UploadBlob(ctx, bucket, filepath.Join(localPath, f.Name()), blobPath+"/"+f.Name())
-			if err != nil {
-				return err
-			}
-		} else {
-			blobFilePath := filepath.Join(blobPath, filepath.Base(f.Name()))
-			localFilePath := filepath.Join(localPath, f.Name())
-			if err := uploadFile(ctx, bucket, localFilePath, blobFilePath); err != nil {
-				return err
-			}
-		}
-
+		return err
 	}
-
-	return nil
+	return runTransfers(ctx, fmt.Sprintf("UploadBlob(%q)", blobPath), files, opts, func(ctx context.Context, f transferFile) error {
+		return uploadFile(ctx, bucket, f.localPath, f.blobKey, opts)
+	})
 }
 
+// DownloadBlob downloads all blobs under blobDir into localDir using the default transfer options.
 func DownloadBlob(ctx context.Context, bucket *blob.Bucket, localDir, blobDir string) error {
+	return DownloadBlobWithOptions(ctx, bucket, localDir, blobDir, DefaultTransferOptions())
+}
+
+// DownloadBlobWithOptions downloads all blobs under blobDir into localDir. Blobs
+// are downloaded concurrently according to opts.
+func DownloadBlobWithOptions(ctx context.Context, bucket *blob.Bucket, localDir, blobDir string, opts *TransferOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	var files []transferFile
 	iter := bucket.List(&blob.ListOptions{Prefix: blobDir})
 	for {
 		obj, err := iter.Next(ctx)
@@ -171,12 +185,36 @@ func DownloadBlob(ctx context.Context, bucket *blob.Bucket, localDir, blobDir st
 			if err != nil {
 				return fmt.Errorf("unexpected object key %q when listing %q: %w", obj.Key, blobDir, err)
 			}
-			if err := downloadFile(ctx, bucket, obj.Key, filepath.Join(localDir, relativePath)); err != nil {
-				return err
-			}
+			files = append(files, transferFile{
+				localPath: filepath.Join(localDir, relativePath),
+				blobKey:   obj.Key,
+				size:      obj.Size,
+			})
 		}
 	}
-	return nil
+	return runTransfers(ctx, fmt.Sprintf("DownloadBlob(%q)", blobDir), files, opts, func(ctx context.Context, f transferFile) error {
+		return downloadFile(ctx, bucket, f.blobKey, f.localPath, opts)
+	})
+}
+
+// SchemeName returns the scheme of a URI or URI prefix without "://", e.g. "gs"
+// for "gs://bucket/path".
+func SchemeName(uri string) string {
+	i := strings.Index(uri, "://")
+	if i < 0 {
+		return ""
+	}
+	return uri[:i]
+}
+
+// IsSupportedScheme reports whether buckets of the scheme can be opened. Besides
+// minio, this includes gs, s3, azblob, file and any other scheme registered with
+// the gocloud.dev default URL mux, so custom drivers only need to be imported.
+func IsSupportedScheme(scheme string) bool {
+	if scheme == "minio" {
+		return true
+	}
+	return scheme != "" && blob.DefaultURLMux().ValidBucketScheme(scheme)
 }
 
 var bucketPattern = regexp.MustCompile(`(^[a-z][a-z0-9]+:///?)([^/?]+)(/[^?]*)?(\?.+)?$`)
@@ -187,8 +225,7 @@ func ParseBucketConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("parse bucket config failed: unrecognized pipeline root format: %q", path)
 	}
 
-	// TODO: Verify/add support for file:///.
-	if ms[1] != "gs://" && ms[1] != "s3://" && ms[1] != "minio://" {
+	if !IsSupportedScheme(SchemeName(ms[1])) {
 		return nil, fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", path)
 	}
 
@@ -211,8 +248,7 @@ func ParseBucketConfigForArtifactURI(uri string) (*Config, error) {
 		return nil, fmt.Errorf("parse bucket config failed: unrecognized uri format: %q", uri)
 	}
 
-	// TODO: Verify/add support for file:///.
-	if ms[1] != "gs://" && ms[1] != "s3://" && ms[1] != "minio://" {
+	if !IsSupportedScheme(SchemeName(ms[1])) {
 		return nil, fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", uri)
 	}
 
@@ -223,66 +259,159 @@ func ParseBucketConfigForArtifactURI(uri string) (*Config, error) {
 }
 
 // TODO(neuromage): Move these helper functions to a storage package and add tests.
-func uploadFile(ctx context.Context, bucket *blob.Bucket, localFilePath, blobFilePath string) error {
+func uploadFile(ctx context.Context, bucket *blob.Bucket, localFilePath, blobFilePath string, opts *TransferOptions) error {
 	errorF := func(err error) error {
 		return fmt.Errorf("uploadFile(): unable to complete copying %q to remote storage %q: %w", localFilePath, blobFilePath, err)
 	}
 
-	w, err := bucket.NewWriter(ctx, blobFilePath, nil)
+	writerOpts := &blob.WriterOptions{BufferSize: opts.PartSize}
+	if opts.VerifyChecksum || opts.Resume {
+		md5sum, err := fileMD5(localFilePath)
+		if err != nil {
+			return errorF(fmt.Errorf("unable to compute MD5 of local file %q: %w", localFilePath, err))
+		}
+		if opts.Resume {
+			if attrs, err := bucket.Attributes(ctx, blobFilePath); err == nil && bytes.Equal(attrs.MD5, md5sum) {
+				glog.Infof("uploadFile(localFilePath=%q, blobFilePath=%q): remote blob is up to date, skipping", localFilePath, blobFilePath)
+				return nil
+			}
+		}
+		if opts.VerifyChecksum {
+			// The blob writer fails on Close if the written content does not match ContentMD5,
+			// and object stores which support it verify the checksum server side too.
+			writerOpts.ContentMD5 = md5sum
+		}
+	}
+
+	err := opts.retry(ctx, fmt.Sprintf("uploadFile(%q)", blobFilePath), func() error {
+		return uploadFileOnce(ctx, bucket, localFilePath, blobFilePath, writerOpts)
+	})
 	if err != nil {
-		return errorF(fmt.Errorf("unable to open writer for bucket: %w", err))
+		return errorF(err)
 	}
 
+	glog.Infof("uploadFile(localFilePath=%q, blobFilePath=%q)", localFilePath, blobFilePath)
+	return nil
+}
+
+func uploadFileOnce(ctx context.Context, bucket *blob.Bucket, localFilePath, blobFilePath string, writerOpts *blob.WriterOptions) error {
 	r, err := os.Open(localFilePath)
 	if err != nil {
-		return errorF(fmt.Errorf("unable to open local file %q for reading: %w", localFilePath, err))
+		return fmt.Errorf("unable to open local file %q for reading: %w", localFilePath, err)
 	}
 	defer r.Close()
 
+	// Cancelling the writer's context aborts the upload instead of committing a partial blob.
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := bucket.NewWriter(writeCtx, blobFilePath, writerOpts)
+	if err != nil {
+		return fmt.Errorf("unable to open writer for bucket: %w", err)
+	}
+
 	if _, err = io.Copy(w, r); err != nil {
-		return errorF(fmt.Errorf("unable to complete copying: %w", err))
+		cancel()
+		w.Close()
+		return fmt.Errorf("unable to complete copying: %w", err)
 	}
 
 	if err = w.Close(); err != nil {
-		return errorF(fmt.Errorf("failed to close Writer for bucket: %w", err))
+		return fmt.Errorf("failed to close Writer for bucket: %w", err)
 	}
-
-	glog.Infof("uploadFile(localFilePath=%q, blobFilePath=%q)", localFilePath, blobFilePath)
 	return nil
 }
 
-func downloadFile(ctx context.Context, bucket *blob.Bucket, blobFilePath, localFilePath string) (err error) {
+func downloadFile(ctx context.Context, bucket *blob.Bucket, blobFilePath, localFilePath string, opts *TransferOptions) error {
 	errorF := func(err error) error {
 		return fmt.Errorf("downloadFile(): unable to complete copying %q to local storage %q: %w", blobFilePath, localFilePath, err)
 	}
 
-	r, err := bucket.NewReader(ctx, blobFilePath, nil)
-	if err != nil {
-		return errorF(fmt.Errorf("unable to open reader for bucket: %w", err))
-	}
-	defer r.Close()
-
 	localDir := filepath.Dir(localFilePath)
 	if err := os.MkdirAll(localDir, 0755); err != nil {
 		return errorF(fmt.Errorf("failed to create local directory %q: %w", localDir, err))
 	}
+	if !opts.Resume {
+		if err := os.Remove(localFilePath); err != nil && !os.IsNotExist(err) {
+			return errorF(fmt.Errorf("failed to remove existing local file %q: %w", localFilePath, err))
+		}
+	}
 
-	w, err := os.Create(localFilePath)
+	attrs, err := bucket.Attributes(ctx, blobFilePath)
 	if err != nil {
-		return errorF(fmt.Errorf("unable to open local file %q for writing: %w", localFilePath, err))
+		return errorF(fmt.Errorf("unable to get attributes of blob: %w", err))
+	}
+	var sum *checksum
+	if opts.VerifyChecksum {
+		sum = checksumFromAttributes(attrs)
+	}
+
+	// Each attempt continues from the bytes already written to the local file, so
+	// retries after a broken connection don't start from scratch.
+	err = opts.retry(ctx, fmt.Sprintf("downloadFile(%q)", blobFilePath), func() error {
+		if err := downloadFileOnce(ctx, bucket, blobFilePath, localFilePath, attrs.Size); err != nil {
+			return err
+		}
+		if sum == nil {
+			return nil
+		}
+		ok, err := sum.verify(localFilePath)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// Start over on the next attempt.
+			if err := os.Remove(localFilePath); err != nil {
+				return err
+			}
+			return fmt.Errorf("%s checksum of downloaded file does not match blob attributes", sum.name)
+		}
+		return nil
+	})
+	if err != nil {
+		return errorF(err)
+	}
+	return nil
+}
+
+func downloadFileOnce(ctx context.Context, bucket *blob.Bucket, blobFilePath, localFilePath string, size int64) (err error) {
+	w, err := os.OpenFile(localFilePath, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open local file %q for writing: %w", localFilePath, err)
 	}
 	defer func() {
 		errClose := w.Close()
 		if err == nil && errClose != nil {
 			// override named return value "err" when there's a close error
-			err = errorF(errClose)
+			err = errClose
 		}
 	}()
 
-	if _, err = io.Copy(w, r); err != nil {
-		return errorF(fmt.Errorf("unable to complete copying: %w", err))
+	offset, err := w.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if offset > size {
+		// The local file is not a prefix of the blob, discard it.
+		if err := w.Truncate(0); err != nil {
+			return err
+		}
+		if offset, err = w.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	if offset == size && size > 0 {
+		return nil
 	}
 
+	r, err := bucket.NewRangeReader(ctx, blobFilePath, offset, -1, nil)
+	if err != nil {
+		return fmt.Errorf("unable to open reader for bucket: %w", err)
+	}
+	defer r.Close()
+
+	if _, err = io.Copy(w, r); err != nil {
+		return fmt.Errorf("unable to complete copying: %w", err)
+	}
 	return nil
 }
 
@@ -15,12 +15,29 @@
 package objectstore_test
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/memblob"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func Test_parseCloudBucket(t *testing.T) {
@@ -120,6 +137,37 @@ func Test_parseCloudBucket(t *testing.T) {
 				QueryString: "",
 			},
 			wantErr: false,
+		}, {
+			name: "Parses local file system - absolute path",
+			path: "file:///mnt/pvc/pipeline-root",
+			want: &objectstore.Config{
+				Scheme:     "file:///",
+				BucketName: "mnt",
+				Prefix:     "pvc/pipeline-root/",
+			},
+			wantErr: false,
+		}, {
+			name: "Parses Azure Blob Storage - Container with prefix",
+			path: "azblob://my-container/my-path",
+			want: &objectstore.Config{
+				Scheme:     "azblob://",
+				BucketName: "my-container",
+				Prefix:     "my-path/",
+			},
+			wantErr: false,
+		}, {
+			name: "Parses custom gocloud scheme",
+			path: "mem://my-bucket/my-path",
+			want: &objectstore.Config{
+				Scheme:     "mem://",
+				BucketName: "my-bucket",
+				Prefix:     "my-path/",
+			},
+			wantErr: false,
+		}, {
+			name:    "Fails on scheme without a registered driver",
+			path:    "hdfs://namenode/my-path",
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -225,3 +273,177 @@ func Test_GetMinioDefaultEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsSupportedScheme(t *testing.T) {
+	for _, scheme := range []string{"gs", "s3", "minio", "file", "azblob", "mem"} {
+		assert.True(t, objectstore.IsSupportedScheme(scheme), scheme)
+	}
+	for _, scheme := range []string{"", "hdfs", "http"} {
+		assert.False(t, objectstore.IsSupportedScheme(scheme), scheme)
+	}
+}
+
+// Artifacts written under a pipeline root can be read back through the artifact
+// URI, for each scheme that has a local fake.
+func Test_OpenBucket_UploadDownloadSymmetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		pipelineRoot func(t *testing.T) string
+	}{
+		{
+			name: "file",
+			pipelineRoot: func(t *testing.T) string {
+				return "file://" + filepath.ToSlash(t.TempDir()) + "/pipeline-root"
+			},
+		},
+		{
+			name: "custom gocloud scheme",
+			pipelineRoot: func(t *testing.T) string {
+				return "mem://my-bucket/pipeline-root"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			pipelineRoot := tt.pipelineRoot(t)
+			config, err := objectstore.ParseBucketConfig(pipelineRoot)
+			require.Nil(t, err)
+			bucket, err := objectstore.OpenBucket(ctx, fake.NewSimpleClientset(), "namespace", config)
+			require.Nil(t, err)
+			defer bucket.Close()
+
+			src := t.TempDir()
+			require.Nil(t, ioutil.WriteFile(filepath.Join(src, "model.bin"), []byte("weights"), 0644))
+			artifactURI := pipelineRoot + "/run-1/task/model"
+			key, err := config.KeyFromURI(artifactURI)
+			require.Nil(t, err)
+			assert.Equal(t, "run-1/task/model", key)
+			assert.Equal(t, artifactURI, config.UriFromKey(key))
+			require.Nil(t, objectstore.UploadBlob(ctx, bucket, src, key))
+
+			dst := t.TempDir()
+			require.Nil(t, objectstore.DownloadBlob(ctx, bucket, dst, key))
+			b, err := ioutil.ReadFile(filepath.Join(dst, "model.bin"))
+			require.Nil(t, err)
+			assert.Equal(t, "weights", string(b))
+		})
+	}
+}
+
+// fakeAzureBlobServer serves the Azure Blob Storage operations used to upload and
+// download blobs, for the blobs of the containers of the account kfptest.
+type fakeAzureBlobServer struct {
+	mu     sync.Mutex
+	blocks map[string][]byte
+	blobs  map[string][]byte
+}
+
+func (s *fakeAzureBlobServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The paths are /kfptest/<container>[/<blob>].
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/kfptest/"), "/", 2)
+	query := r.URL.Query()
+	lastModified := time.Unix(0, 0).UTC().Format(http.TimeFormat)
+	w.Header().Set("x-ms-request-id", "request")
+	if len(parts) == 1 {
+		if r.Method != http.MethodGet || query.Get("comp") != "list" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var names []string
+		for name := range s.blobs {
+			if strings.HasPrefix(name, parts[0]+"/"+query.Get("prefix")) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		var items strings.Builder
+		for _, name := range names {
+			fmt.Fprintf(&items, "<Blob><Name>%s</Name><Properties><Last-Modified>%s</Last-Modified>"+
+				"<Etag>0x1</Etag><Content-Length>%d</Content-Length><BlobType>BlockBlob</BlobType></Properties></Blob>",
+				strings.TrimPrefix(name, parts[0]+"/"), lastModified, len(s.blobs[name]))
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults ContainerName="%s"><Blobs>%s</Blobs><NextMarker /></EnumerationResults>`,
+			parts[0], items.String())
+		return
+	}
+	name := parts[0] + "/" + parts[1]
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		body, _ := ioutil.ReadAll(r.Body)
+		s.blocks[name+"/"+query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var blockList struct {
+			Latest []string `xml:"Latest"`
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := xml.Unmarshal(body, &blockList); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var content []byte
+		for _, id := range blockList.Latest {
+			content = append(content, s.blocks[name+"/"+id]...)
+		}
+		s.blobs[name] = content
+		w.Header().Set("ETag", "0x1")
+		w.Header().Set("Last-Modified", lastModified)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		content, ok := s.blobs[name]
+		if !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var offset int
+		if rangeHeader := r.Header.Get("x-ms-range"); rangeHeader != "" {
+			fmt.Sscanf(rangeHeader, "bytes=%d-", &offset)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(content)-1, len(content)))
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)-offset))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("ETag", "0x1")
+		w.Header().Set("Last-Modified", lastModified)
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(content[offset:])
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func Test_OpenBucket_AzureBlob(t *testing.T) {
+	server := httptest.NewServer(&fakeAzureBlobServer{blocks: make(map[string][]byte), blobs: make(map[string][]byte)})
+	defer server.Close()
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "kfptest")
+	t.Setenv("AZURE_STORAGE_KEY", base64.StdEncoding.EncodeToString([]byte("not-a-real-key")))
+	// Blob storage domains on 127.0.0.1 are accessed like the local emulator.
+	t.Setenv("AZURE_STORAGE_DOMAIN", strings.TrimPrefix(server.URL, "http://"))
+	t.Setenv("AZURE_STORAGE_PROTOCOL", "http")
+	ctx := context.Background()
+	pipelineRoot := "azblob://my-container/pipeline-root"
+	config, err := objectstore.ParseBucketConfig(pipelineRoot)
+	require.Nil(t, err)
+	bucket, err := objectstore.OpenBucket(ctx, fake.NewSimpleClientset(), "namespace", config)
+	require.Nil(t, err)
+	defer bucket.Close()
+
+	src := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(src, "model.bin"), []byte("weights"), 0644))
+	key, err := config.KeyFromURI(pipelineRoot + "/run-1/task/model")
+	require.Nil(t, err)
+	require.Nil(t, objectstore.UploadBlob(ctx, bucket, src, key))
+
+	dst := t.TempDir()
+	require.Nil(t, objectstore.DownloadBlob(ctx, bucket, dst, key))
+	b, err := ioutil.ReadFile(filepath.Join(dst, "model.bin"))
+	require.Nil(t, err)
+	assert.Equal(t, "weights", string(b))
+}
@@ -0,0 +1,305 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/cenkalti/backoff"
+	"github.com/golang/glog"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// TransferOptions configures how files are copied between the local file system
+// and an object store by UploadBlob and DownloadBlob.
+type TransferOptions struct {
+	// Parallelism is the maximum number of files transferred concurrently.
+	Parallelism int
+	// MaxRetries is the number of times a failed file transfer is retried.
+	MaxRetries int
+	// InitialBackoff is the wait time before the first retry. It grows
+	// exponentially on each subsequent retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// PartSize is the size in bytes of the chunks sent in a multipart upload.
+	// Zero uses the driver default.
+	PartSize int
+	// VerifyChecksum compares the MD5 (or CRC32C when MD5 is not available)
+	// reported in the blob attributes against the transferred data.
+	VerifyChecksum bool
+	// Resume skips files which already exist at the destination with a
+	// matching checksum, and continues partially downloaded files from
+	// where they stopped.
+	Resume bool
+	// ProgressInterval is the minimum time between two progress log lines.
+	ProgressInterval time.Duration
+}
+
+// DefaultTransferOptions returns the options used when callers do not specify any.
+func DefaultTransferOptions() *TransferOptions {
+	return &TransferOptions{
+		Parallelism:      4,
+		MaxRetries:       3,
+		InitialBackoff:   time.Second,
+		MaxBackoff:       30 * time.Second,
+		VerifyChecksum:   true,
+		Resume:           false,
+		ProgressInterval: 10 * time.Second,
+	}
+}
+
+func (o *TransferOptions) Validate() error {
+	if o.Parallelism < 1 {
+		return fmt.Errorf("invalid transfer options: parallelism must be at least 1, got %d", o.Parallelism)
+	}
+	if o.MaxRetries < 0 {
+		return fmt.Errorf("invalid transfer options: max retries must not be negative, got %d", o.MaxRetries)
+	}
+	if o.InitialBackoff < 0 || o.MaxBackoff < 0 {
+		return fmt.Errorf("invalid transfer options: backoff must not be negative")
+	}
+	if o.PartSize < 0 {
+		return fmt.Errorf("invalid transfer options: part size must not be negative, got %d", o.PartSize)
+	}
+	return nil
+}
+
+func (o *TransferOptions) newBackOff(ctx context.Context) backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = o.InitialBackoff
+	b.MaxInterval = o.MaxBackoff
+	// Retries are bounded by MaxRetries instead of elapsed time.
+	b.MaxElapsedTime = 0
+	return backoff.WithContext(backoff.WithMaxRetries(b, uint64(o.MaxRetries)), ctx)
+}
+
+// retry runs op until it succeeds, returns a permanent error or retries are exhausted.
+func (o *TransferOptions) retry(ctx context.Context, name string, op func() error) error {
+	return backoff.RetryNotify(func() error {
+		err := op()
+		if err != nil && !isRetryable(err) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, o.newBackOff(ctx), func(err error, next time.Duration) {
+		glog.Warningf("%s failed, retrying in %v: %v", name, next, err)
+	})
+}
+
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		// Local file system errors won't go away by retrying.
+		return false
+	}
+	switch gcerrors.Code(err) {
+	case gcerrors.NotFound, gcerrors.PermissionDenied, gcerrors.InvalidArgument, gcerrors.Unimplemented:
+		return false
+	}
+	return true
+}
+
+// transferFile is a single file to copy as part of a blob transfer.
+type transferFile struct {
+	localPath string
+	blobKey   string
+	size      int64
+}
+
+// transferProgress periodically logs how much of a transfer is complete.
+type transferProgress struct {
+	operation  string
+	totalFiles int
+	totalBytes int64
+	interval   time.Duration
+	start      time.Time
+
+	doneFiles int64
+	doneBytes int64
+
+	mu      sync.Mutex
+	lastLog time.Time
+}
+
+func newTransferProgress(operation string, files []transferFile, interval time.Duration) *transferProgress {
+	var totalBytes int64
+	for _, f := range files {
+		totalBytes += f.size
+	}
+	now := time.Now()
+	return &transferProgress{
+		operation:  operation,
+		totalFiles: len(files),
+		totalBytes: totalBytes,
+		interval:   interval,
+		start:      now,
+		lastLog:    now,
+	}
+}
+
+func (p *transferProgress) fileDone(size int64) {
+	doneFiles := atomic.AddInt64(&p.doneFiles, 1)
+	doneBytes := atomic.AddInt64(&p.doneBytes, size)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if now.Sub(p.lastLog) < p.interval && int(doneFiles) < p.totalFiles {
+		return
+	}
+	p.lastLog = now
+	glog.Infof("%s progress: %d/%d files, %d/%d bytes, elapsed %v",
+		p.operation, doneFiles, p.totalFiles, doneBytes, p.totalBytes, now.Sub(p.start).Round(time.Millisecond))
+}
+
+// runTransfers calls transfer for each file using at most opts.Parallelism goroutines.
+// The first error cancels the remaining transfers and is returned.
+func runTransfers(ctx context.Context, operation string, files []transferFile, opts *TransferOptions, transfer func(ctx context.Context, f transferFile) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	progress := newTransferProgress(operation, files, opts.ProgressInterval)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, opts.Parallelism)
+	for _, f := range files {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(f transferFile) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := transfer(ctx, f); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			progress.fileDone(f.size)
+		}(f)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// checksum is the expected digest of a blob together with the hash used to compute it.
+type checksum struct {
+	name     string
+	expected []byte
+	newHash  func() hash.Hash
+}
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksumFromAttributes returns the checksum advertised by the object store, or
+// nil when the driver does not report one (e.g. S3 multipart uploads).
+func checksumFromAttributes(attrs *blob.Attributes) *checksum {
+	if len(attrs.MD5) > 0 {
+		return &checksum{name: "MD5", expected: attrs.MD5, newHash: md5.New}
+	}
+	var gcsAttrs storage.ObjectAttrs
+	if attrs.As(&gcsAttrs) && gcsAttrs.CRC32C != 0 {
+		c := gcsAttrs.CRC32C
+		return &checksum{
+			name:     "CRC32C",
+			expected: []byte{byte(c >> 24), byte(c >> 16), byte(c >> 8), byte(c)},
+			newHash:  func() hash.Hash { return crc32.New(crc32cTable) },
+		}
+	}
+	return nil
+}
+
+// verify reports whether the content of the local file matches the checksum.
+func (c *checksum) verify(localFilePath string) (bool, error) {
+	f, err := os.Open(localFilePath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	h := c.newHash()
+	if _, err := io.Copy(h, f); err != nil {
+		return false, err
+	}
+	return bytes.Equal(h.Sum(nil), c.expected), nil
+}
+
+func fileMD5(localFilePath string) ([]byte, error) {
+	f, err := os.Open(localFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// listLocalFiles returns all regular files under localPath, keyed relative to blobPath.
+func listLocalFiles(localPath, blobPath string) ([]transferFile, error) {
+	var files []transferFile
+	err := filepath.Walk(localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, transferFile{
+			localPath: p,
+			blobKey:   path.Join(blobPath, filepath.ToSlash(rel)),
+			size:      info.Size(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list local directory %q: %w", localPath, err)
+	}
+	return files, nil
+}
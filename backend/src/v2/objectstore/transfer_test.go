@@ -0,0 +1,233 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+	"gocloud.dev/blob/memblob"
+)
+
+func testTransferOptions() *objectstore.TransferOptions {
+	opts := objectstore.DefaultTransferOptions()
+	opts.Parallelism = 3
+	opts.MaxRetries = 1
+	opts.InitialBackoff = time.Millisecond
+	opts.MaxBackoff = time.Millisecond
+	return opts
+}
+
+// writeTestTree creates a directory with nested files and returns their relative paths and contents.
+func writeTestTree(t *testing.T, dir string) map[string]string {
+	files := map[string]string{
+		"a.txt":           "a",
+		"b.txt":           "bb",
+		"empty":           "",
+		"sub/c.txt":       "ccc",
+		"sub/deeper/d.md": "dddd",
+	}
+	for i := 0; i < 20; i++ {
+		files[fmt.Sprintf("many/%02d.bin", i)] = fmt.Sprintf("content of file %d", i)
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		require.Nil(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.Nil(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+	return files
+}
+
+func assertTree(t *testing.T, dir string, want map[string]string) {
+	got := make(map[string]string)
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		got[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func Test_UploadDownloadBlob_Directory(t *testing.T) {
+	fileBucketDir := t.TempDir()
+	fileBucket, err := fileblob.OpenBucket(fileBucketDir, nil)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		bucket *blob.Bucket
+	}{
+		{name: "memblob", bucket: memblob.OpenBucket(nil)},
+		{name: "fileblob", bucket: fileBucket},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			defer tt.bucket.Close()
+			src := t.TempDir()
+			files := writeTestTree(t, src)
+
+			err := objectstore.UploadBlobWithOptions(ctx, tt.bucket, src, "pipeline/run/output", testTransferOptions())
+			require.Nil(t, err)
+			for name, content := range files {
+				b, err := tt.bucket.ReadAll(ctx, "pipeline/run/output/"+name)
+				require.Nil(t, err, name)
+				assert.Equal(t, content, string(b), name)
+			}
+
+			dst := t.TempDir()
+			err = objectstore.DownloadBlobWithOptions(ctx, tt.bucket, dst, "pipeline/run/output", testTransferOptions())
+			require.Nil(t, err)
+			assertTree(t, dst, files)
+		})
+	}
+}
+
+func Test_UploadBlob_SingleFile(t *testing.T) {
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	src := filepath.Join(t.TempDir(), "model.bin")
+	require.Nil(t, ioutil.WriteFile(src, []byte("weights"), 0644))
+
+	require.Nil(t, objectstore.UploadBlob(ctx, bucket, src, "out/model.bin"))
+	b, err := bucket.ReadAll(ctx, "out/model.bin")
+	require.Nil(t, err)
+	assert.Equal(t, "weights", string(b))
+}
+
+func Test_UploadBlob_NotExist(t *testing.T) {
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	err := objectstore.UploadBlob(context.Background(), bucket, filepath.Join(t.TempDir(), "missing"), "out")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func Test_DownloadBlob_ChecksumMismatch(t *testing.T) {
+	ctx := context.Background()
+	bucketDir := t.TempDir()
+	bucket, err := fileblob.OpenBucket(bucketDir, nil)
+	require.Nil(t, err)
+	defer bucket.Close()
+	require.Nil(t, bucket.WriteAll(ctx, "out/data.txt", []byte("original"), nil))
+	// Corrupt the blob behind fileblob's back, the MD5 in its attributes still
+	// refers to the original content.
+	require.Nil(t, ioutil.WriteFile(filepath.Join(bucketDir, "out", "data.txt"), []byte("tampered"), 0644))
+
+	opts := testTransferOptions()
+	err = objectstore.DownloadBlobWithOptions(ctx, bucket, t.TempDir(), "out", opts)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "MD5 checksum")
+
+	opts.VerifyChecksum = false
+	dst := t.TempDir()
+	require.Nil(t, objectstore.DownloadBlobWithOptions(ctx, bucket, dst, "out", opts))
+	assertTree(t, dst, map[string]string{"data.txt": "tampered"})
+}
+
+func Test_DownloadBlob_Resume(t *testing.T) {
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	require.Nil(t, bucket.WriteAll(ctx, "out/data.txt", []byte("0123456789"), nil))
+
+	tests := []struct {
+		name    string
+		partial string
+		resume  bool
+	}{
+		{name: "continue partial download", partial: "01234", resume: true},
+		{name: "partial download is not a prefix", partial: "abcde", resume: true},
+		{name: "local file longer than blob", partial: "0123456789abc", resume: true},
+		{name: "without resume", partial: "01234", resume: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := t.TempDir()
+			require.Nil(t, ioutil.WriteFile(filepath.Join(dst, "data.txt"), []byte(tt.partial), 0644))
+			opts := testTransferOptions()
+			opts.Resume = tt.resume
+			require.Nil(t, objectstore.DownloadBlobWithOptions(ctx, bucket, dst, "out", opts))
+			assertTree(t, dst, map[string]string{"data.txt": "0123456789"})
+		})
+	}
+}
+
+func Test_UploadBlob_ResumeSkipsUpToDate(t *testing.T) {
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	src := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(src, "same.txt"), []byte("same"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(src, "changed.txt"), []byte("new"), 0644))
+	require.Nil(t, bucket.WriteAll(ctx, "out/same.txt", []byte("same"), nil))
+	require.Nil(t, bucket.WriteAll(ctx, "out/changed.txt", []byte("old"), nil))
+	before, err := bucket.Attributes(ctx, "out/same.txt")
+	require.Nil(t, err)
+
+	opts := testTransferOptions()
+	opts.Resume = true
+	require.Nil(t, objectstore.UploadBlobWithOptions(ctx, bucket, src, "out", opts))
+
+	after, err := bucket.Attributes(ctx, "out/same.txt")
+	require.Nil(t, err)
+	assert.Equal(t, before.ModTime, after.ModTime, "up to date blob should not be rewritten")
+	b, err := bucket.ReadAll(ctx, "out/changed.txt")
+	require.Nil(t, err)
+	assert.Equal(t, "new", string(b))
+}
+
+func Test_TransferOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(o *objectstore.TransferOptions)
+		wantErr bool
+	}{
+		{name: "defaults", modify: func(o *objectstore.TransferOptions) {}},
+		{name: "zero parallelism", modify: func(o *objectstore.TransferOptions) { o.Parallelism = 0 }, wantErr: true},
+		{name: "negative retries", modify: func(o *objectstore.TransferOptions) { o.MaxRetries = -1 }, wantErr: true},
+		{name: "negative backoff", modify: func(o *objectstore.TransferOptions) { o.InitialBackoff = -time.Second }, wantErr: true},
+		{name: "negative part size", modify: func(o *objectstore.TransferOptions) { o.PartSize = -1 }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := objectstore.DefaultTransferOptions()
+			tt.modify(opts)
+			err := opts.Validate()
+			assert.Equal(t, tt.wantErr, err != nil, "Validate() error = %v", err)
+		})
+	}
+}
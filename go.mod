@@ -1,6 +1,7 @@
 module github.com/kubeflow/pipelines
 
 require (
+	cloud.google.com/go/storage v1.20.0
 	github.com/Masterminds/squirrel v0.0.0-20190107164353-fa735ea14f09
 	github.com/VividCortex/mysqlerr v0.0.0-20170204212430-6c6b55f8796f
 	github.com/argoproj/argo-workflows/v3 v3.3.10
@@ -46,6 +47,7 @@ require (
 	github.com/stretchr/testify v1.8.1
 	gocloud.dev v0.22.0
 	golang.org/x/net v0.10.0
+	golang.org/x/oauth2 v0.0.0-20211104180415-d3ed0bb246c8
 	google.golang.org/genproto v0.0.0-20220310185008-1973136f34c6
 	google.golang.org/grpc v1.44.0
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.1.0